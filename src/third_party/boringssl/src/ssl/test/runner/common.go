@@ -59,11 +59,17 @@ const (
 type recordType uint8
 
 const (
-	recordTypeChangeCipherSpec   recordType = 20
-	recordTypeAlert              recordType = 21
-	recordTypeHandshake          recordType = 22
-	recordTypeApplicationData    recordType = 23
-	recordTypePlaintextHandshake recordType = 24
+	recordTypeChangeCipherSpec recordType = 20
+	recordTypeAlert            recordType = 21
+	recordTypeHandshake        recordType = 22
+	recordTypeApplicationData  recordType = 23
+	recordTypeHeartbeat        recordType = 24 // RFC 6520
+)
+
+// Heartbeat message types. (RFC 6520)
+const (
+	heartbeatRequest  uint8 = 1
+	heartbeatResponse uint8 = 2
 )
 
 // TLS handshake message types.
@@ -1069,6 +1075,17 @@ type ProtocolBugs struct {
 	// PacketAdaptor is the packetAdaptor to use to simulate timeouts.
 	PacketAdaptor *packetAdaptor
 
+	// DTLSReadTimeout, if non-zero, is the deadline for reading each DTLS
+	// packet. If no packet arrives in time, the read fails with
+	// errDTLSReadTimeout rather than blocking until the idle timeout.
+	DTLSReadTimeout time.Duration
+
+	// DropHandshakeMessage, if not nil, is called with the type and body of
+	// each reassembled DTLS handshake message before it is processed. If it
+	// returns true, the message and the rest of its flight are discarded and
+	// a timeout is simulated so the peer retransmits the flight.
+	DropHandshakeMessage func(typ uint8, body []byte) bool
+
 	// MockQUICTransport is the mockQUICTransport used when testing
 	// QUIC interfaces.
 	MockQUICTransport *mockQUICTransport
@@ -1096,6 +1113,21 @@ type ProtocolBugs struct {
 	// content type to be sent immediately following the handshake.
 	SendInvalidRecordType bool
 
+	// SendEpochZeroRecord, if not nil, causes a plaintext application data
+	// record with epoch zero and the given contents to be sent immediately
+	// following the handshake in DTLS.
+	SendEpochZeroRecord []byte
+
+	// SendZeroLengthRecord, if true, causes an application data record in
+	// the current epoch with a zero length field, and thus no ciphertext,
+	// to be sent immediately following the handshake in DTLS.
+	SendZeroLengthRecord bool
+
+	// SendHeartbeatRequest, if not nil, causes a heartbeat request record
+	// with the given payload to be sent immediately following the
+	// handshake.
+	SendHeartbeatRequest []byte
+
 	// SendWrongMessageType, if non-zero, causes messages of the specified
 	// type to be sent with the wrong value.
 	SendWrongMessageType byte
@@ -1112,6 +1144,15 @@ type ProtocolBugs struct {
 	// handshake fragments in DTLS to have the wrong message length.
 	FragmentMessageLengthMismatch bool
 
+	// WrongMessageSeqType, if non-zero, causes DTLS handshake messages of
+	// the specified type to be sent with a message_seq offset by
+	// WrongMessageSeqDelta.
+	WrongMessageSeqType byte
+
+	// WrongMessageSeqDelta is the amount added to the message_seq of
+	// messages selected by WrongMessageSeqType. It may be negative.
+	WrongMessageSeqDelta int
+
 	// SplitFragments, if non-zero, causes the handshake fragments in DTLS
 	// to be split across two records. The value of |SplitFragments| is the
 	// number of bytes in the first fragment.
@@ -1121,6 +1162,11 @@ type ProtocolBugs struct {
 	// fragments in DTLS.
 	SendEmptyFragments bool
 
+	// SendOutOfRangeFragment, if true, causes each DTLS handshake message
+	// to be preceded by an empty fragment whose offset is past the end of
+	// the message.
+	SendOutOfRangeFragment bool
+
 	// SendSplitAlert, if true, causes an alert to be sent with the header
 	// and record body split across multiple packets. The peer should
 	// discard these packets rather than process it.
@@ -1155,6 +1201,26 @@ type ProtocolBugs struct {
 	// packets, up to the specified packet size.
 	PackHandshakeRecords int
 
+	// TrailingPacketData, if not nil, is appended to every packet sent in
+	// DTLS, after the last record. It should not itself parse as a record.
+	TrailingPacketData []byte
+
+	// PadPacketsToLength, if non-zero, causes every packet sent in DTLS to
+	// be padded with zeros, after the last record, to at least this length.
+	// The zeros do not parse as a record and should be dropped by the peer.
+	PadPacketsToLength int
+
+	// ReverseRecordsInPacket, if true, causes the records packed into each
+	// DTLS packet to be sent in reverse order. Records in different epochs
+	// keep their relative order, so that a ChangeCipherSpec still precedes
+	// the records it enables. See |PackHandshakeRecords|.
+	ReverseRecordsInPacket bool
+
+	// SeparateClientHelloFragments, if true, causes each ClientHello
+	// fragment in DTLS to be sent in its own record and packet, overriding
+	// PackHandshakeFragments and PackHandshakeRecords.
+	SeparateClientHelloFragments bool
+
 	// PackAppDataWithHandshake, if true, extends PackHandshakeRecords to
 	// additionally include the first application data record sent after the
 	// final Finished message in a handshake. (If the final Finished message
@@ -1640,6 +1706,23 @@ type ProtocolBugs struct {
 	// length accepted from the peer.
 	MaxReceivePlaintext int
 
+	// MaxSendPlaintext, if non-zero, is the maximum plaintext length of
+	// application data records sent in DTLS, as if a maximum fragment
+	// length had been negotiated. Longer writes are split across records.
+	MaxSendPlaintext int
+
+	// ReplayWindowGap, if non-zero, causes the first application data
+	// record in DTLS to be sent this many sequence numbers ahead. The next
+	// application data record is sent at the start of the skipped range,
+	// behind the highest sequence number sent so far, and is followed by a
+	// replay of the first record.
+	ReplayWindowGap int
+
+	// BufferNextEpochRecords, if true, causes DTLS records received from the
+	// next epoch before it is established to be buffered and processed once
+	// the epoch changes, rather than rejected.
+	BufferNextEpochRecords bool
+
 	// ExpectPackedEncryptedHandshake, if non-zero, requires that the peer maximally
 	// pack their encrypted handshake messages, fitting at most the
 	// specified number of plaintext bytes per record.
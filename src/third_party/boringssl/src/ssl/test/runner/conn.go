@@ -110,6 +110,21 @@ type Conn struct {
 	pendingFragments [][]byte // pending outgoing handshake fragments.
 	pendingPacket    []byte   // pending outgoing packet.
 
+	// skipStaleFragments is set once a handshake message has been dropped
+	// to request a retransmit. It causes fragments of messages already
+	// processed to be ignored until the dropped message is received again.
+	skipStaleFragments bool
+
+	// replayWindowSeq and replayWindowRecord are the skipped sequence
+	// number and the first record sent for ReplayWindowGap.
+	replayWindowSeq    [8]byte
+	replayWindowRecord []byte
+	replayWindowDone   bool
+
+	// nextEpochRecords contains records received from the next epoch
+	// before it was established, for BufferNextEpochRecords.
+	nextEpochRecords [][]byte
+
 	keyUpdateSeen      bool
 	keyUpdateRequested bool
 	seenOneByteRecord  bool
@@ -1029,6 +1044,12 @@ Again:
 	default:
 		c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
 
+	case recordTypeHeartbeat:
+		// The heartbeat extension is never negotiated, so the peer may
+		// not send heartbeat messages.
+		c.sendAlert(alertUnexpectedMessage)
+		c.in.setErrorLocked(errors.New("tls: received unexpected heartbeat record"))
+
 	case recordTypeAlert:
 		if len(data) != 2 {
 			c.in.setErrorLocked(c.sendAlert(alertUnexpectedMessage))
@@ -1866,9 +1887,29 @@ func (c *Conn) Handshake() error {
 	if c.handshakeErr == nil && c.config.Bugs.SendInvalidRecordType {
 		c.writeRecord(recordType(42), []byte("invalid record"))
 	}
+	if data := c.config.Bugs.SendEpochZeroRecord; c.handshakeErr == nil && c.isDTLS && data != nil {
+		c.dtlsWriteEpochZeroRecord(recordTypeApplicationData, data)
+	}
+	if c.handshakeErr == nil && c.isDTLS && c.config.Bugs.SendZeroLengthRecord {
+		c.dtlsWriteZeroLengthRecord(recordTypeApplicationData)
+	}
+	if payload := c.config.Bugs.SendHeartbeatRequest; c.handshakeErr == nil && payload != nil {
+		c.writeRecord(recordTypeHeartbeat, newHeartbeatMessage(heartbeatRequest, payload))
+	}
 	return c.handshakeErr
 }
 
+// newHeartbeatMessage returns a heartbeat message of the given type carrying
+// payload, followed by the minimum 16 bytes of padding.
+func newHeartbeatMessage(typ uint8, payload []byte) []byte {
+	msg := make([]byte, 3, 3+len(payload)+16)
+	msg[0] = typ
+	msg[1] = byte(len(payload) >> 8)
+	msg[2] = byte(len(payload))
+	msg = append(msg, payload...)
+	return append(msg, make([]byte, 16)...)
+}
+
 // ConnectionState returns basic TLS details about the connection.
 func (c *Conn) ConnectionState() ConnectionState {
 	c.handshakeMutex.Lock()
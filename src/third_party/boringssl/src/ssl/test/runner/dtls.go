@@ -21,8 +21,32 @@ import (
 	"io"
 	"math/rand"
 	"net"
+	"time"
 )
 
+// errDTLSReadTimeout is returned when no packet arrives within
+// Bugs.DTLSReadTimeout.
+var errDTLSReadTimeout = errors.New("dtls: read timed out")
+
+// dtlsReadPacket reads a packet from the transport. If
+// Bugs.DTLSReadTimeout is set and the read does not complete in time, it
+// returns errDTLSReadTimeout.
+func (c *Conn) dtlsReadPacket(b []byte) (int, error) {
+	timeout := c.config.Bugs.DTLSReadTimeout
+	if timeout == 0 {
+		return c.conn.Read(b)
+	}
+	if err := c.conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+	defer c.conn.SetReadDeadline(time.Time{})
+	n, err := c.conn.Read(b)
+	if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+		return 0, errDTLSReadTimeout
+	}
+	return n, err
+}
+
 func (c *Conn) dtlsDoReadRecord(want recordType) (recordType, *block, error) {
 	recordHeaderLen := dtlsRecordHeaderLen
 
@@ -31,13 +55,24 @@ func (c *Conn) dtlsDoReadRecord(want recordType) (recordType, *block, error) {
 	}
 	b := c.rawInput
 
+	// Process records buffered from the current epoch before reading a new
+	// packet.
+	if len(b.data) == 0 && len(c.nextEpochRecords) > 0 && bytes.Equal(c.nextEpochRecords[0][3:5], c.in.seq[:2]) {
+		b.resize(len(c.nextEpochRecords[0]))
+		copy(b.data, c.nextEpochRecords[0])
+		c.nextEpochRecords = c.nextEpochRecords[1:]
+	}
+
 	// Read a new packet only if the current one is empty.
 	var newPacket bool
 	if len(b.data) == 0 {
 		// Pick some absurdly large buffer size.
 		b.resize(maxCiphertext + recordHeaderLen)
-		n, err := c.conn.Read(c.rawInput.data)
+		n, err := c.dtlsReadPacket(c.rawInput.data)
 		if err != nil {
+			// Leave the buffer empty so a later read waits for a new
+			// packet.
+			b.resize(0)
 			return 0, nil, err
 		}
 		if c.config.Bugs.MaxPacketLength != 0 && n > c.config.Bugs.MaxPacketLength {
@@ -76,10 +111,24 @@ func (c *Conn) dtlsDoReadRecord(want recordType) (recordType, *block, error) {
 	}
 	epoch := b.data[3:5]
 	seq := b.data[5:11]
+	// Set aside records from the next epoch. They are read again once the
+	// epoch changes.
+	if c.config.Bugs.BufferNextEpochRecords && isNextEpoch(epoch, c.in.seq[:2]) {
+		n := int(b.data[11])<<8 | int(b.data[12])
+		if len(b.data) < recordHeaderLen+n {
+			return 0, nil, errors.New("dtls: truncated record")
+		}
+		record, rest := c.in.splitBlock(b, recordHeaderLen+n)
+		c.nextEpochRecords = append(c.nextEpochRecords, append([]byte(nil), record.data...))
+		c.in.freeBlock(record)
+		c.rawInput = rest
+		return c.dtlsDoReadRecord(want)
+	}
 	// For test purposes, require the sequence number be monotonically
-	// increasing, so c.in includes the minimum next sequence number. Gaps
-	// may occur if packets failed to be sent out. A real implementation
-	// would maintain a replay window and such.
+	// increasing, so c.in includes the minimum next sequence number. This
+	// is scoped to the current epoch: incEpoch resets it when the epoch
+	// changes. Gaps may occur if packets failed to be sent out. A real
+	// implementation would maintain a replay window and such.
 	if !bytes.Equal(epoch, c.in.seq[:2]) {
 		c.sendAlert(alertIllegalParameter)
 		return 0, nil, c.in.setErrorLocked(fmt.Errorf("dtls: bad epoch"))
@@ -118,10 +167,92 @@ func (c *Conn) dtlsDoReadRecord(want recordType) (recordType, *block, error) {
 	return typ, b, nil
 }
 
+// isNextEpoch returns whether epoch is the one after current. Both are
+// big-endian 16-bit values.
+func isNextEpoch(epoch, current []byte) bool {
+	return uint16(epoch[0])<<8|uint16(epoch[1]) == (uint16(current[0])<<8|uint16(current[1]))+1
+}
+
+// dtlsWriteEpochZeroRecord writes a plaintext record in epoch zero as its own
+// packet, regardless of the current write epoch.
+func (c *Conn) dtlsWriteEpochZeroRecord(typ recordType, data []byte) error {
+	var seq [8]byte
+	copy(seq[2:], c.out.outSeq[2:])
+	return c.dtlsWriteRawRecord(typ, seq[:], data)
+}
+
+// dtlsWriteZeroLengthRecord writes a record in the current epoch whose length
+// field is zero, as its own packet. The record is not encrypted, so it has no
+// room for even an authentication tag.
+func (c *Conn) dtlsWriteZeroLengthRecord(typ recordType) error {
+	if err := c.dtlsWriteRawRecord(typ, c.out.outSeq[:], nil); err != nil {
+		return err
+	}
+	c.out.incSeq(true)
+	return nil
+}
+
+// dtlsWriteRawRecord writes a record with the given epoch and sequence number
+// and unprotected contents as its own packet.
+func (c *Conn) dtlsWriteRawRecord(typ recordType, seq []byte, data []byte) error {
+	record := make([]byte, dtlsRecordHeaderLen, dtlsRecordHeaderLen+len(data))
+	record[0] = byte(typ)
+	record[1] = byte(c.wireVersion >> 8)
+	record[2] = byte(c.wireVersion)
+	copy(record[3:11], seq)
+	record[11] = byte(len(data) >> 8)
+	record[12] = byte(len(data))
+	record = append(record, data...)
+	_, err := c.conn.Write(record)
+	return err
+}
+
+// dtlsWriteReplayWindowRecord implements ReplayWindowGap. The first call sends
+// data after skipping gap sequence numbers. The second sends data at the first
+// skipped sequence number and then replays the first record.
+func (c *Conn) dtlsWriteReplayWindowRecord(data []byte, gap int) (int, error) {
+	if err := c.dtlsFlushPacket(); err != nil {
+		return 0, err
+	}
+
+	if c.replayWindowRecord == nil {
+		c.replayWindowSeq = c.out.seq
+		for i := 0; i < gap; i++ {
+			c.out.incSeq(true)
+		}
+		n, err := c.dtlsPackRecord(recordTypeApplicationData, data, false)
+		if err != nil {
+			return n, err
+		}
+		c.replayWindowRecord = append([]byte(nil), c.pendingPacket...)
+		return n, c.dtlsFlushPacket()
+	}
+
+	resumeSeq := c.out.seq
+	c.out.seq = c.replayWindowSeq
+	c.out.updateOutSeq()
+	n, err := c.dtlsPackRecord(recordTypeApplicationData, data, false)
+	c.out.seq = resumeSeq
+	c.out.updateOutSeq()
+	if err != nil {
+		return n, err
+	}
+	if err := c.dtlsFlushPacket(); err != nil {
+		return n, err
+	}
+	c.replayWindowDone = true
+	_, err = c.conn.Write(c.replayWindowRecord)
+	return n, err
+}
+
 func (c *Conn) makeFragment(header, data []byte, fragOffset, fragLen int) []byte {
 	fragment := make([]byte, 0, 12+fragLen)
 	fragment = append(fragment, header...)
-	fragment = append(fragment, byte(c.sendHandshakeSeq>>8), byte(c.sendHandshakeSeq))
+	seq := c.sendHandshakeSeq
+	if wrongType := c.config.Bugs.WrongMessageSeqType; wrongType != 0 && header[0] == wrongType {
+		seq += uint16(c.config.Bugs.WrongMessageSeqDelta)
+	}
+	fragment = append(fragment, byte(seq>>8), byte(seq))
 	fragment = append(fragment, byte(fragOffset>>16), byte(fragOffset>>8), byte(fragOffset))
 	fragment = append(fragment, byte(fragLen>>16), byte(fragLen>>8), byte(fragLen))
 	fragment = append(fragment, data[fragOffset:fragOffset+fragLen]...)
@@ -151,6 +282,27 @@ func (c *Conn) dtlsWriteRecord(typ recordType, data []byte) (n int, err error) {
 				return
 			}
 			n = len(data)
+		} else if gap := c.config.Bugs.ReplayWindowGap; typ == recordTypeApplicationData && gap > 0 && !c.replayWindowDone {
+			n, err = c.dtlsWriteReplayWindowRecord(data, gap)
+			if err != nil {
+				return
+			}
+		} else if max := c.config.Bugs.MaxSendPlaintext; typ == recordTypeApplicationData && max > 0 {
+			// Loop at least once so an empty write still sends a
+			// record.
+			for first := true; first || len(data) > 0; first = false {
+				m := len(data)
+				if m > max {
+					m = max
+				}
+				var written int
+				written, err = c.dtlsPackRecord(typ, data[:m], false)
+				n += written
+				if err != nil {
+					return
+				}
+				data = data[m:]
+			}
 		} else {
 			n, err = c.dtlsPackRecord(typ, data, false)
 			if err != nil {
@@ -212,6 +364,15 @@ func (c *Conn) dtlsWriteRecord(typ recordType, data []byte) (n int, err error) {
 		c.pendingFragments = append(c.pendingFragments, c.makeFragment(header, data, len(data), 0))
 	}
 
+	if c.config.Bugs.SendOutOfRangeFragment {
+		fragment := c.makeFragment(header, data, 0, 0)
+		fragOffset := len(data) + 1
+		fragment[6] = byte(fragOffset >> 16)
+		fragment[7] = byte(fragOffset >> 8)
+		fragment[8] = byte(fragOffset)
+		c.pendingFragments = append(c.pendingFragments, fragment)
+	}
+
 	firstRun := true
 	fragOffset := 0
 	for firstRun || fragOffset < len(data) {
@@ -287,6 +448,11 @@ func (c *Conn) dtlsPackHandshake() error {
 
 	maxRecordLen := c.config.Bugs.PackHandshakeFragments
 
+	// isSeparate returns whether a fragment or record must be sent alone.
+	isSeparate := func(b []byte) bool {
+		return c.config.Bugs.SeparateClientHelloFragments && b[0] == typeClientHello
+	}
+
 	// Pack handshake fragments into records.
 	var records [][]byte
 	for _, fragment := range fragments {
@@ -297,7 +463,7 @@ func (c *Conn) dtlsPackHandshake() error {
 			} else {
 				records = append(records, fragment)
 			}
-		} else if i := len(records) - 1; len(records) > 0 && len(records[i])+len(fragment) <= maxRecordLen {
+		} else if i := len(records) - 1; len(records) > 0 && len(records[i])+len(fragment) <= maxRecordLen && !isSeparate(records[i]) && !isSeparate(fragment) {
 			records[i] = append(records[i], fragment...)
 		} else {
 			// The fragment will be appended to, so copy it.
@@ -307,10 +473,21 @@ func (c *Conn) dtlsPackHandshake() error {
 
 	// Send the records.
 	for _, record := range records {
+		separate := isSeparate(record)
+		if separate {
+			if err := c.dtlsFlushPacket(); err != nil {
+				return err
+			}
+		}
 		_, err := c.dtlsPackRecord(recordTypeHandshake, record, false)
 		if err != nil {
 			return err
 		}
+		if separate {
+			if err := c.dtlsFlushPacket(); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -371,6 +548,12 @@ func (c *Conn) dtlsPackRecord(typ recordType, data []byte, mustPack bool) (n int
 			vers = VersionTLS10
 		}
 	}
+	if c.config.Bugs.SendRecordVersion != 0 {
+		vers = c.config.Bugs.SendRecordVersion
+	}
+	if c.vers == 0 && c.config.Bugs.SendInitialRecordVersion != 0 {
+		vers = c.config.Bugs.SendInitialRecordVersion
+	}
 	b.data[1] = byte(vers >> 8)
 	b.data[2] = byte(vers)
 	// DTLS records include an explicit sequence number.
@@ -410,12 +593,90 @@ func (c *Conn) dtlsFlushPacket() error {
 	if len(c.pendingPacket) == 0 {
 		return nil
 	}
+	if c.config.Bugs.ReverseRecordsInPacket {
+		c.pendingPacket = reverseRecordsInPacket(c.pendingPacket)
+	}
+	c.pendingPacket = append(c.pendingPacket, c.config.Bugs.TrailingPacketData...)
+	if n := c.config.Bugs.PadPacketsToLength; len(c.pendingPacket) < n {
+		c.pendingPacket = append(c.pendingPacket, make([]byte, n-len(c.pendingPacket))...)
+	}
 	_, err := c.conn.Write(c.pendingPacket)
 	c.pendingPacket = nil
 	return err
 }
 
+// reverseRecordsInPacket returns a copy of packet with the order of each run
+// of records in the same epoch reversed.
+func reverseRecordsInPacket(packet []byte) []byte {
+	var runs [][][]byte
+	var lastEpoch []byte
+	for len(packet) >= dtlsRecordHeaderLen {
+		n := dtlsRecordHeaderLen + (int(packet[11])<<8 | int(packet[12]))
+		if n > len(packet) {
+			break
+		}
+		record := packet[:n]
+		packet = packet[n:]
+		if len(runs) == 0 || !bytes.Equal(record[3:5], lastEpoch) {
+			runs = append(runs, nil)
+			lastEpoch = record[3:5]
+		}
+		runs[len(runs)-1] = append(runs[len(runs)-1], record)
+	}
+
+	var ret []byte
+	for _, run := range runs {
+		for i := len(run) - 1; i >= 0; i-- {
+			ret = append(ret, run[i]...)
+		}
+	}
+	// Keep anything which did not parse as a record at the end.
+	return append(ret, packet...)
+}
+
 func (c *Conn) dtlsDoReadHandshake() ([]byte, error) {
+	for {
+		msg, err := c.dtlsReadHandshakeMessage()
+		if err != nil {
+			return nil, err
+		}
+		if drop := c.config.Bugs.DropHandshakeMessage; drop == nil || !drop(msg[0], msg[4:]) {
+			return msg, nil
+		}
+		if err := c.dtlsRequestRetransmit(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// dtlsRequestRetransmit discards the rest of the current flight, including
+// the last message read, and simulates a timeout so the peer retransmits it.
+func (c *Conn) dtlsRequestRetransmit() error {
+	if c.config.Bugs.PacketAdaptor == nil {
+		return errors.New("dtls: DropHandshakeMessage set without PacketAdaptor")
+	}
+	c.recvHandshakeSeq--
+	c.skipStaleFragments = true
+	c.hand.Reset()
+	if c.rawInput != nil {
+		c.in.freeBlock(c.rawInput)
+		c.rawInput = nil
+	}
+	// Use the peer's initial retransmit timeout.
+	packets, err := c.config.Bugs.PacketAdaptor.SendReadTimeout(timeouts[0])
+	if err != nil {
+		return err
+	}
+	c.config.Bugs.PacketAdaptor.stats.RetransmitsRequested++
+	for _, packet := range packets {
+		if err := c.skipPacket(packet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Conn) dtlsReadHandshakeMessage() ([]byte, error) {
 	// Assemble a full handshake message.  For test purposes, this
 	// implementation assumes fragments arrive in order. It may
 	// need to be cleverer if we ever test BoringSSL's retransmit
@@ -448,6 +709,17 @@ func (c *Conn) dtlsDoReadHandshake() ([]byte, error) {
 		}
 		fragment := c.hand.Next(fragLen)
 
+		// Ignore the retransmitted fragments of messages already
+		// processed. Fragments arrive in order, so once the
+		// retransmission reaches the dropped message, there are no
+		// more to skip.
+		if c.skipStaleFragments {
+			if fragSeq < c.recvHandshakeSeq {
+				continue
+			}
+			c.skipStaleFragments = false
+		}
+
 		// Check it's a fragment for the right message.
 		if fragSeq != c.recvHandshakeSeq {
 			return nil, errors.New("dtls: bad handshake sequence number")
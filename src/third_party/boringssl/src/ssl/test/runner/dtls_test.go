@@ -0,0 +1,362 @@
+// Copyright (c) 2026, Google Inc.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY
+// SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION
+// OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN
+// CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package runner
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestDTLSReadTimeout(t *testing.T) {
+	local, remote := net.Pipe()
+	defer local.Close()
+	defer remote.Close()
+
+	config := &Config{
+		Bugs: ProtocolBugs{
+			DTLSReadTimeout: 10 * time.Millisecond,
+		},
+	}
+	c := DTLSClient(newPacketAdaptor(local), config)
+
+	// The peer is silent, so the read should time out.
+	if _, _, err := c.dtlsDoReadRecord(recordTypeHandshake); err != errDTLSReadTimeout {
+		t.Fatalf("dtlsDoReadRecord returned %v, wanted %v", err, errDTLSReadTimeout)
+	}
+
+	// A peer which sends garbage should not be reported as a timeout.
+	go remote.Write([]byte{opcodePacket, 0, 0, 0, 1, 0})
+	if _, _, err := c.dtlsDoReadRecord(recordTypeHandshake); err == nil || err == errDTLSReadTimeout {
+		t.Fatalf("dtlsDoReadRecord returned %v, wanted a parse error", err)
+	}
+}
+
+func TestDTLSSingleByteFragments(t *testing.T) {
+	const msgLen = 100
+
+	local, remote := net.Pipe()
+	defer local.Close()
+	defer remote.Close()
+
+	body := make([]byte, msgLen)
+	for i := range body {
+		body[i] = byte(i)
+	}
+
+	// Send each byte of the message as its own fragment, record and packet.
+	go func() {
+		for i := 0; i < msgLen; i++ {
+			fragment := []byte{typeServerHello, 0, 0, msgLen, 0, 0, 0, 0, byte(i), 0, 0, 1, body[i]}
+			record := []byte{byte(recordTypeHandshake), 0xfe, 0xfd, 0, 0, 0, 0, 0, 0, 0, byte(i), 0, byte(len(fragment))}
+			record = append(record, fragment...)
+			packet := []byte{opcodePacket, 0, 0, 0, 0}
+			binary.BigEndian.PutUint32(packet[1:], uint32(len(record)))
+			packet = append(packet, record...)
+			if _, err := remote.Write(packet); err != nil {
+				return
+			}
+		}
+	}()
+
+	c := DTLSClient(newPacketAdaptor(local), &Config{})
+	start := time.Now()
+	msg, err := c.dtlsDoReadHandshake()
+	if err != nil {
+		t.Fatalf("dtlsDoReadHandshake failed: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("reassembling %d fragments took %s", msgLen, elapsed)
+	}
+
+	want := append([]byte{typeServerHello, 0, 0, msgLen}, body...)
+	if !bytes.Equal(msg, want) {
+		t.Errorf("dtlsDoReadHandshake returned %x, wanted %x", msg, want)
+	}
+}
+
+func TestReverseRecordsInPacket(t *testing.T) {
+	record := func(epoch, seq byte) []byte {
+		return []byte{byte(recordTypeHandshake), 0xfe, 0xfd, 0, epoch, 0, 0, 0, 0, 0, seq, 0, 1, seq}
+	}
+	join := func(records ...[]byte) []byte {
+		var ret []byte
+		for _, r := range records {
+			ret = append(ret, r...)
+		}
+		return ret
+	}
+
+	packet := join(record(0, 1), record(0, 2), record(1, 0), record(1, 1))
+	want := join(record(0, 2), record(0, 1), record(1, 1), record(1, 0))
+	if got := reverseRecordsInPacket(packet); !bytes.Equal(got, want) {
+		t.Errorf("reverseRecordsInPacket returned %x, wanted %x", got, want)
+	}
+}
+
+func TestDTLSEmptyRecord(t *testing.T) {
+	local, remote := net.Pipe()
+	defer local.Close()
+	defer remote.Close()
+
+	go remote.Write([]byte{
+		opcodePacket, 0, 0, 0, dtlsRecordHeaderLen,
+		byte(recordTypeApplicationData), 0xfe, 0xfd, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	})
+
+	c := DTLSClient(newPacketAdaptor(local), &Config{})
+	typ, b, err := c.dtlsDoReadRecord(recordTypeApplicationData)
+	if err != nil {
+		t.Fatalf("dtlsDoReadRecord failed: %s", err)
+	}
+	if typ != recordTypeApplicationData {
+		t.Errorf("dtlsDoReadRecord returned record type %d, wanted %d", typ, recordTypeApplicationData)
+	}
+	if n := len(b.data[b.off:]); n != 0 {
+		t.Errorf("dtlsDoReadRecord returned %d bytes, wanted 0", n)
+	}
+}
+
+type packetRecorder struct {
+	net.Conn
+	packets [][]byte
+}
+
+func (p *packetRecorder) Write(b []byte) (int, error) {
+	p.packets = append(p.packets, append([]byte(nil), b...))
+	return len(b), nil
+}
+
+func TestReplayWindowGap(t *testing.T) {
+	const gap = 10
+
+	recorder := new(packetRecorder)
+	config := &Config{
+		Bugs: ProtocolBugs{
+			ReplayWindowGap: gap,
+		},
+	}
+	c := DTLSClient(recorder, config)
+	for _, msg := range []string{"first", "second", "third"} {
+		if _, err := c.dtlsWriteRecord(recordTypeApplicationData, []byte(msg)); err != nil {
+			t.Fatalf("dtlsWriteRecord failed: %s", err)
+		}
+	}
+
+	// The first record skips ahead, the second fills in the gap and is
+	// followed by a replay of the first, and the third resumes after the
+	// first.
+	wantSeqs := []uint64{gap, 0, gap, gap + 1}
+	if len(recorder.packets) != len(wantSeqs) {
+		t.Fatalf("wrote %d packets, wanted %d", len(recorder.packets), len(wantSeqs))
+	}
+	for i, packet := range recorder.packets {
+		if seq := binary.BigEndian.Uint64(packet[3:11]); seq != wantSeqs[i] {
+			t.Errorf("packet %d has sequence number %d, wanted %d", i, seq, wantSeqs[i])
+		}
+	}
+	if !bytes.Equal(recorder.packets[0], recorder.packets[2]) {
+		t.Errorf("packet 2 is %x, wanted a replay of %x", recorder.packets[2], recorder.packets[0])
+	}
+}
+
+func TestDTLSSendRecordVersion(t *testing.T) {
+	recorder := new(packetRecorder)
+	config := &Config{
+		Bugs: ProtocolBugs{
+			SendInitialRecordVersion: 0xfe00,
+		},
+	}
+	c := DTLSClient(recorder, config)
+	if _, err := c.dtlsWriteRecord(recordTypeApplicationData, []byte("hello")); err != nil {
+		t.Fatalf("dtlsWriteRecord failed: %s", err)
+	}
+	if len(recorder.packets) != 1 {
+		t.Fatalf("wrote %d packets, wanted 1", len(recorder.packets))
+	}
+	if vers := binary.BigEndian.Uint16(recorder.packets[0][1:3]); vers != 0xfe00 {
+		t.Errorf("record version was %04x, wanted fe00", vers)
+	}
+}
+
+func TestDTLSSequenceNumberPerEpoch(t *testing.T) {
+	local, remote := net.Pipe()
+	defer local.Close()
+	defer remote.Close()
+
+	record := func(epoch, seq byte) []byte {
+		return []byte{
+			opcodePacket, 0, 0, 0, dtlsRecordHeaderLen + 1,
+			byte(recordTypeApplicationData), 0xfe, 0xfd, 0, epoch, 0, 0, 0, 0, 0, seq, 0, 1, seq,
+		}
+	}
+	go func() {
+		remote.Write(record(0, 5))
+		remote.Write(record(1, 0))
+	}()
+
+	c := DTLSClient(newPacketAdaptor(local), &Config{})
+	if _, _, err := c.dtlsDoReadRecord(recordTypeApplicationData); err != nil {
+		t.Fatalf("dtlsDoReadRecord failed in epoch 0: %s", err)
+	}
+
+	// The sequence number restarts at zero in the new epoch, which must
+	// not be mistaken for a sequence number going backwards.
+	c.in.incEpoch()
+	if _, _, err := c.dtlsDoReadRecord(recordTypeApplicationData); err != nil {
+		t.Fatalf("dtlsDoReadRecord failed in epoch 1: %s", err)
+	}
+	if epoch := binary.BigEndian.Uint16(c.in.seq[:2]); epoch != 1 {
+		t.Errorf("read epoch %d, wanted 1", epoch)
+	}
+}
+
+func TestBufferNextEpochRecords(t *testing.T) {
+	record := func(typ recordType, epoch, seq, body byte) []byte {
+		return []byte{byte(typ), 0xfe, 0xfd, 0, epoch, 0, 0, 0, 0, 0, seq, 0, 1, body}
+	}
+	packet := func(records ...[]byte) []byte {
+		var ret []byte
+		for _, r := range records {
+			ret = append(ret, r...)
+		}
+		return append([]byte{opcodePacket, 0, 0, 0, byte(len(ret))}, ret...)
+	}
+
+	for _, buffer := range []bool{false, true} {
+		local, remote := net.Pipe()
+
+		// The epoch 1 record is reordered before the ChangeCipherSpec
+		// and Finished which establish epoch 1.
+		go func() {
+			remote.Write(packet(record(recordTypeApplicationData, 1, 1, 'x')))
+			remote.Write(packet(record(recordTypeChangeCipherSpec, 0, 0, 1), record(recordTypeHandshake, 1, 0, 'f')))
+		}()
+		// Discard any alert sent in response.
+		go io.Copy(ioutil.Discard, remote)
+
+		config := &Config{
+			Bugs: ProtocolBugs{
+				BufferNextEpochRecords: buffer,
+			},
+		}
+		c := DTLSClient(newPacketAdaptor(local), config)
+		typ, _, err := c.dtlsDoReadRecord(recordTypeChangeCipherSpec)
+		if !buffer {
+			if err == nil {
+				t.Errorf("dtlsDoReadRecord unexpectedly accepted a record from the next epoch")
+			}
+			local.Close()
+			remote.Close()
+			continue
+		}
+		if err != nil {
+			t.Fatalf("dtlsDoReadRecord failed: %s", err)
+		}
+		if typ != recordTypeChangeCipherSpec {
+			t.Errorf("dtlsDoReadRecord returned record type %d, wanted %d", typ, recordTypeChangeCipherSpec)
+		}
+
+		// Once the epoch changes, the Finished is read, followed by the
+		// buffered record.
+		c.in.incEpoch()
+		for _, want := range []struct {
+			typ  recordType
+			body string
+		}{
+			{recordTypeHandshake, "f"},
+			{recordTypeApplicationData, "x"},
+		} {
+			typ, b, err := c.dtlsDoReadRecord(want.typ)
+			if err != nil {
+				t.Fatalf("dtlsDoReadRecord failed in epoch 1: %s", err)
+			}
+			if body := string(b.data[b.off:]); typ != want.typ || body != want.body {
+				t.Errorf("dtlsDoReadRecord returned record type %d with %q, wanted %d with %q", typ, body, want.typ, want.body)
+			}
+		}
+		local.Close()
+		remote.Close()
+	}
+}
+
+func TestDTLSZeroLengthRecord(t *testing.T) {
+	recorder := new(packetRecorder)
+	c := DTLSClient(recorder, &Config{})
+	c.out.incEpoch()
+	if err := c.dtlsWriteZeroLengthRecord(recordTypeApplicationData); err != nil {
+		t.Fatalf("dtlsWriteZeroLengthRecord failed: %s", err)
+	}
+	if _, err := c.dtlsWriteRecord(recordTypeApplicationData, []byte("hello")); err != nil {
+		t.Fatalf("dtlsWriteRecord failed: %s", err)
+	}
+
+	if len(recorder.packets) != 2 {
+		t.Fatalf("wrote %d packets, wanted 2", len(recorder.packets))
+	}
+	record := recorder.packets[0]
+	if len(record) != dtlsRecordHeaderLen {
+		t.Errorf("zero-length record was %d bytes, wanted %d", len(record), dtlsRecordHeaderLen)
+	}
+	if epoch := binary.BigEndian.Uint16(record[3:5]); epoch != 1 {
+		t.Errorf("zero-length record was in epoch %d, wanted 1", epoch)
+	}
+
+	// The following record must not reuse the sequence number.
+	for i, want := range []uint64{1 << 48, 1<<48 | 1} {
+		if seq := binary.BigEndian.Uint64(recorder.packets[i][3:11]); seq != want {
+			t.Errorf("packet %d has epoch and sequence number %x, wanted %x", i, seq, want)
+		}
+	}
+}
+
+func TestSkipStaleFragments(t *testing.T) {
+	local, remote := net.Pipe()
+	defer local.Close()
+	defer remote.Close()
+	go io.Copy(ioutil.Discard, remote)
+
+	fragment := func(seq byte) []byte {
+		return []byte{typeServerHello, 0, 0, 1, 0, seq, 0, 0, 0, 0, 0, 1, seq}
+	}
+
+	c := DTLSClient(newPacketAdaptor(local), &Config{})
+	// Message 0 was processed and message 1 was dropped, so the
+	// retransmitted flight repeats message 0.
+	c.recvHandshakeSeq = 1
+	c.skipStaleFragments = true
+	c.hand.Write(fragment(0))
+	c.hand.Write(fragment(1))
+	msg, err := c.dtlsReadHandshakeMessage()
+	if err != nil {
+		t.Fatalf("dtlsReadHandshakeMessage failed: %s", err)
+	}
+	if want := []byte{typeServerHello, 0, 0, 1, 1}; !bytes.Equal(msg, want) {
+		t.Errorf("dtlsReadHandshakeMessage returned %x, wanted %x", msg, want)
+	}
+	if c.skipStaleFragments {
+		t.Errorf("skipStaleFragments was not cleared after the dropped message was received")
+	}
+
+	// Later flights are checked as normal.
+	c.hand.Write(fragment(0))
+	if _, err := c.dtlsReadHandshakeMessage(); err == nil {
+		t.Errorf("dtlsReadHandshakeMessage accepted a stale fragment in a later flight")
+	}
+}
@@ -29,13 +29,38 @@ const opcodeTimeoutAck = byte('t')
 type packetAdaptor struct {
 	net.Conn
 	debug *recordingConn
+	stats packetAdaptorStats
+}
+
+// packetAdaptorStats counts the traffic seen by a packetAdaptor.
+type packetAdaptorStats struct {
+	// PacketsSent is the number of packets written to the peer.
+	PacketsSent int
+	// PacketsReceived is the number of packets read from the peer,
+	// excluding those dropped.
+	PacketsReceived int
+	// PacketsDropped is the number of packets from the peer which were
+	// dropped by simulated timeouts.
+	PacketsDropped int
+	// Timeouts is the number of simulated read timeouts, including those
+	// in RetransmitsRequested.
+	Timeouts int
+	// RetransmitsRequested is the number of times the runner dropped a
+	// handshake message and simulated a timeout so that the peer would
+	// retransmit its flight.
+	RetransmitsRequested int
 }
 
 // newPacketAdaptor wraps a reliable streaming net.Conn into a reliable
 // packet-based net.Conn. The stream contains packets and control commands,
 // distinguished by a one byte opcode.
 func newPacketAdaptor(conn net.Conn) *packetAdaptor {
-	return &packetAdaptor{conn, nil}
+	return &packetAdaptor{Conn: conn}
+}
+
+// Stats returns counts of the packets and timeouts seen so far.
+func (p *packetAdaptor) Stats() packetAdaptorStats {
+	return p.stats
 }
 
 func (p *packetAdaptor) log(message string, data []byte) {
@@ -78,6 +103,7 @@ func (p *packetAdaptor) Read(b []byte) (int, error) {
 	if err != nil {
 		return 0, err
 	}
+	p.stats.PacketsReceived++
 	return copy(b, out), nil
 }
 
@@ -89,6 +115,7 @@ func (p *packetAdaptor) Write(b []byte) (int, error) {
 	if _, err := p.Conn.Write(payload); err != nil {
 		return 0, err
 	}
+	p.stats.PacketsSent++
 	return len(b), nil
 }
 
@@ -104,6 +131,7 @@ func (p *packetAdaptor) SendReadTimeout(d time.Duration) ([][]byte, error) {
 	if _, err := p.Conn.Write(payload); err != nil {
 		return nil, err
 	}
+	p.stats.Timeouts++
 
 	var packets [][]byte
 	for {
@@ -123,6 +151,7 @@ func (p *packetAdaptor) SendReadTimeout(d time.Duration) ([][]byte, error) {
 				return nil, err
 			}
 			p.log("Simulating dropped packet", packet)
+			p.stats.PacketsDropped++
 			packets = append(packets, packet)
 		default:
 			return nil, fmt.Errorf("unexpected opcode '%d'", opcode)
@@ -0,0 +1,137 @@
+// Copyright (c) 2026, Google Inc.
+//
+// Permission to use, copy, modify, and/or distribute this software for any
+// purpose with or without fee is hereby granted, provided that the above
+// copyright notice and this permission notice appear in all copies.
+//
+// THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+// WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+// MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR ANY
+// SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+// WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN ACTION
+// OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF OR IN
+// CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+
+package runner
+
+import (
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+// fakeShim plays the C side of a packetAdaptor. Each time it sees a timeout,
+// it sends dropsPerTimeout packets followed by a timeout ACK.
+func fakeShim(conn net.Conn, dropsPerTimeout int, done chan<- error) {
+	done <- func() error {
+		for {
+			var opcode [1]byte
+			if _, err := io.ReadFull(conn, opcode[:]); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+
+			switch opcode[0] {
+			case opcodePacket:
+				var length uint32
+				if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+					return err
+				}
+				if _, err := io.CopyN(ioutil.Discard, conn, int64(length)); err != nil {
+					return err
+				}
+
+			case opcodeTimeout:
+				var nanoseconds [8]byte
+				if _, err := io.ReadFull(conn, nanoseconds[:]); err != nil {
+					return err
+				}
+				for i := 0; i < dropsPerTimeout; i++ {
+					packet := []byte{opcodePacket, 0, 0, 0, 1, byte(i)}
+					if _, err := conn.Write(packet); err != nil {
+						return err
+					}
+				}
+				if _, err := conn.Write([]byte{opcodeTimeoutAck}); err != nil {
+					return err
+				}
+			}
+		}
+	}()
+}
+
+func TestPacketAdaptorStats(t *testing.T) {
+	const (
+		packets         = 10
+		timeouts        = 3
+		dropsPerTimeout = 2
+	)
+
+	local, remote := net.Pipe()
+	done := make(chan error, 1)
+	go fakeShim(remote, dropsPerTimeout, done)
+
+	p := newPacketAdaptor(local)
+	for i := 0; i < packets; i++ {
+		if _, err := p.Write([]byte{byte(i)}); err != nil {
+			t.Fatalf("Write failed: %s", err)
+		}
+	}
+	for i := 0; i < timeouts; i++ {
+		dropped, err := p.SendReadTimeout(time.Second)
+		if err != nil {
+			t.Fatalf("SendReadTimeout failed: %s", err)
+		}
+		if len(dropped) != dropsPerTimeout {
+			t.Errorf("SendReadTimeout returned %d packets, wanted %d", len(dropped), dropsPerTimeout)
+		}
+	}
+	local.Close()
+	if err := <-done; err != nil {
+		t.Fatalf("fake shim failed: %s", err)
+	}
+
+	want := packetAdaptorStats{
+		PacketsSent:    packets,
+		PacketsDropped: timeouts * dropsPerTimeout,
+		Timeouts:       timeouts,
+	}
+	if got := p.Stats(); got != want {
+		t.Errorf("Stats() = %+v, wanted %+v", got, want)
+	}
+}
+
+func TestPacketAdaptorRetransmitStats(t *testing.T) {
+	const retransmits = 3
+
+	local, remote := net.Pipe()
+	done := make(chan error, 1)
+	go fakeShim(remote, 0, done)
+
+	p := newPacketAdaptor(local)
+	c := DTLSClient(p, &Config{Bugs: ProtocolBugs{PacketAdaptor: p}})
+	for i := 0; i < retransmits; i++ {
+		// Pretend the message just read is being dropped.
+		c.recvHandshakeSeq = 1
+		if err := c.dtlsRequestRetransmit(); err != nil {
+			t.Fatalf("dtlsRequestRetransmit failed: %s", err)
+		}
+	}
+	local.Close()
+	if err := <-done; err != nil {
+		t.Fatalf("fake shim failed: %s", err)
+	}
+
+	want := packetAdaptorStats{
+		Timeouts:             retransmits,
+		RetransmitsRequested: retransmits,
+	}
+	if got := p.Stats(); got != want {
+		t.Errorf("Stats() = %+v, wanted %+v", got, want)
+	}
+}
@@ -721,14 +721,25 @@ func appendTranscript(path string, data []byte) error {
 }
 
 // A timeoutConn implements an idle timeout on each Read and Write operation.
+// A read deadline set by the caller applies if it is earlier.
 type timeoutConn struct {
 	net.Conn
-	timeout time.Duration
+	timeout      time.Duration
+	readDeadline time.Time
+}
+
+func (t *timeoutConn) SetReadDeadline(deadline time.Time) error {
+	t.readDeadline = deadline
+	return t.Conn.SetReadDeadline(deadline)
 }
 
 func (t *timeoutConn) Read(b []byte) (int, error) {
 	if !*useGDB {
-		if err := t.SetReadDeadline(time.Now().Add(t.timeout)); err != nil {
+		deadline := time.Now().Add(t.timeout)
+		if !t.readDeadline.IsZero() && t.readDeadline.Before(deadline) {
+			deadline = t.readDeadline
+		}
+		if err := t.Conn.SetReadDeadline(deadline); err != nil {
 			return 0, err
 		}
 	}
@@ -772,7 +783,7 @@ func doExchange(test *testCase, config *Config, conn net.Conn, isResume bool, tr
 	}
 
 	if !useDebugger() {
-		conn = &timeoutConn{conn, *idleTimeout}
+		conn = &timeoutConn{Conn: conn, timeout: *idleTimeout}
 	}
 
 	if test.protocol == dtls {
@@ -1864,6 +1875,19 @@ NextTest:
 	return splitHandshakeTests
 }
 
+// dropHandshakeMessageOnce returns a DropHandshakeMessage callback which drops
+// the first handshake message of type typ.
+func dropHandshakeMessageOnce(typ uint8) func(uint8, []byte) bool {
+	dropped := false
+	return func(msgType uint8, body []byte) bool {
+		if dropped || msgType != typ {
+			return false
+		}
+		dropped = true
+		return true
+	}
+}
+
 func addBasicTests() {
 	basicTests := []testCase{
 		{
@@ -2438,6 +2462,43 @@ read alert 1 0
 			shouldFail:    true,
 			expectedError: ":UNEXPECTED_RECORD:",
 		},
+		{
+			// A plaintext record from epoch zero after the handshake
+			// must be discarded rather than processed.
+			protocol: dtls,
+			name:     "EpochZeroRecordAfterHandshake-DTLS10",
+			config: Config{
+				MaxVersion: VersionTLS10,
+				Bugs: ProtocolBugs{
+					SendEpochZeroRecord: []byte("plaintext"),
+				},
+			},
+		},
+		{
+			// A plaintext record from epoch zero after the handshake
+			// must be discarded rather than processed.
+			protocol: dtls,
+			name:     "EpochZeroRecordAfterHandshake-DTLS12",
+			config: Config{
+				MaxVersion: VersionTLS12,
+				Bugs: ProtocolBugs{
+					SendEpochZeroRecord: []byte("plaintext"),
+				},
+			},
+		},
+		{
+			// BoringSSL does not implement heartbeats and must reject
+			// heartbeat records.
+			protocol: dtls,
+			name:     "SendHeartbeatRequest-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					SendHeartbeatRequest: []byte("heartbeat"),
+				},
+			},
+			shouldFail:    true,
+			expectedError: ":UNEXPECTED_RECORD:",
+		},
 		{
 			name: "FalseStart-SkipServerSecondLeg",
 			config: Config{
@@ -2558,6 +2619,134 @@ read alert 1 0
 			shouldFail:    true,
 			expectedError: ":FRAGMENT_MISMATCH:",
 		},
+		{
+			protocol: dtls,
+			name:     "WrongMessageSeq-Finished-TooHigh-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					WrongMessageSeqType:  typeFinished,
+					WrongMessageSeqDelta: 1,
+				},
+			},
+			shouldFail:    true,
+			expectedError: ":UNEXPECTED_RECORD:",
+		},
+		{
+			protocol: dtls,
+			name:     "WrongMessageSeq-Finished-TooLow-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					WrongMessageSeqType:  typeFinished,
+					WrongMessageSeqDelta: -1,
+				},
+			},
+			shouldFail:    true,
+			expectedError: ":UNEXPECTED_RECORD:",
+		},
+		{
+			protocol: dtls,
+			testType: serverTest,
+			name:     "SeparateClientHelloFragments-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					MaxHandshakeRecordLength:     32,
+					PackHandshakeFragments:       2000,
+					PackHandshakeRecords:         1500,
+					SeparateClientHelloFragments: true,
+				},
+			},
+		},
+		{
+			protocol: dtls,
+			name:     "SendOutOfRangeFragment-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					SendOutOfRangeFragment: true,
+				},
+			},
+			shouldFail:    true,
+			expectedError: ":EXCESSIVE_MESSAGE_SIZE:",
+		},
+		{
+			// Trailing data which is too short to be a record header is
+			// silently dropped.
+			protocol: dtls,
+			name:     "TrailingPacketData-Short-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					TrailingPacketData: []byte{1, 2, 3, 4, 5},
+				},
+			},
+		},
+		{
+			// Trailing data which claims to be a record longer than the
+			// rest of the packet is silently dropped.
+			protocol: dtls,
+			name:     "TrailingPacketData-TruncatedRecord-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					TrailingPacketData: []byte{
+						byte(recordTypeApplicationData), 0xfe, 0xfd,
+						0, 1, 0, 0, 0, 0, 0, 0,
+						0x01, 0x00,
+						1, 2, 3, 4,
+					},
+				},
+			},
+		},
+		{
+			// Records within a packet may be in descending sequence
+			// number order.
+			protocol: dtls,
+			name:     "ReverseRecordsInPacket-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					MaxHandshakeRecordLength: 32,
+					PackHandshakeRecords:     1500,
+					ReverseRecordsInPacket:   true,
+				},
+			},
+		},
+		{
+			// Packets larger than the MTU are accepted. The MTU only
+			// limits what the shim sends.
+			protocol: dtls,
+			name:     "OversizedPacket-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					PadPacketsToLength: 1024,
+				},
+			},
+			flags: []string{"-mtu", "256"},
+		},
+		{
+			// The shim reads each packet into a buffer sized for one
+			// maximum-length record, so longer packets are truncated
+			// there, independent of the MTU. The records which fit
+			// are processed and the truncated padding dropped.
+			protocol: dtls,
+			name:     "OversizedPacket-BeyondReadBuffer-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					PadPacketsToLength: 20000,
+				},
+			},
+			flags: []string{"-mtu", "256"},
+		},
+		{
+			// Drop the server's Certificate once. The runner simulates
+			// a timeout and the handshake should complete with the
+			// retransmitted flight.
+			protocol: dtls,
+			testType: serverTest,
+			name:     "DropHandshakeMessage-Certificate-DTLS",
+			config: Config{
+				MaxVersion: VersionTLS12,
+				Bugs: ProtocolBugs{
+					DropHandshakeMessage: dropHandshakeMessageOnce(typeCertificate),
+				},
+			},
+		},
 		{
 			protocol: dtls,
 			name:     "SplitFragments-Header-DTLS",
@@ -2776,6 +2965,32 @@ read alert 1 0
 			name:             "SendEmptyRecords-Pass",
 			sendEmptyRecords: 32,
 		},
+		{
+			protocol:         dtls,
+			name:             "SendEmptyRecords-DTLS",
+			sendEmptyRecords: 1,
+		},
+		{
+			// A record with no ciphertext cannot be authenticated
+			// and must be discarded.
+			protocol: dtls,
+			name:     "SendZeroLengthRecord-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					SendZeroLengthRecord: true,
+				},
+			},
+		},
+		{
+			protocol: dtls,
+			name:     "SendEmptyRecords-MaxSendPlaintext-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					MaxSendPlaintext: 512,
+				},
+			},
+			sendEmptyRecords: 1,
+		},
 		{
 			name:             "SendEmptyRecords",
 			sendEmptyRecords: 33,
@@ -3425,6 +3640,47 @@ read alert 1 0
 			shouldFail:         true,
 			expectedLocalError: "local error: record overflow",
 		},
+		{
+			// Both sides of a DTLS connection keep within a maximum
+			// fragment length of 512 bytes. The shim is the client, so
+			// its handshake records are small, and it echoes each
+			// record it reads.
+			protocol: dtls,
+			name:     "MaxFragmentLength-512-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					MaxSendPlaintext:    512,
+					MaxReceivePlaintext: 512,
+				},
+			},
+			messageLen: 1024,
+		},
+		{
+			protocol: dtls,
+			name:     "MaxFragmentLength-1024-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					MaxSendPlaintext:    1024,
+					MaxReceivePlaintext: 1024,
+				},
+			},
+			messageLen: 2048,
+		},
+		{
+			// Records larger than the maximum fragment length are
+			// rejected.
+			protocol: dtls,
+			name:     "MaxFragmentLength-TooLarge-DTLS",
+			config: Config{
+				Bugs: ProtocolBugs{
+					MaxSendPlaintext:    1024,
+					MaxReceivePlaintext: 512,
+				},
+			},
+			messageLen:         2048,
+			shouldFail:         true,
+			expectedLocalError: "local error: record overflow",
+		},
 		{
 			// Test that handshake data is tightly packed in TLS 1.3.
 			testType: serverTest,
@@ -9883,6 +10139,22 @@ func addDTLSReplayTests() {
 		messageCount: 200,
 		replayWrites: true,
 	})
+
+	// Test that a record behind the highest sequence number seen, but
+	// within the replay window, is accepted if it has not been seen, and
+	// that a replay of the highest record is then rejected. Were the
+	// replay accepted, the shim would echo it in place of a later
+	// message.
+	testCases = append(testCases, testCase{
+		protocol: dtls,
+		name:     "DTLS-Replay-WithinWindow",
+		config: Config{
+			Bugs: ProtocolBugs{
+				ReplayWindowGap: 10,
+			},
+		},
+		messageCount: 4,
+	})
 }
 
 var testSignatureAlgorithms = []struct {
@@ -15332,6 +15604,21 @@ func addRecordVersionTests() {
 			expectedError: ":WRONG_VERSION_NUMBER:",
 		})
 	}
+
+	// Test that the DTLS ClientHello may use any record version with the
+	// DTLS major version. The runner does not implement DTLS 1.3, where
+	// the record version is ignored entirely, so this covers the DTLS 1.2
+	// compatibility rule for the initial flight.
+	testCases = append(testCases, testCase{
+		protocol: dtls,
+		testType: serverTest,
+		name:     "LooseInitialRecordVersion-DTLS",
+		config: Config{
+			Bugs: ProtocolBugs{
+				SendInitialRecordVersion: 0xfe00,
+			},
+		},
+	})
 }
 
 func addCertificateTests() {
@@ -39,6 +39,12 @@ type inputFile struct {
 	// isArchive indicates that the input should be processed as an ar
 	// file.
 	isArchive bool
+	// coff indicates that the input is COFF (Windows) assembly, enabling
+	// the grammar rules for SEH, COFF sections and symbol descriptors.
+	coff bool
+	// strictCFI indicates that unmatched .cfi_startproc and .cfi_endproc
+	// directives in the input are errors.
+	strictCFI bool
 	// contents contains the contents of the file.
 	contents string
 	// ast points to the head of the syntax tree.
@@ -66,6 +72,9 @@ type delocation struct {
 
 	// symbols is the set of symbols defined in the module.
 	symbols map[string]struct{}
+	// aliases maps from a symbol defined with .set to the symbol in the
+	// module that it aliases. E.g. “foo_alias” -> “foo”.
+	aliases map[string]string
 	// localEntrySymbols is the set of symbols with .localentry directives.
 	localEntrySymbols map[string]struct{}
 	// redirectors maps from out-call symbol name to the name of a
@@ -143,12 +152,20 @@ func (d *delocation) processInput(input inputFile) (err error) {
 		}
 
 		switch node.pegRule {
-		case ruleGlobalDirective, ruleComment, ruleLocationDirective:
+		case ruleGlobalDirective, ruleComment, ruleLocationDirective, ruleGnuAttributeDirective, ruleAttributeDirective, ruleSEHDirective, ruleCOFFDefDirective, ruleInlineAsmMarker, ruleDiagnosticDirective, ruleInsnDirective, ruleIdentDirective, ruleSubsectionDirective, ruleVariantPCSDirective, ruleLiteralPoolDirective, ruleBundleDirective, ruleIncbinDirective, ruleMIPSSetDirective, ruleMachineDirective:
 			d.writeNode(statement)
+		case ruleCFIDirective:
+			statement, err = d.processCFIDirective(statement, node.up)
 		case ruleDirective:
 			statement, err = d.processDirective(statement, node.up)
+		case ruleCOFFSectionDirective:
+			statement, err = d.processCOFFSectionDirective(statement, node.up)
 		case ruleLabelContainingDirective:
 			statement, err = d.processLabelContainingDirective(statement, node.up)
+		case ruleEquDirective:
+			statement, err = d.processEquDirective(statement, node.up)
+		case ruleRelocDirective:
+			statement, err = d.processRelocDirective(statement, node.up)
 		case ruleLabel:
 			statement, err = d.processLabel(statement, node.up)
 		case ruleInstruction:
@@ -211,64 +228,87 @@ func (d *delocation) processDirective(statement, directive *node32) (*node32, er
 		return nil, errors.New(".data section found in module")
 
 	case "section":
-		section := args[0]
-
-		if section == ".data.rel.ro" {
-			// In a normal build, this is an indication of a
-			// problem but any references from the module to this
-			// section will result in a relocation and thus will
-			// break the integrity check. ASAN can generate these
-			// sections and so we will likely have to work around
-			// that in the future.
-			return nil, errors.New(".data.rel.ro section found in module")
-		}
-
-		sectionType, ok := sectionType(section)
-		if !ok {
-			// Unknown sections are permitted in order to be robust
-			// to different compiler modes.
-			d.writeNode(statement)
-			break
-		}
-
-		switch sectionType {
-		case ".rodata", ".text":
-			// Move .rodata to .text so it may be accessed without
-			// a relocation. GCC with -fmerge-constants will place
-			// strings into separate sections, so we move all
-			// sections named like .rodata. Also move .text.startup
-			// so the self-test function is also in the module.
-			d.writeCommentedNode(statement)
-			d.output.WriteString(".text\n")
-
-		case ".data":
-			// See above about .data
-			return nil, errors.New(".data section found in module")
-
-		case ".init_array", ".fini_array", ".ctors", ".dtors":
-			// init_array/ctors/dtors contains function
-			// pointers to constructor/destructor
-			// functions. These contain relocations, but
-			// they're in a different section anyway.
-			d.writeNode(statement)
-			break
+		return d.processSection(statement, args[0])
 
-		case ".debug", ".note", ".toc":
-			d.writeNode(statement)
-			break
+	default:
+		d.writeNode(statement)
+	}
 
-		case ".bss":
-			d.writeNode(statement)
-			return d.handleBSS(statement)
-		}
+	return statement, nil
+}
 
-	default:
+// processSection handles a directive that switches to the section named
+// section.
+func (d *delocation) processSection(statement *node32, section string) (*node32, error) {
+	if section == ".data.rel.ro" {
+		// In a normal build, this is an indication of a
+		// problem but any references from the module to this
+		// section will result in a relocation and thus will
+		// break the integrity check. ASAN can generate these
+		// sections and so we will likely have to work around
+		// that in the future.
+		return nil, errors.New(".data.rel.ro section found in module")
+	}
+
+	sectionType, ok := sectionType(section)
+	if !ok {
+		// Unknown sections are permitted in order to be robust
+		// to different compiler modes.
 		d.writeNode(statement)
+		return statement, nil
+	}
+
+	switch sectionType {
+	case ".rodata", ".rdata", ".text":
+		// Move .rodata to .text so it may be accessed without
+		// a relocation. GCC with -fmerge-constants will place
+		// strings into separate sections, so we move all
+		// sections named like .rodata. Also move .text.startup
+		// so the self-test function is also in the module.
+		// (.rdata is the COFF equivalent of .rodata.)
+		d.writeCommentedNode(statement)
+		d.output.WriteString(".text\n")
+
+	case ".data":
+		// See above about .data
+		return nil, errors.New(".data section found in module")
+
+	case ".init_array", ".fini_array", ".ctors", ".dtors":
+		// init_array/ctors/dtors contains function
+		// pointers to constructor/destructor
+		// functions. These contain relocations, but
+		// they're in a different section anyway.
+		d.writeNode(statement)
+		break
+
+	case ".debug", ".note", ".toc", ".pdata", ".xdata":
+		// .pdata and .xdata hold COFF unwind information.
+		d.writeNode(statement)
+		break
+
+	case ".bss":
+		d.writeNode(statement)
+		return d.handleBSS(statement)
 	}
 
 	return statement, nil
 }
 
+func (d *delocation) processCOFFSectionDirective(statement, directive *node32) (*node32, error) {
+	assertNodeType(directive, ruleWS)
+	name := directive.next
+	assertNodeType(name, ruleCOFFSectionName)
+	section := d.contents(name)
+
+	// The linker merges grouped COFF sections, e.g. “.text$mn”, into the
+	// section named by the part before the dollar sign.
+	if i := strings.IndexByte(section, '$'); i != -1 {
+		section = section[:i]
+	}
+
+	return d.processSection(statement, section)
+}
+
 func (d *delocation) processLabelContainingDirective(statement, directive *node32) (*node32, error) {
 	// The symbols within directives need to be mapped so that local
 	// symbols in two different .s inputs don't collide.
@@ -285,24 +325,218 @@ func (d *delocation) processLabelContainingDirective(statement, directive *node3
 	var args []string
 	for node = skipWS(node.up); node != nil; node = skipWS(node.next) {
 		assertNodeType(node, ruleSymbolArg)
-		arg := node.up
-		var mapped string
-
-		for term := arg; term != nil; term = term.next {
-			if term.pegRule != ruleLocalSymbol {
-				mapped += d.contents(term)
-				continue
+		mapped, argChanged := d.mapSymbolArg(node)
+		if name == ".type" && len(args) == 1 && node.up.pegRule == ruleOffset && node.up.next == nil {
+			// Symbol types may be given numerically. Write them
+			// out by name, which all assemblers accept.
+			var err error
+			if mapped, err = d.symbolTypeName(mapped); err != nil {
+				return nil, err
 			}
+			argChanged = true
+		}
+		if argChanged {
+			changed = true
+		}
+		args = append(args, mapped)
+	}
+
+	if !changed {
+		d.writeNode(statement)
+	} else {
+		d.writeCommentedNode(statement)
+		d.output.WriteString("\t" + name + "\t" + strings.Join(args, ", ") + "\n")
+	}
+
+	if name == ".localentry" {
+		d.output.WriteString(localEntryName(args[0]) + ":\n")
+	}
+
+	if target, ok := d.aliases[args[0]]; ok && name == ".set" {
+		// References to the alias use its local target, so define
+		// that too.
+		d.output.WriteString("\t.set\t" + localTargetName(args[0]) + ", " + localTargetName(target) + "\n")
+	}
 
-			oldSymbol := d.contents(term)
-			newSymbol := d.mapLocalSymbol(oldSymbol)
-			if newSymbol != oldSymbol {
+	return statement, nil
+}
+
+// symbolTypeNames maps ELF symbol type values to their names in a .type
+// directive.
+var symbolTypeNames = map[int64]string{
+	0:  "notype",
+	1:  "object",
+	2:  "function",
+	5:  "common",
+	6:  "tls_object",
+	10: "gnu_indirect_function", // STT_GNU_IFUNC
+}
+
+// symbolTypeName returns the named form of the numeric symbol type value.
+func (d *delocation) symbolTypeName(value string) (string, error) {
+	n, err := strconv.ParseInt(value, 0, 64)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse symbol type %q: %s", value, err)
+	}
+	name, ok := symbolTypeNames[n]
+	if !ok {
+		return "", fmt.Errorf("unknown symbol type %d", n)
+	}
+
+	// AArch64 assembly conventionally uses “%” rather than “@”.
+	if d.processor == aarch64 {
+		return "%" + name, nil
+	}
+	return "@" + name, nil
+}
+
+// mapSymbolArg returns the contents of a SymbolArg node with any local symbols
+// mapped, and whether that changed anything.
+func (d *delocation) mapSymbolArg(arg *node32) (string, bool) {
+	var mapped string
+	changed := false
+
+	for term := arg.up; term != nil; term = term.next {
+		if term.pegRule != ruleLocalSymbol {
+			mapped += d.contents(term)
+			continue
+		}
+
+		oldSymbol := d.contents(term)
+		newSymbol := d.mapLocalSymbol(oldSymbol)
+		if newSymbol != oldSymbol {
+			changed = true
+		}
+
+		mapped += newSymbol
+	}
+
+	return mapped, changed
+}
+
+func (d *delocation) processEquDirective(statement, directive *node32) (*node32, error) {
+	// .equ and .equiv define symbols, which need to be mapped as labels
+	// are.
+	assertNodeType(directive, ruleEquDirectiveName)
+	name := d.contents(directive)
+
+	node := skipWS(directive.next)
+	symbol := d.contents(node)
+	changed := false
+	switch node.pegRule {
+	case ruleLocalSymbol:
+		if mapped := d.mapLocalSymbol(symbol); mapped != symbol {
+			symbol = mapped
+			changed = true
+		}
+	case ruleSymbolName:
+		break
+	default:
+		return nil, fmt.Errorf("unknown %s symbol type %q", name, rul3s[node.pegRule])
+	}
+
+	node = skipWS(node.next)
+	assertNodeType(node, ruleSymbolArg)
+	value, valueChanged := d.mapSymbolArg(node)
+	if valueChanged {
+		changed = true
+	}
+
+	if !changed {
+		d.writeNode(statement)
+	} else {
+		d.writeCommentedNode(statement)
+		d.output.WriteString("\t" + name + "\t" + symbol + ", " + value + "\n")
+	}
+
+	return statement, nil
+}
+
+func (d *delocation) processRelocDirective(statement, arg *node32) (*node32, error) {
+	// The offset and target of a .reloc may refer to symbols, which need to
+	// be mapped to their local versions.
+	changed := false
+	var args []string
+	for node := skipWS(arg); node != nil; node = skipWS(node.next) {
+		if node.pegRule == ruleRelocType {
+			args = append(args, d.contents(node))
+			continue
+		}
+
+		mapped, argChanged := d.mapRelocArg(node)
+		if argChanged {
+			changed = true
+		}
+		args = append(args, mapped)
+	}
+
+	if !changed {
+		d.writeNode(statement)
+	} else {
+		d.writeCommentedNode(statement)
+		d.output.WriteString("\t.reloc " + strings.Join(args, ", ") + "\n")
+	}
+
+	return statement, nil
+}
+
+// mapRelocArg returns the contents of the offset or target of a .reloc with
+// any symbols in the module mapped, and whether that changed anything. Other
+// symbols are left for the linker, e.g. so that a BFD_RELOC_NONE can keep an
+// external symbol live.
+func (d *delocation) mapRelocArg(arg *node32) (string, bool) {
+	var mapped string
+	changed := false
+
+	for term := arg.up; term != nil; term = term.next {
+		symbol := d.contents(term)
+		switch term.pegRule {
+		case ruleLocalSymbol:
+			if newSymbol := d.mapLocalSymbol(symbol); newSymbol != symbol {
+				symbol = newSymbol
+				changed = true
+			}
+		case ruleSymbolName:
+			if _, knownSymbol := d.symbols[symbol]; knownSymbol {
+				symbol = localTargetName(symbol)
 				changed = true
 			}
+		}
+		mapped += symbol
+	}
 
-			mapped += newSymbol
+	return mapped, changed
+}
+
+func (d *delocation) processCFIDirective(statement, directive *node32) (*node32, error) {
+	switch directive.pegRule {
+	case ruleCFIEscapeDirective:
+		break
+	case ruleCFIValEncodedAddrDirective:
+		return d.processCFIValEncodedAddrDirective(statement, directive)
+	case ruleCFILabelDirective:
+		return d.processCFILabelDirective(statement, directive)
+	default:
+		d.writeNode(statement)
+		return statement, nil
+	}
+
+	// The bytes of a .cfi_escape may be computed from local symbols,
+	// which need to be mapped.
+	changed := false
+	var args []string
+	for node := skipWS(directive.up); node != nil; node = skipWS(node.next) {
+		assertNodeType(node, ruleCFIEscapeArg)
+		arg := node.up
+		if arg.pegRule != ruleSymbolArg {
+			args = append(args, d.contents(arg))
+			continue
 		}
 
+		mapped, argChanged := d.mapSymbolArg(arg)
+		if argChanged {
+			changed = true
+		}
 		args = append(args, mapped)
 	}
 
@@ -310,16 +544,135 @@ func (d *delocation) processLabelContainingDirective(statement, directive *node3
 		d.writeNode(statement)
 	} else {
 		d.writeCommentedNode(statement)
-		d.output.WriteString("\t" + name + "\t" + strings.Join(args, ", ") + "\n")
+		d.output.WriteString("\t.cfi_escape " + strings.Join(args, ", ") + "\n")
 	}
 
-	if name == ".localentry" {
-		d.output.WriteString(localEntryName(args[0]) + ":\n")
+	return statement, nil
+}
+
+func (d *delocation) processCFIValEncodedAddrDirective(statement, directive *node32) (*node32, error) {
+	// The symbol operand may be a local symbol, which needs to be mapped.
+	register := skipWS(directive.up)
+	assertNodeType(register, ruleCFIRegister)
+	encoding := skipWS(register.next)
+	assertNodeType(encoding, ruleCFIEncoding)
+	symbol := skipWS(encoding.next)
+	assertNodeType(symbol, ruleSymbolArg)
+
+	mapped, changed := d.mapSymbolArg(symbol)
+	if !changed {
+		d.writeNode(statement)
+	} else {
+		d.writeCommentedNode(statement)
+		d.output.WriteString("\t.cfi_val_encoded_addr " + d.contents(register) + ", " + d.contents(encoding) + ", " + mapped + "\n")
 	}
 
 	return statement, nil
 }
 
+func (d *delocation) processCFILabelDirective(statement, directive *node32) (*node32, error) {
+	// .cfi_label defines a label, so local ones need to be mapped.
+	label := skipWS(directive.up)
+	if label.pegRule != ruleLocalSymbol {
+		d.writeNode(statement)
+		return statement, nil
+	}
+
+	symbol := d.contents(label)
+	if mapped := d.mapLocalSymbol(symbol); mapped != symbol {
+		d.writeCommentedNode(statement)
+		d.output.WriteString("\t.cfi_label " + mapped + "\n")
+	} else {
+		d.writeNode(statement)
+	}
+
+	return statement, nil
+}
+
+// cfiRegion is a function bracketed by .cfi_startproc and .cfi_endproc.
+// start and end are the statements containing those directives, so the
+// function's body is the statements strictly between them.
+type cfiRegion struct {
+	start, end *node32
+}
+
+// cfiRegions returns the regions bracketed by .cfi_startproc and
+// .cfi_endproc in input, in order. A region may switch sections, e.g. with
+// .pushsection or .section and .previous, but must end in the section it
+// started in. It returns an error if the directives are nested. If strict is
+// set, it also returns an error if they are otherwise unbalanced; otherwise
+// unmatched directives are ignored.
+func cfiRegions(input inputFile, strict bool) ([]cfiRegion, error) {
+	var regions []cfiRegion
+	var start *node32
+	// section and previous are the current and previous sections, as
+	// selected by directives in input, and startSection is the value of
+	// section at start. pushed holds the pairs saved by .pushsection.
+	var section, previous, startSection string
+	var pushed [][2]string
+	for statement := input.ast.up; statement != nil; statement = statement.next {
+		assertNodeType(statement, ruleStatement)
+		node := skipWS(statement.up)
+		if node == nil {
+			continue
+		}
+
+		if node.pegRule == ruleDirective {
+			var arg string
+			if args := skipWS(node.up.next); args != nil {
+				assertNodeType(args, ruleArgs)
+				arg = input.contents[args.up.begin:args.up.end]
+			}
+			switch name := input.contents[node.up.begin:node.up.end]; name {
+			case "text", "data", "bss":
+				section, previous = "."+name, section
+			case "section":
+				section, previous = arg, section
+			case "previous":
+				section, previous = previous, section
+			case "pushsection":
+				pushed = append(pushed, [2]string{section, previous})
+				section, previous = arg, section
+			case "popsection":
+				if len(pushed) > 0 {
+					section, previous = pushed[len(pushed)-1][0], pushed[len(pushed)-1][1]
+					pushed = pushed[:len(pushed)-1]
+				}
+			}
+			continue
+		}
+		if node.pegRule != ruleCFIDirective {
+			continue
+		}
+
+		switch node.up.pegRule {
+		case ruleCFIStartProcDirective:
+			if start != nil {
+				return nil, locateError(errors.New("nested .cfi_startproc"), node, input)
+			}
+			start = statement
+			startSection = section
+		case ruleCFIEndProcDirective:
+			if start == nil {
+				if strict {
+					return nil, locateError(errors.New(".cfi_endproc without .cfi_startproc"), node, input)
+				}
+				continue
+			}
+			if section != startSection {
+				return nil, locateError(errors.New(".cfi_endproc in a different section from its .cfi_startproc"), node, input)
+			}
+			regions = append(regions, cfiRegion{start, statement})
+			start = nil
+		}
+	}
+
+	if start != nil && strict {
+		return nil, locateError(errors.New(".cfi_startproc without .cfi_endproc"), skipWS(start.up), input)
+	}
+	return regions, nil
+}
+
 func (d *delocation) processLabel(statement, label *node32) (*node32, error) {
 	symbol := d.contents(label)
 
@@ -494,7 +847,15 @@ func (d *delocation) processAarch64Instruction(statement, instruction *node32) (
 		fullArg := arg
 
 		switch arg.pegRule {
-		case ruleRegisterOrConstant, ruleLocalLabelRef, ruleARMConstantTweak:
+		case ruleRegisterOrConstant, ruleLocalLabelRef, ruleARMConstantTweak, ruleARMPrefetchOp, ruleARMSystemRegister:
+			args = append(args, d.contents(fullArg))
+
+		case ruleARMLiteralPoolOperand:
+			// A symbol in the literal pool would need an absolute
+			// relocation.
+			if arg.up.pegRule != ruleOffset {
+				return nil, fmt.Errorf("literal pool load of symbol %q is not supported", d.contents(arg.up))
+			}
 			args = append(args, d.contents(fullArg))
 
 		case ruleGOTSymbolOffset:
@@ -881,7 +1242,7 @@ Args:
 		}
 
 		switch arg.pegRule {
-		case ruleRegisterOrConstant, ruleLocalLabelRef:
+		case ruleRegisterOrConstant, ruleLocalLabelRef, rulePPCConditionRegister:
 			args = append(args, d.contents(fullArg))
 
 		case ruleTOCRefLow:
@@ -1111,7 +1472,7 @@ func classifyInstruction(instr string, args []*node32) instructionType {
 			return instrConditionalMove
 		}
 
-	case "call", "callq", "jmp", "jo", "jno", "js", "jns", "je", "jz", "jne", "jnz", "jb", "jnae", "jc", "jnb", "jae", "jnc", "jbe", "jna", "ja", "jnbe", "jl", "jnge", "jge", "jnl", "jle", "jng", "jg", "jnle", "jp", "jpe", "jnp", "jpo":
+	case "call", "callq", "jmp", "jmpq", "jo", "jno", "js", "jns", "je", "jz", "jne", "jnz", "jb", "jnae", "jc", "jnb", "jae", "jnc", "jbe", "jna", "ja", "jnbe", "jl", "jnge", "jge", "jnl", "jle", "jng", "jg", "jnle", "jp", "jpe", "jnp", "jpo":
 		if len(args) == 1 {
 			return instrJump
 		}
@@ -1291,6 +1652,14 @@ func (d *delocation) isRIPRelative(node *node32) bool {
 }
 
 func (d *delocation) processIntelInstruction(statement, instruction *node32) (*node32, error) {
+	// Encoding hints and prefixes must be kept if the instruction is
+	// rewritten.
+	var prefixes string
+	for instruction.pegRule == ruleEncodingHint || instruction.pegRule == ruleInstructionPrefix {
+		prefixes += d.contents(instruction) + " "
+		instruction = skipWS(instruction.next)
+	}
+
 	assertNodeType(instruction, ruleInstructionName)
 	instructionName := d.contents(instruction)
 
@@ -1354,6 +1723,13 @@ Args:
 				if _, knownSymbol := d.symbols[symbol]; knownSymbol {
 					symbol = localTargetName(symbol)
 					changed = true
+				} else if isRetpolineThunk(symbol) && classifyInstruction(instructionName, argNodes) == instrJump {
+					// Compilers reference retpoline and return
+					// thunks without @PLT, but they are
+					// out-calls all the same.
+					d.redirectors[symbol+"@PLT"] = redirectorName(symbol)
+					symbol = redirectorName(symbol)
+					changed = true
 				}
 
 			case "PLT":
@@ -1539,6 +1915,10 @@ Args:
 				return nil, fmt.Errorf("movabs of _GLOBAL_OFFSET_TABLE_ didn't expected form")
 			}
 
+			if arg.up == nil {
+				return nil, fmt.Errorf("_GLOBAL_OFFSET_TABLE_ lookup wasn't relative to a local symbol")
+			}
+
 			d.gotDeltaNeeded = true
 			changed = true
 			instructionName = "movq"
@@ -1591,7 +1971,7 @@ Args:
 
 	if changed {
 		d.writeCommentedNode(statement)
-		replacement := "\t" + instructionName + "\t" + strings.Join(args, ", ") + "\n"
+		replacement := "\t" + prefixes + instructionName + "\t" + strings.Join(args, ", ") + "\n"
 		wrappers.do(func() {
 			d.output.WriteString(replacement)
 		})
@@ -1612,7 +1992,7 @@ func (d *delocation) handleBSS(statement *node32) (*node32, error) {
 		}
 
 		switch node.pegRule {
-		case ruleGlobalDirective, ruleComment, ruleInstruction, ruleLocationDirective:
+		case ruleGlobalDirective, ruleComment, ruleInstruction, ruleLocationDirective, ruleCFIDirective, ruleGnuAttributeDirective, ruleAttributeDirective, ruleSEHDirective, ruleCOFFDefDirective, ruleInlineAsmMarker, ruleDiagnosticDirective, ruleInsnDirective, ruleIdentDirective, ruleSubsectionDirective, ruleVariantPCSDirective, ruleLiteralPoolDirective, ruleBundleDirective, ruleIncbinDirective, ruleMIPSSetDirective, ruleMachineDirective:
 			d.writeNode(statement)
 
 		case ruleDirective:
@@ -1624,6 +2004,9 @@ func (d *delocation) handleBSS(statement *node32) (*node32, error) {
 			}
 			d.writeNode(statement)
 
+		case ruleCOFFSectionDirective:
+			return lastStatement, nil
+
 		case ruleLabel:
 			label := node.up
 			d.writeNode(statement)
@@ -1643,6 +2026,20 @@ func (d *delocation) handleBSS(statement *node32) (*node32, error) {
 				return nil, err
 			}
 
+		case ruleEquDirective:
+			var err error
+			statement, err = d.processEquDirective(statement, node.up)
+			if err != nil {
+				return nil, err
+			}
+
+		case ruleRelocDirective:
+			var err error
+			statement, err = d.processRelocDirective(statement, node.up)
+			if err != nil {
+				return nil, err
+			}
+
 		default:
 			return nil, fmt.Errorf("unknown BSS statement type %q in %q", rul3s[node.pegRule], d.contents(statement))
 		}
@@ -1662,6 +2059,71 @@ func writeAarch64Function(w stringWriter, funcName string, writeContents func(st
 	w.WriteString(".size " + funcName + ", .-" + funcName + "\n")
 }
 
+// findSymbolAliases adds each “.set alias, target” directive in input, where
+// both are plain symbol names, to aliases.
+func findSymbolAliases(input inputFile, aliases map[string]string) {
+	forEachPath(input.ast.up, func(node *node32) {
+		node = node.up
+		assertNodeType(node, ruleLabelContainingDirectiveName)
+		if input.contents[node.begin:node.end] != ".set" {
+			return
+		}
+
+		node = skipWS(node.next)
+		assertNodeType(node, ruleSymbolArgs)
+		alias := node.up
+		assertNodeType(alias, ruleSymbolArg)
+		target := skipWS(alias.next)
+		if target == nil || skipWS(target.next) != nil {
+			return
+		}
+		for _, arg := range []*node32{alias, target} {
+			if arg.up.pegRule != ruleSymbolName || arg.up.next != nil {
+				return
+			}
+		}
+		aliases[input.contents[alias.begin:alias.end]] = input.contents[target.begin:target.end]
+	}, ruleStatement, ruleLabelContainingDirective)
+}
+
+// parseFileDirective extracts the file number from a .file directive and
+// reports whether it carries an MD5 checksum. It returns ok=false for the
+// single-argument form, which has no file number, and a negative file number if
+// one is present but cannot be parsed.
+func parseFileDirective(contents string, node *node32) (fileNo int, hasMD5, ok bool) {
+	if node.pegRule == ruleRawFileDirective {
+		parts := strings.Fields(contents[node.begin:node.end])
+		if len(parts) == 2 {
+			return 0, false, false
+		}
+		fileNo, err := strconv.Atoi(parts[1])
+		if err != nil {
+			fileNo = -1
+		}
+		for _, token := range parts[2:] {
+			if token == "md5" {
+				hasMD5 = true
+			}
+		}
+		return fileNo, hasMD5, true
+	}
+
+	assertNodeType(node, ruleStructuredFileDirective)
+	for child := node.up; child != nil; child = child.next {
+		switch child.pegRule {
+		case ruleFileNumber:
+			n, err := strconv.Atoi(contents[child.begin:child.end])
+			if err != nil {
+				n = -1
+			}
+			fileNo, ok = n, true
+		case ruleFileMD5:
+			hasMD5 = true
+		}
+	}
+	return fileNo, hasMD5, ok
+}
+
 func transform(w stringWriter, inputs []inputFile) error {
 	// symbols contains all defined symbols.
 	symbols := make(map[string]struct{})
@@ -1681,6 +2143,13 @@ func transform(w stringWriter, inputs []inputFile) error {
 	symbols["OPENSSL_ia32cap_get"] = struct{}{}
 
 	for _, input := range inputs {
+		// Reject CFI regions which overlap or end in a different section.
+		// Unmatched directives are only rejected if requested, since
+		// compilers do not always close regions.
+		if _, err := cfiRegions(input, input.strictCFI); err != nil {
+			return err
+		}
+
 		forEachPath(input.ast.up, func(node *node32) {
 			symbol := input.contents[node.begin:node.end]
 			if _, ok := symbols[symbol]; ok {
@@ -1710,20 +2179,19 @@ func transform(w stringWriter, inputs []inputFile) error {
 
 		forEachPath(input.ast.up, func(node *node32) {
 			assertNodeType(node, ruleLocationDirective)
-			directive := input.contents[node.begin:node.end]
-			if !strings.HasPrefix(directive, ".file") {
+			if node.up.pegRule != ruleFileDirective {
 				return
 			}
-			parts := strings.Fields(directive)
-			if len(parts) == 2 {
+			directive := input.contents[node.begin:node.end]
+			fileNo, hasMD5, ok := parseFileDirective(input.contents, node.up.up)
+			if !ok {
 				// This is a .file directive with just a
 				// filename. Clang appears to generate just one
 				// of these at the beginning of the output for
 				// the compilation unit. Ignore it.
 				return
 			}
-			fileNo, err := strconv.Atoi(parts[1])
-			if err != nil {
+			if fileNo < 0 {
 				panic(fmt.Sprintf("Failed to parse file number from .file: %q", directive))
 			}
 
@@ -1736,14 +2204,36 @@ func transform(w stringWriter, inputs []inputFile) error {
 				maxObservedFileNumber = fileNo
 			}
 
-			for _, token := range parts[2:] {
-				if token == "md5" {
-					fileDirectivesContainMD5 = true
-				}
+			if hasMD5 {
+				fileDirectivesContainMD5 = true
 			}
 		}, ruleStatement, ruleLocationDirective)
 	}
 
+	// Aliases of symbols in the module are themselves in the module. Repeat
+	// until no more are found so that aliases of aliases are included.
+	aliases := make(map[string]string)
+	for _, input := range inputs {
+		findSymbolAliases(input, aliases)
+	}
+	for found := true; found; {
+		found = false
+		for alias, target := range aliases {
+			if _, ok := symbols[alias]; ok {
+				continue
+			}
+			if _, ok := symbols[target]; ok {
+				symbols[alias] = struct{}{}
+				found = true
+			}
+		}
+	}
+	for alias := range aliases {
+		if _, ok := symbols[alias]; !ok {
+			delete(aliases, alias)
+		}
+	}
+
 	processor := x86_64
 	if len(inputs) > 0 {
 		processor = detectProcessor(inputs[0])
@@ -1756,6 +2246,7 @@ func transform(w stringWriter, inputs []inputFile) error {
 
 	d := &delocation{
 		symbols:             symbols,
+		aliases:             aliases,
 		localEntrySymbols:   localEntrySymbols,
 		processor:           processor,
 		commentIndicator:    commentIndicator,
@@ -1981,26 +2472,46 @@ func parseInputs(inputs []inputFile) error {
 			contents = string(inBytes)
 		}
 
-		asm := Asm{Buffer: contents, Pretty: true}
-		asm.Init()
-		if err := asm.Parse(); err != nil {
-			return fmt.Errorf("error while parsing %q: %s", input.path, err)
+		inputs[i].contents = contents
+		if err := inputs[i].parse(false); err != nil {
+			return err
 		}
-		ast := asm.AST()
+	}
 
-		inputs[i].contents = contents
-		inputs[i].ast = ast
+	// Condition register names, such as "cr1", are only operands on
+	// ppc64le. The processor is not known until the inputs are parsed, so
+	// ppc64le inputs are parsed again with those rules enabled.
+	if len(inputs) > 0 && findProcessor(inputs[0]) == ppc64le {
+		for i := range inputs {
+			if err := inputs[i].parse(true); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// parse sets input.ast from input.contents. If ppc64le is true, operands
+// which are only valid on ppc64le are recognised.
+func (input *inputFile) parse(ppc64le bool) error {
+	asm := Asm{Buffer: input.contents, Pretty: true, COFF: input.coff, PPC64LE: ppc64le}
+	asm.Init()
+	if err := asm.Parse(); err != nil {
+		return fmt.Errorf("error while parsing %q: %s", input.path, err)
+	}
+	input.ast = asm.AST()
+	return nil
+}
+
 func main() {
 	// The .a file, if given, is expected to be an archive of textual
 	// assembly sources. That's odd, but CMake really wants to create
 	// archive files so it's the only way that we can make it work.
 	arInput := flag.String("a", "", "Path to a .a file containing assembly sources")
 	outFile := flag.String("o", "", "Path to output assembly")
+	coff := flag.Bool("coff", false, "Parse inputs as COFF (Windows) assembly")
+	strictCFI := flag.Bool("strict-cfi", false, "Reject unmatched .cfi_startproc and .cfi_endproc directives")
 
 	flag.Parse()
 
@@ -2015,6 +2526,8 @@ func main() {
 			path:      *arInput,
 			index:     0,
 			isArchive: true,
+			coff:      *coff,
+			strictCFI: *strictCFI,
 		})
 	}
 
@@ -2024,8 +2537,10 @@ func main() {
 		}
 
 		inputs = append(inputs, inputFile{
-			path:  path,
-			index: i + 1,
+			path:      path,
+			index:     i + 1,
+			coff:      *coff,
+			strictCFI: *strictCFI,
 		})
 	}
 
@@ -2072,6 +2587,18 @@ func forEachPath(node *node32, cb func(*node32), rules ...pegRule) {
 	}
 }
 
+// CountRule returns the number of tokens of rule r in t, including those
+// which match the empty string.
+func (t *tokens32) CountRule(r pegRule) int {
+	n := 0
+	for _, token := range t.Tokens() {
+		if token.pegRule == r {
+			n++
+		}
+	}
+	return n
+}
+
 func skipNodes(node *node32, ruleToSkip pegRule) *node32 {
 	for ; node != nil && node.pegRule == ruleToSkip; node = node.next {
 	}
@@ -2119,6 +2646,13 @@ func isSynthesized(symbol string) bool {
 		strings.HasPrefix(symbol, "BORINGSSL_bcm_text_")
 }
 
+// isRetpolineThunk returns true if symbol is one of the thunks that GCC calls
+// for indirect branches and returns under -mindirect-branch=thunk-extern and
+// -mfunction-return=thunk-extern.
+func isRetpolineThunk(symbol string) bool {
+	return strings.HasPrefix(symbol, "__x86_indirect_thunk_") || symbol == "__x86_return_thunk"
+}
+
 func redirectorName(symbol string) string {
 	return "bcm_redirector_" + symbol
 }
@@ -2156,6 +2690,16 @@ func (d *delocation) mapLocalSymbol(symbol string) string {
 }
 
 func detectProcessor(input inputFile) processorType {
+	processor := findProcessor(input)
+	if processor == 0 {
+		panic("processed entire input and didn't recognise any instructions.")
+	}
+	return processor
+}
+
+// findProcessor returns the processor of input, or zero if no instructions
+// are recognised.
+func findProcessor(input inputFile) processorType {
 	for statement := input.ast.up; statement != nil; statement = statement.next {
 		node := skipNodes(statement.up, ruleWS)
 		if node == nil || node.pegRule != ruleInstruction {
@@ -2163,6 +2707,9 @@ func detectProcessor(input inputFile) processorType {
 		}
 
 		instruction := node.up
+		for instruction.pegRule == ruleEncodingHint || instruction.pegRule == ruleInstructionPrefix {
+			instruction = skipWS(instruction.next)
+		}
 		instructionName := input.contents[instruction.begin:instruction.end]
 
 		switch instructionName {
@@ -2175,7 +2722,7 @@ func detectProcessor(input inputFile) processorType {
 		}
 	}
 
-	panic("processed entire input and didn't recognise any instructions.")
+	return 0
 }
 
 func sortedSet(m map[string]struct{}) []string {
@@ -21,27 +21,91 @@ const (
 	ruleDirectiveName
 	ruleLocationDirective
 	ruleFileDirective
+	ruleStructuredFileDirective
+	ruleRawFileDirective
+	ruleFileNumber
+	ruleFileName
+	ruleFileMD5
+	ruleFileSource
 	ruleLocDirective
+	ruleCFIDirective
+	ruleCFIStartProcDirective
+	ruleCFIEndProcDirective
+	ruleCFIDefCFAOffsetDirective
+	ruleCFIAdjustCFAOffsetDirective
+	ruleCFINoArgDirective
+	ruleCFIReturnColumnDirective
+	ruleCFIUndefinedDirective
+	ruleCFIEscapeDirective
+	ruleCFIEscapeArg
+	ruleCFIExpressionDirective
+	ruleCFIExpressionOperand
+	ruleCFIValEncodedAddrDirective
+	ruleCFIEncoding
+	ruleCFILabelDirective
+	ruleCFIPersonalityIDDirective
+	ruleCFIRegister
+	ruleGnuAttributeDirective
+	ruleAttributeDirective
+	ruleAttributeTag
+	ruleAttributeValue
+	ruleSEHDirective
+	ruleSEHRegister
+	ruleCOFFSectionDirective
+	ruleCOFFSectionName
+	ruleCOFFSectionSelection
+	ruleCOFFDefDirective
+	ruleCOFFStorageClass
+	ruleCOFFSymbolType
 	ruleArgs
 	ruleArg
 	ruleQuotedArg
 	ruleQuotedText
 	ruleLabelContainingDirective
+	ruleEquDirective
+	ruleEquDirectiveName
+	ruleDiagnosticDirective
+	ruleDiagnosticDirectiveName
+	ruleIdentDirective
+	ruleSubsectionDirective
+	ruleLiteralPoolDirective
+	ruleIncbinDirective
+	ruleIncbinFile
+	ruleIncbinSkip
+	ruleIncbinCount
+	ruleBundleDirective
+	ruleRelocDirective
+	ruleRelocOffset
+	ruleRelocType
+	ruleMIPSSetDirective
+	ruleMIPSSetOption
+	ruleVariantPCSDirective
+	ruleMachineDirective
+	ruleMachineStackOp
+	ruleMachineName
+	ruleInsnDirective
+	ruleInsnFormat
+	ruleInsnArg
+	ruleInsnRegister
 	ruleLabelContainingDirectiveName
 	ruleSymbolArgs
 	ruleSymbolArg
+	ruleSymbolArgEnd
 	ruleSymbolType
 	ruleDot
 	ruleTCMarker
 	ruleEscapedChar
 	ruleWS
 	ruleComment
+	ruleInlineAsmMarker
 	ruleLabel
 	ruleSymbolName
 	ruleLocalSymbol
 	ruleLocalLabel
 	ruleLocalLabelRef
 	ruleInstruction
+	ruleEncodingHint
+	ruleInstructionPrefix
 	ruleInstructionName
 	ruleInstructionArg
 	ruleGOTLocation
@@ -52,6 +116,10 @@ const (
 	ruleIndirectionIndicator
 	ruleRegisterOrConstant
 	ruleARMConstantTweak
+	rulePPCConditionRegister
+	ruleARMPrefetchOp
+	ruleARMSystemRegister
+	ruleARMLiteralPoolOperand
 	ruleARMRegister
 	ruleARMVectorRegister
 	ruleMemoryRef
@@ -62,6 +130,15 @@ const (
 	ruleARMPostincrement
 	ruleBaseIndexScale
 	ruleOperator
+	ruleExpression
+	ruleExpressionBitwise
+	ruleExpressionProduct
+	ruleExpressionTerm
+	ruleCharConstant
+	ruleUnaryOperator
+	ruleAdditiveOperator
+	ruleBitwiseOperator
+	ruleProductOperator
 	ruleOffset
 	ruleSection
 	ruleSegmentRegister
@@ -76,27 +153,91 @@ var rul3s = [...]string{
 	"DirectiveName",
 	"LocationDirective",
 	"FileDirective",
+	"StructuredFileDirective",
+	"RawFileDirective",
+	"FileNumber",
+	"FileName",
+	"FileMD5",
+	"FileSource",
 	"LocDirective",
+	"CFIDirective",
+	"CFIStartProcDirective",
+	"CFIEndProcDirective",
+	"CFIDefCFAOffsetDirective",
+	"CFIAdjustCFAOffsetDirective",
+	"CFINoArgDirective",
+	"CFIReturnColumnDirective",
+	"CFIUndefinedDirective",
+	"CFIEscapeDirective",
+	"CFIEscapeArg",
+	"CFIExpressionDirective",
+	"CFIExpressionOperand",
+	"CFIValEncodedAddrDirective",
+	"CFIEncoding",
+	"CFILabelDirective",
+	"CFIPersonalityIDDirective",
+	"CFIRegister",
+	"GnuAttributeDirective",
+	"AttributeDirective",
+	"AttributeTag",
+	"AttributeValue",
+	"SEHDirective",
+	"SEHRegister",
+	"COFFSectionDirective",
+	"COFFSectionName",
+	"COFFSectionSelection",
+	"COFFDefDirective",
+	"COFFStorageClass",
+	"COFFSymbolType",
 	"Args",
 	"Arg",
 	"QuotedArg",
 	"QuotedText",
 	"LabelContainingDirective",
+	"EquDirective",
+	"EquDirectiveName",
+	"DiagnosticDirective",
+	"DiagnosticDirectiveName",
+	"IdentDirective",
+	"SubsectionDirective",
+	"LiteralPoolDirective",
+	"IncbinDirective",
+	"IncbinFile",
+	"IncbinSkip",
+	"IncbinCount",
+	"BundleDirective",
+	"RelocDirective",
+	"RelocOffset",
+	"RelocType",
+	"MIPSSetDirective",
+	"MIPSSetOption",
+	"VariantPCSDirective",
+	"MachineDirective",
+	"MachineStackOp",
+	"MachineName",
+	"InsnDirective",
+	"InsnFormat",
+	"InsnArg",
+	"InsnRegister",
 	"LabelContainingDirectiveName",
 	"SymbolArgs",
 	"SymbolArg",
+	"SymbolArgEnd",
 	"SymbolType",
 	"Dot",
 	"TCMarker",
 	"EscapedChar",
 	"WS",
 	"Comment",
+	"InlineAsmMarker",
 	"Label",
 	"SymbolName",
 	"LocalSymbol",
 	"LocalLabel",
 	"LocalLabelRef",
 	"Instruction",
+	"EncodingHint",
+	"InstructionPrefix",
 	"InstructionName",
 	"InstructionArg",
 	"GOTLocation",
@@ -107,6 +248,10 @@ var rul3s = [...]string{
 	"IndirectionIndicator",
 	"RegisterOrConstant",
 	"ARMConstantTweak",
+	"PPCConditionRegister",
+	"ARMPrefetchOp",
+	"ARMSystemRegister",
+	"ARMLiteralPoolOperand",
 	"ARMRegister",
 	"ARMVectorRegister",
 	"MemoryRef",
@@ -117,6 +262,15 @@ var rul3s = [...]string{
 	"ARMPostincrement",
 	"BaseIndexScale",
 	"Operator",
+	"Expression",
+	"ExpressionBitwise",
+	"ExpressionProduct",
+	"ExpressionTerm",
+	"CharConstant",
+	"UnaryOperator",
+	"AdditiveOperator",
+	"BitwiseOperator",
+	"ProductOperator",
 	"Offset",
 	"Section",
 	"SegmentRegister",
@@ -232,12 +386,17 @@ func (t *tokens32) Tokens() []token32 {
 }
 
 type Asm struct {
-	Buffer string
-	buffer []rune
-	rules  [52]func() bool
-	parse  func(rule ...int) error
-	reset  func()
-	Pretty bool
+	// COFF enables rules for Windows (COFF) assembly.
+	COFF bool
+	// PPC64LE enables rules for ppc64le operands which would otherwise be
+	// symbols.
+	PPC64LE bool
+	Buffer  string
+	buffer  []rune
+	rules   [129]func() bool
+	parse   func(rule ...int) error
+	reset   func()
+	Pretty  bool
 	tokens32
 }
 
@@ -416,7 +575,7 @@ func (p *Asm) Init() {
 			position, tokenIndex = position0, tokenIndex0
 			return false
 		},
-		/* 1 Statement <- <(WS? (Label / ((GlobalDirective / LocationDirective / LabelContainingDirective / Instruction / Directive / Comment / ) WS? ((Comment? '\n') / ';'))))> */
+		/* 1 Statement <- <(WS? (Label / ((GlobalDirective / LocationDirective / CFIDirective / GnuAttributeDirective / AttributeDirective / SEHDirective / COFFSectionDirective / COFFDefDirective / EquDirective / DiagnosticDirective / InsnDirective / IdentDirective / SubsectionDirective / VariantPCSDirective / LiteralPoolDirective / BundleDirective / IncbinDirective / MachineDirective / RelocDirective / MIPSSetDirective / LabelContainingDirective / Instruction / Directive / InlineAsmMarker / Comment / ) WS? ((Comment? '\n') / ';'))))> */
 		func() bool {
 			position5, tokenIndex5 := position, tokenIndex
 			{
@@ -453,67 +612,181 @@ func (p *Asm) Init() {
 						goto l11
 					l13:
 						position, tokenIndex = position11, tokenIndex11
-						if !_rules[ruleLabelContainingDirective]() {
+						if !_rules[ruleCFIDirective]() {
 							goto l14
 						}
 						goto l11
 					l14:
 						position, tokenIndex = position11, tokenIndex11
-						if !_rules[ruleInstruction]() {
+						if !_rules[ruleGnuAttributeDirective]() {
 							goto l15
 						}
 						goto l11
 					l15:
 						position, tokenIndex = position11, tokenIndex11
-						if !_rules[ruleDirective]() {
+						if !_rules[ruleAttributeDirective]() {
 							goto l16
 						}
 						goto l11
 					l16:
 						position, tokenIndex = position11, tokenIndex11
-						if !_rules[ruleComment]() {
+						if !_rules[ruleSEHDirective]() {
 							goto l17
 						}
 						goto l11
 					l17:
 						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleCOFFSectionDirective]() {
+							goto l18
+						}
+						goto l11
+					l18:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleCOFFDefDirective]() {
+							goto l19
+						}
+						goto l11
+					l19:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleEquDirective]() {
+							goto l20
+						}
+						goto l11
+					l20:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleDiagnosticDirective]() {
+							goto l21
+						}
+						goto l11
+					l21:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleInsnDirective]() {
+							goto l22
+						}
+						goto l11
+					l22:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleIdentDirective]() {
+							goto l23
+						}
+						goto l11
+					l23:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleSubsectionDirective]() {
+							goto l24
+						}
+						goto l11
+					l24:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleVariantPCSDirective]() {
+							goto l25
+						}
+						goto l11
+					l25:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleLiteralPoolDirective]() {
+							goto l26
+						}
+						goto l11
+					l26:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleBundleDirective]() {
+							goto l27
+						}
+						goto l11
+					l27:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleIncbinDirective]() {
+							goto l28
+						}
+						goto l11
+					l28:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleMachineDirective]() {
+							goto l29
+						}
+						goto l11
+					l29:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleRelocDirective]() {
+							goto l30
+						}
+						goto l11
+					l30:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleMIPSSetDirective]() {
+							goto l31
+						}
+						goto l11
+					l31:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleLabelContainingDirective]() {
+							goto l32
+						}
+						goto l11
+					l32:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleInstruction]() {
+							goto l33
+						}
+						goto l11
+					l33:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleDirective]() {
+							goto l34
+						}
+						goto l11
+					l34:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleInlineAsmMarker]() {
+							goto l35
+						}
+						goto l11
+					l35:
+						position, tokenIndex = position11, tokenIndex11
+						if !_rules[ruleComment]() {
+							goto l36
+						}
+						goto l11
+					l36:
+						position, tokenIndex = position11, tokenIndex11
 					}
 				l11:
 					{
-						position18, tokenIndex18 := position, tokenIndex
+						position37, tokenIndex37 := position, tokenIndex
 						if !_rules[ruleWS]() {
-							goto l18
+							goto l37
 						}
-						goto l19
-					l18:
-						position, tokenIndex = position18, tokenIndex18
+						goto l38
+					l37:
+						position, tokenIndex = position37, tokenIndex37
 					}
-				l19:
+				l38:
 					{
-						position20, tokenIndex20 := position, tokenIndex
+						position39, tokenIndex39 := position, tokenIndex
 						{
-							position22, tokenIndex22 := position, tokenIndex
+							position41, tokenIndex41 := position, tokenIndex
 							if !_rules[ruleComment]() {
-								goto l22
+								goto l41
 							}
-							goto l23
-						l22:
-							position, tokenIndex = position22, tokenIndex22
+							goto l42
+						l41:
+							position, tokenIndex = position41, tokenIndex41
 						}
-					l23:
+					l42:
 						if buffer[position] != rune('\n') {
-							goto l21
+							goto l40
 						}
 						position++
-						goto l20
-					l21:
-						position, tokenIndex = position20, tokenIndex20
+						goto l39
+					l40:
+						position, tokenIndex = position39, tokenIndex39
 						if buffer[position] != rune(';') {
 							goto l5
 						}
 						position++
 					}
-				l20:
+				l39:
 				}
 			l9:
 				add(ruleStatement, position6)
@@ -525,5576 +798,25381 @@ func (p *Asm) Init() {
 		},
 		/* 2 GlobalDirective <- <((('.' ('g' / 'G') ('l' / 'L') ('o' / 'O') ('b' / 'B') ('a' / 'A') ('l' / 'L')) / ('.' ('g' / 'G') ('l' / 'L') ('o' / 'O') ('b' / 'B') ('l' / 'L'))) WS SymbolName)> */
 		func() bool {
-			position24, tokenIndex24 := position, tokenIndex
+			position43, tokenIndex43 := position, tokenIndex
 			{
-				position25 := position
+				position44 := position
 				{
-					position26, tokenIndex26 := position, tokenIndex
+					position45, tokenIndex45 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l27
+						goto l46
 					}
 					position++
 					{
-						position28, tokenIndex28 := position, tokenIndex
+						position47, tokenIndex47 := position, tokenIndex
 						if buffer[position] != rune('g') {
-							goto l29
+							goto l48
 						}
 						position++
-						goto l28
-					l29:
-						position, tokenIndex = position28, tokenIndex28
+						goto l47
+					l48:
+						position, tokenIndex = position47, tokenIndex47
 						if buffer[position] != rune('G') {
-							goto l27
+							goto l46
 						}
 						position++
 					}
-				l28:
+				l47:
 					{
-						position30, tokenIndex30 := position, tokenIndex
+						position49, tokenIndex49 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l31
+							goto l50
 						}
 						position++
-						goto l30
-					l31:
-						position, tokenIndex = position30, tokenIndex30
+						goto l49
+					l50:
+						position, tokenIndex = position49, tokenIndex49
 						if buffer[position] != rune('L') {
-							goto l27
+							goto l46
 						}
 						position++
 					}
-				l30:
+				l49:
 					{
-						position32, tokenIndex32 := position, tokenIndex
+						position51, tokenIndex51 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l33
+							goto l52
 						}
 						position++
-						goto l32
-					l33:
-						position, tokenIndex = position32, tokenIndex32
+						goto l51
+					l52:
+						position, tokenIndex = position51, tokenIndex51
 						if buffer[position] != rune('O') {
-							goto l27
+							goto l46
 						}
 						position++
 					}
-				l32:
+				l51:
 					{
-						position34, tokenIndex34 := position, tokenIndex
+						position53, tokenIndex53 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l35
+							goto l54
 						}
 						position++
-						goto l34
-					l35:
-						position, tokenIndex = position34, tokenIndex34
+						goto l53
+					l54:
+						position, tokenIndex = position53, tokenIndex53
 						if buffer[position] != rune('B') {
-							goto l27
+							goto l46
 						}
 						position++
 					}
-				l34:
+				l53:
 					{
-						position36, tokenIndex36 := position, tokenIndex
+						position55, tokenIndex55 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l37
+							goto l56
 						}
 						position++
-						goto l36
-					l37:
-						position, tokenIndex = position36, tokenIndex36
+						goto l55
+					l56:
+						position, tokenIndex = position55, tokenIndex55
 						if buffer[position] != rune('A') {
-							goto l27
+							goto l46
 						}
 						position++
 					}
-				l36:
+				l55:
 					{
-						position38, tokenIndex38 := position, tokenIndex
+						position57, tokenIndex57 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l39
+							goto l58
 						}
 						position++
-						goto l38
-					l39:
-						position, tokenIndex = position38, tokenIndex38
+						goto l57
+					l58:
+						position, tokenIndex = position57, tokenIndex57
 						if buffer[position] != rune('L') {
-							goto l27
+							goto l46
 						}
 						position++
 					}
-				l38:
-					goto l26
-				l27:
-					position, tokenIndex = position26, tokenIndex26
+				l57:
+					goto l45
+				l46:
+					position, tokenIndex = position45, tokenIndex45
 					if buffer[position] != rune('.') {
-						goto l24
+						goto l43
 					}
 					position++
 					{
-						position40, tokenIndex40 := position, tokenIndex
+						position59, tokenIndex59 := position, tokenIndex
 						if buffer[position] != rune('g') {
-							goto l41
+							goto l60
 						}
 						position++
-						goto l40
-					l41:
-						position, tokenIndex = position40, tokenIndex40
+						goto l59
+					l60:
+						position, tokenIndex = position59, tokenIndex59
 						if buffer[position] != rune('G') {
-							goto l24
+							goto l43
 						}
 						position++
 					}
-				l40:
+				l59:
 					{
-						position42, tokenIndex42 := position, tokenIndex
+						position61, tokenIndex61 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l43
+							goto l62
 						}
 						position++
-						goto l42
-					l43:
-						position, tokenIndex = position42, tokenIndex42
+						goto l61
+					l62:
+						position, tokenIndex = position61, tokenIndex61
 						if buffer[position] != rune('L') {
-							goto l24
+							goto l43
 						}
 						position++
 					}
-				l42:
+				l61:
 					{
-						position44, tokenIndex44 := position, tokenIndex
+						position63, tokenIndex63 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l45
+							goto l64
 						}
 						position++
-						goto l44
-					l45:
-						position, tokenIndex = position44, tokenIndex44
+						goto l63
+					l64:
+						position, tokenIndex = position63, tokenIndex63
 						if buffer[position] != rune('O') {
-							goto l24
+							goto l43
 						}
 						position++
 					}
-				l44:
+				l63:
 					{
-						position46, tokenIndex46 := position, tokenIndex
+						position65, tokenIndex65 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l47
+							goto l66
 						}
 						position++
-						goto l46
-					l47:
-						position, tokenIndex = position46, tokenIndex46
+						goto l65
+					l66:
+						position, tokenIndex = position65, tokenIndex65
 						if buffer[position] != rune('B') {
-							goto l24
+							goto l43
 						}
 						position++
 					}
-				l46:
+				l65:
 					{
-						position48, tokenIndex48 := position, tokenIndex
+						position67, tokenIndex67 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l49
+							goto l68
 						}
 						position++
-						goto l48
-					l49:
-						position, tokenIndex = position48, tokenIndex48
+						goto l67
+					l68:
+						position, tokenIndex = position67, tokenIndex67
 						if buffer[position] != rune('L') {
-							goto l24
+							goto l43
 						}
 						position++
 					}
-				l48:
+				l67:
 				}
-			l26:
+			l45:
 				if !_rules[ruleWS]() {
-					goto l24
+					goto l43
 				}
 				if !_rules[ruleSymbolName]() {
-					goto l24
+					goto l43
 				}
-				add(ruleGlobalDirective, position25)
+				add(ruleGlobalDirective, position44)
 			}
 			return true
-		l24:
-			position, tokenIndex = position24, tokenIndex24
+		l43:
+			position, tokenIndex = position43, tokenIndex43
 			return false
 		},
 		/* 3 Directive <- <('.' DirectiveName (WS Args)?)> */
 		func() bool {
-			position50, tokenIndex50 := position, tokenIndex
+			position69, tokenIndex69 := position, tokenIndex
 			{
-				position51 := position
+				position70 := position
 				if buffer[position] != rune('.') {
-					goto l50
+					goto l69
 				}
 				position++
 				if !_rules[ruleDirectiveName]() {
-					goto l50
+					goto l69
 				}
 				{
-					position52, tokenIndex52 := position, tokenIndex
+					position71, tokenIndex71 := position, tokenIndex
 					if !_rules[ruleWS]() {
-						goto l52
+						goto l71
 					}
 					if !_rules[ruleArgs]() {
-						goto l52
+						goto l71
 					}
-					goto l53
-				l52:
-					position, tokenIndex = position52, tokenIndex52
+					goto l72
+				l71:
+					position, tokenIndex = position71, tokenIndex71
 				}
-			l53:
-				add(ruleDirective, position51)
+			l72:
+				add(ruleDirective, position70)
 			}
 			return true
-		l50:
-			position, tokenIndex = position50, tokenIndex50
+		l69:
+			position, tokenIndex = position69, tokenIndex69
 			return false
 		},
 		/* 4 DirectiveName <- <([a-z] / [A-Z] / ([0-9] / [0-9]) / '_')+> */
 		func() bool {
-			position54, tokenIndex54 := position, tokenIndex
+			position73, tokenIndex73 := position, tokenIndex
 			{
-				position55 := position
+				position74 := position
 				{
-					position58, tokenIndex58 := position, tokenIndex
+					position77, tokenIndex77 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l59
+						goto l78
 					}
 					position++
-					goto l58
-				l59:
-					position, tokenIndex = position58, tokenIndex58
+					goto l77
+				l78:
+					position, tokenIndex = position77, tokenIndex77
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l60
+						goto l79
 					}
 					position++
-					goto l58
-				l60:
-					position, tokenIndex = position58, tokenIndex58
+					goto l77
+				l79:
+					position, tokenIndex = position77, tokenIndex77
 					{
-						position62, tokenIndex62 := position, tokenIndex
+						position81, tokenIndex81 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l63
+							goto l82
 						}
 						position++
-						goto l62
-					l63:
-						position, tokenIndex = position62, tokenIndex62
+						goto l81
+					l82:
+						position, tokenIndex = position81, tokenIndex81
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l61
+							goto l80
 						}
 						position++
 					}
-				l62:
-					goto l58
-				l61:
-					position, tokenIndex = position58, tokenIndex58
+				l81:
+					goto l77
+				l80:
+					position, tokenIndex = position77, tokenIndex77
 					if buffer[position] != rune('_') {
-						goto l54
+						goto l73
 					}
 					position++
 				}
-			l58:
-			l56:
+			l77:
+			l75:
 				{
-					position57, tokenIndex57 := position, tokenIndex
+					position76, tokenIndex76 := position, tokenIndex
 					{
-						position64, tokenIndex64 := position, tokenIndex
+						position83, tokenIndex83 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l65
+							goto l84
 						}
 						position++
-						goto l64
-					l65:
-						position, tokenIndex = position64, tokenIndex64
+						goto l83
+					l84:
+						position, tokenIndex = position83, tokenIndex83
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l66
+							goto l85
 						}
 						position++
-						goto l64
-					l66:
-						position, tokenIndex = position64, tokenIndex64
+						goto l83
+					l85:
+						position, tokenIndex = position83, tokenIndex83
 						{
-							position68, tokenIndex68 := position, tokenIndex
+							position87, tokenIndex87 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l69
+								goto l88
 							}
 							position++
-							goto l68
-						l69:
-							position, tokenIndex = position68, tokenIndex68
+							goto l87
+						l88:
+							position, tokenIndex = position87, tokenIndex87
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l67
+								goto l86
 							}
 							position++
 						}
-					l68:
-						goto l64
-					l67:
-						position, tokenIndex = position64, tokenIndex64
+					l87:
+						goto l83
+					l86:
+						position, tokenIndex = position83, tokenIndex83
 						if buffer[position] != rune('_') {
-							goto l57
+							goto l76
 						}
 						position++
 					}
-				l64:
-					goto l56
-				l57:
-					position, tokenIndex = position57, tokenIndex57
+				l83:
+					goto l75
+				l76:
+					position, tokenIndex = position76, tokenIndex76
 				}
-				add(ruleDirectiveName, position55)
+				add(ruleDirectiveName, position74)
 			}
 			return true
-		l54:
-			position, tokenIndex = position54, tokenIndex54
+		l73:
+			position, tokenIndex = position73, tokenIndex73
 			return false
 		},
 		/* 5 LocationDirective <- <(FileDirective / LocDirective)> */
 		func() bool {
-			position70, tokenIndex70 := position, tokenIndex
+			position89, tokenIndex89 := position, tokenIndex
 			{
-				position71 := position
+				position90 := position
 				{
-					position72, tokenIndex72 := position, tokenIndex
+					position91, tokenIndex91 := position, tokenIndex
 					if !_rules[ruleFileDirective]() {
-						goto l73
+						goto l92
 					}
-					goto l72
-				l73:
-					position, tokenIndex = position72, tokenIndex72
+					goto l91
+				l92:
+					position, tokenIndex = position91, tokenIndex91
 					if !_rules[ruleLocDirective]() {
-						goto l70
+						goto l89
 					}
 				}
-			l72:
-				add(ruleLocationDirective, position71)
+			l91:
+				add(ruleLocationDirective, position90)
+			}
+			return true
+		l89:
+			position, tokenIndex = position89, tokenIndex89
+			return false
+		},
+		/* 6 FileDirective <- <(StructuredFileDirective / RawFileDirective)> */
+		func() bool {
+			position93, tokenIndex93 := position, tokenIndex
+			{
+				position94 := position
+				{
+					position95, tokenIndex95 := position, tokenIndex
+					if !_rules[ruleStructuredFileDirective]() {
+						goto l96
+					}
+					goto l95
+				l96:
+					position, tokenIndex = position95, tokenIndex95
+					if !_rules[ruleRawFileDirective]() {
+						goto l93
+					}
+				}
+			l95:
+				add(ruleFileDirective, position94)
 			}
 			return true
-		l70:
-			position, tokenIndex = position70, tokenIndex70
+		l93:
+			position, tokenIndex = position93, tokenIndex93
 			return false
 		},
-		/* 6 FileDirective <- <('.' ('f' / 'F') ('i' / 'I') ('l' / 'L') ('e' / 'E') WS (!('#' / '\n') .)+)> */
+		/* 7 StructuredFileDirective <- <('.' ('f' / 'F') ('i' / 'I') ('l' / 'L') ('e' / 'E') WS (FileNumber WS)? FileName (WS !(((('m' / 'M') ('d' / 'D') '5') / (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E'))) WS) FileName)? (WS FileMD5)? (WS FileSource)? &(WS? ('#' / '\n' / ';')))> */
 		func() bool {
-			position74, tokenIndex74 := position, tokenIndex
+			position97, tokenIndex97 := position, tokenIndex
 			{
-				position75 := position
+				position98 := position
 				if buffer[position] != rune('.') {
-					goto l74
+					goto l97
 				}
 				position++
 				{
-					position76, tokenIndex76 := position, tokenIndex
+					position99, tokenIndex99 := position, tokenIndex
 					if buffer[position] != rune('f') {
-						goto l77
+						goto l100
 					}
 					position++
-					goto l76
-				l77:
-					position, tokenIndex = position76, tokenIndex76
+					goto l99
+				l100:
+					position, tokenIndex = position99, tokenIndex99
 					if buffer[position] != rune('F') {
-						goto l74
+						goto l97
 					}
 					position++
 				}
-			l76:
+			l99:
 				{
-					position78, tokenIndex78 := position, tokenIndex
+					position101, tokenIndex101 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l79
+						goto l102
 					}
 					position++
-					goto l78
-				l79:
-					position, tokenIndex = position78, tokenIndex78
+					goto l101
+				l102:
+					position, tokenIndex = position101, tokenIndex101
 					if buffer[position] != rune('I') {
-						goto l74
+						goto l97
 					}
 					position++
 				}
-			l78:
+			l101:
 				{
-					position80, tokenIndex80 := position, tokenIndex
+					position103, tokenIndex103 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l81
+						goto l104
 					}
 					position++
-					goto l80
-				l81:
-					position, tokenIndex = position80, tokenIndex80
+					goto l103
+				l104:
+					position, tokenIndex = position103, tokenIndex103
 					if buffer[position] != rune('L') {
-						goto l74
+						goto l97
 					}
 					position++
 				}
-			l80:
+			l103:
 				{
-					position82, tokenIndex82 := position, tokenIndex
+					position105, tokenIndex105 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l83
+						goto l106
 					}
 					position++
-					goto l82
-				l83:
-					position, tokenIndex = position82, tokenIndex82
+					goto l105
+				l106:
+					position, tokenIndex = position105, tokenIndex105
 					if buffer[position] != rune('E') {
-						goto l74
+						goto l97
 					}
 					position++
 				}
-			l82:
+			l105:
 				if !_rules[ruleWS]() {
-					goto l74
+					goto l97
 				}
 				{
-					position86, tokenIndex86 := position, tokenIndex
-					{
-						position87, tokenIndex87 := position, tokenIndex
-						if buffer[position] != rune('#') {
-							goto l88
-						}
-						position++
-						goto l87
-					l88:
-						position, tokenIndex = position87, tokenIndex87
-						if buffer[position] != rune('\n') {
-							goto l86
-						}
-						position++
+					position107, tokenIndex107 := position, tokenIndex
+					if !_rules[ruleFileNumber]() {
+						goto l107
+					}
+					if !_rules[ruleWS]() {
+						goto l107
 					}
-				l87:
-					goto l74
-				l86:
-					position, tokenIndex = position86, tokenIndex86
+					goto l108
+				l107:
+					position, tokenIndex = position107, tokenIndex107
 				}
-				if !matchDot() {
-					goto l74
+			l108:
+				if !_rules[ruleFileName]() {
+					goto l97
 				}
-			l84:
 				{
-					position85, tokenIndex85 := position, tokenIndex
+					position109, tokenIndex109 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l109
+					}
 					{
-						position89, tokenIndex89 := position, tokenIndex
+						position111, tokenIndex111 := position, tokenIndex
 						{
-							position90, tokenIndex90 := position, tokenIndex
-							if buffer[position] != rune('#') {
-								goto l91
+							position112, tokenIndex112 := position, tokenIndex
+							{
+								position114, tokenIndex114 := position, tokenIndex
+								if buffer[position] != rune('m') {
+									goto l115
+								}
+								position++
+								goto l114
+							l115:
+								position, tokenIndex = position114, tokenIndex114
+								if buffer[position] != rune('M') {
+									goto l113
+								}
+								position++
 							}
-							position++
-							goto l90
-						l91:
-							position, tokenIndex = position90, tokenIndex90
-							if buffer[position] != rune('\n') {
-								goto l89
+						l114:
+							{
+								position116, tokenIndex116 := position, tokenIndex
+								if buffer[position] != rune('d') {
+									goto l117
+								}
+								position++
+								goto l116
+							l117:
+								position, tokenIndex = position116, tokenIndex116
+								if buffer[position] != rune('D') {
+									goto l113
+								}
+								position++
+							}
+						l116:
+							if buffer[position] != rune('5') {
+								goto l113
 							}
 							position++
+							goto l112
+						l113:
+							position, tokenIndex = position112, tokenIndex112
+							{
+								position118, tokenIndex118 := position, tokenIndex
+								if buffer[position] != rune('s') {
+									goto l119
+								}
+								position++
+								goto l118
+							l119:
+								position, tokenIndex = position118, tokenIndex118
+								if buffer[position] != rune('S') {
+									goto l111
+								}
+								position++
+							}
+						l118:
+							{
+								position120, tokenIndex120 := position, tokenIndex
+								if buffer[position] != rune('o') {
+									goto l121
+								}
+								position++
+								goto l120
+							l121:
+								position, tokenIndex = position120, tokenIndex120
+								if buffer[position] != rune('O') {
+									goto l111
+								}
+								position++
+							}
+						l120:
+							{
+								position122, tokenIndex122 := position, tokenIndex
+								if buffer[position] != rune('u') {
+									goto l123
+								}
+								position++
+								goto l122
+							l123:
+								position, tokenIndex = position122, tokenIndex122
+								if buffer[position] != rune('U') {
+									goto l111
+								}
+								position++
+							}
+						l122:
+							{
+								position124, tokenIndex124 := position, tokenIndex
+								if buffer[position] != rune('r') {
+									goto l125
+								}
+								position++
+								goto l124
+							l125:
+								position, tokenIndex = position124, tokenIndex124
+								if buffer[position] != rune('R') {
+									goto l111
+								}
+								position++
+							}
+						l124:
+							{
+								position126, tokenIndex126 := position, tokenIndex
+								if buffer[position] != rune('c') {
+									goto l127
+								}
+								position++
+								goto l126
+							l127:
+								position, tokenIndex = position126, tokenIndex126
+								if buffer[position] != rune('C') {
+									goto l111
+								}
+								position++
+							}
+						l126:
+							{
+								position128, tokenIndex128 := position, tokenIndex
+								if buffer[position] != rune('e') {
+									goto l129
+								}
+								position++
+								goto l128
+							l129:
+								position, tokenIndex = position128, tokenIndex128
+								if buffer[position] != rune('E') {
+									goto l111
+								}
+								position++
+							}
+						l128:
+						}
+					l112:
+						if !_rules[ruleWS]() {
+							goto l111
 						}
-					l90:
-						goto l85
-					l89:
-						position, tokenIndex = position89, tokenIndex89
+						goto l109
+					l111:
+						position, tokenIndex = position111, tokenIndex111
 					}
-					if !matchDot() {
-						goto l85
+					if !_rules[ruleFileName]() {
+						goto l109
 					}
-					goto l84
-				l85:
-					position, tokenIndex = position85, tokenIndex85
+					goto l110
+				l109:
+					position, tokenIndex = position109, tokenIndex109
+				}
+			l110:
+				{
+					position130, tokenIndex130 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l130
+					}
+					if !_rules[ruleFileMD5]() {
+						goto l130
+					}
+					goto l131
+				l130:
+					position, tokenIndex = position130, tokenIndex130
+				}
+			l131:
+				{
+					position132, tokenIndex132 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l132
+					}
+					if !_rules[ruleFileSource]() {
+						goto l132
+					}
+					goto l133
+				l132:
+					position, tokenIndex = position132, tokenIndex132
+				}
+			l133:
+				position134, tokenIndex134 := position, tokenIndex
+				{
+					position135, tokenIndex135 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l135
+					}
+					goto l136
+				l135:
+					position, tokenIndex = position135, tokenIndex135
 				}
-				add(ruleFileDirective, position75)
+			l136:
+				{
+					position137, tokenIndex137 := position, tokenIndex
+					if buffer[position] != rune('#') {
+						goto l138
+					}
+					position++
+					goto l137
+				l138:
+					position, tokenIndex = position137, tokenIndex137
+					if buffer[position] != rune('\n') {
+						goto l139
+					}
+					position++
+					goto l137
+				l139:
+					position, tokenIndex = position137, tokenIndex137
+					if buffer[position] != rune(';') {
+						goto l97
+					}
+					position++
+				}
+			l137:
+				position, tokenIndex = position134, tokenIndex134
+				add(ruleStructuredFileDirective, position98)
 			}
 			return true
-		l74:
-			position, tokenIndex = position74, tokenIndex74
+		l97:
+			position, tokenIndex = position97, tokenIndex97
 			return false
 		},
-		/* 7 LocDirective <- <('.' ('l' / 'L') ('o' / 'O') ('c' / 'C') WS (!('#' / '/' / '\n') .)+)> */
+		/* 8 RawFileDirective <- <('.' ('f' / 'F') ('i' / 'I') ('l' / 'L') ('e' / 'E') WS (!('#' / '\n') .)+)> */
 		func() bool {
-			position92, tokenIndex92 := position, tokenIndex
+			position140, tokenIndex140 := position, tokenIndex
 			{
-				position93 := position
+				position141 := position
 				if buffer[position] != rune('.') {
-					goto l92
+					goto l140
 				}
 				position++
 				{
-					position94, tokenIndex94 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l95
+					position142, tokenIndex142 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l143
 					}
 					position++
-					goto l94
-				l95:
-					position, tokenIndex = position94, tokenIndex94
-					if buffer[position] != rune('L') {
-						goto l92
+					goto l142
+				l143:
+					position, tokenIndex = position142, tokenIndex142
+					if buffer[position] != rune('F') {
+						goto l140
 					}
 					position++
 				}
-			l94:
+			l142:
 				{
-					position96, tokenIndex96 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l97
+					position144, tokenIndex144 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l145
 					}
 					position++
-					goto l96
-				l97:
-					position, tokenIndex = position96, tokenIndex96
-					if buffer[position] != rune('O') {
-						goto l92
+					goto l144
+				l145:
+					position, tokenIndex = position144, tokenIndex144
+					if buffer[position] != rune('I') {
+						goto l140
 					}
 					position++
 				}
-			l96:
+			l144:
 				{
-					position98, tokenIndex98 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l99
+					position146, tokenIndex146 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l147
 					}
 					position++
-					goto l98
-				l99:
-					position, tokenIndex = position98, tokenIndex98
-					if buffer[position] != rune('C') {
-						goto l92
+					goto l146
+				l147:
+					position, tokenIndex = position146, tokenIndex146
+					if buffer[position] != rune('L') {
+						goto l140
+					}
+					position++
+				}
+			l146:
+				{
+					position148, tokenIndex148 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l149
+					}
+					position++
+					goto l148
+				l149:
+					position, tokenIndex = position148, tokenIndex148
+					if buffer[position] != rune('E') {
+						goto l140
 					}
 					position++
 				}
-			l98:
+			l148:
 				if !_rules[ruleWS]() {
-					goto l92
+					goto l140
 				}
 				{
-					position102, tokenIndex102 := position, tokenIndex
+					position152, tokenIndex152 := position, tokenIndex
 					{
-						position103, tokenIndex103 := position, tokenIndex
+						position153, tokenIndex153 := position, tokenIndex
 						if buffer[position] != rune('#') {
-							goto l104
-						}
-						position++
-						goto l103
-					l104:
-						position, tokenIndex = position103, tokenIndex103
-						if buffer[position] != rune('/') {
-							goto l105
+							goto l154
 						}
 						position++
-						goto l103
-					l105:
-						position, tokenIndex = position103, tokenIndex103
+						goto l153
+					l154:
+						position, tokenIndex = position153, tokenIndex153
 						if buffer[position] != rune('\n') {
-							goto l102
+							goto l152
 						}
 						position++
 					}
-				l103:
-					goto l92
-				l102:
-					position, tokenIndex = position102, tokenIndex102
+				l153:
+					goto l140
+				l152:
+					position, tokenIndex = position152, tokenIndex152
 				}
 				if !matchDot() {
-					goto l92
+					goto l140
 				}
-			l100:
+			l150:
 				{
-					position101, tokenIndex101 := position, tokenIndex
+					position151, tokenIndex151 := position, tokenIndex
 					{
-						position106, tokenIndex106 := position, tokenIndex
+						position155, tokenIndex155 := position, tokenIndex
 						{
-							position107, tokenIndex107 := position, tokenIndex
+							position156, tokenIndex156 := position, tokenIndex
 							if buffer[position] != rune('#') {
-								goto l108
+								goto l157
 							}
 							position++
-							goto l107
-						l108:
-							position, tokenIndex = position107, tokenIndex107
-							if buffer[position] != rune('/') {
-								goto l109
-							}
-							position++
-							goto l107
-						l109:
-							position, tokenIndex = position107, tokenIndex107
+							goto l156
+						l157:
+							position, tokenIndex = position156, tokenIndex156
 							if buffer[position] != rune('\n') {
-								goto l106
+								goto l155
 							}
 							position++
 						}
-					l107:
-						goto l101
-					l106:
-						position, tokenIndex = position106, tokenIndex106
+					l156:
+						goto l151
+					l155:
+						position, tokenIndex = position155, tokenIndex155
 					}
 					if !matchDot() {
-						goto l101
+						goto l151
 					}
-					goto l100
-				l101:
-					position, tokenIndex = position101, tokenIndex101
+					goto l150
+				l151:
+					position, tokenIndex = position151, tokenIndex151
 				}
-				add(ruleLocDirective, position93)
+				add(ruleRawFileDirective, position141)
 			}
 			return true
-		l92:
-			position, tokenIndex = position92, tokenIndex92
+		l140:
+			position, tokenIndex = position140, tokenIndex140
 			return false
 		},
-		/* 8 Args <- <(Arg (WS? ',' WS? Arg)*)> */
+		/* 9 FileNumber <- <[0-9]+> */
 		func() bool {
-			position110, tokenIndex110 := position, tokenIndex
+			position158, tokenIndex158 := position, tokenIndex
 			{
-				position111 := position
-				if !_rules[ruleArg]() {
-					goto l110
+				position159 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l158
 				}
-			l112:
+				position++
+			l160:
 				{
-					position113, tokenIndex113 := position, tokenIndex
-					{
-						position114, tokenIndex114 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l114
-						}
-						goto l115
-					l114:
-						position, tokenIndex = position114, tokenIndex114
-					}
-				l115:
-					if buffer[position] != rune(',') {
-						goto l113
+					position161, tokenIndex161 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l161
 					}
 					position++
-					{
-						position116, tokenIndex116 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l116
-						}
-						goto l117
-					l116:
-						position, tokenIndex = position116, tokenIndex116
-					}
-				l117:
-					if !_rules[ruleArg]() {
-						goto l113
-					}
-					goto l112
-				l113:
-					position, tokenIndex = position113, tokenIndex113
+					goto l160
+				l161:
+					position, tokenIndex = position161, tokenIndex161
 				}
-				add(ruleArgs, position111)
+				add(ruleFileNumber, position159)
 			}
 			return true
-		l110:
-			position, tokenIndex = position110, tokenIndex110
+		l158:
+			position, tokenIndex = position158, tokenIndex158
 			return false
 		},
-		/* 9 Arg <- <(QuotedArg / ([0-9] / [0-9] / ([a-z] / [A-Z]) / '%' / '+' / '-' / '*' / '_' / '@' / '.')*)> */
+		/* 10 FileName <- <(QuotedArg / (!(' ' / '\t' / '#' / ';' / '\n' / '"') .)+)> */
 		func() bool {
+			position162, tokenIndex162 := position, tokenIndex
 			{
-				position119 := position
+				position163 := position
 				{
-					position120, tokenIndex120 := position, tokenIndex
+					position164, tokenIndex164 := position, tokenIndex
 					if !_rules[ruleQuotedArg]() {
-						goto l121
+						goto l165
 					}
-					goto l120
-				l121:
-					position, tokenIndex = position120, tokenIndex120
-				l122:
+					goto l164
+				l165:
+					position, tokenIndex = position164, tokenIndex164
 					{
-						position123, tokenIndex123 := position, tokenIndex
+						position168, tokenIndex168 := position, tokenIndex
 						{
-							position124, tokenIndex124 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l125
-							}
-							position++
-							goto l124
-						l125:
-							position, tokenIndex = position124, tokenIndex124
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l126
-							}
-							position++
-							goto l124
-						l126:
-							position, tokenIndex = position124, tokenIndex124
-							{
-								position128, tokenIndex128 := position, tokenIndex
-								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l129
-								}
-								position++
-								goto l128
-							l129:
-								position, tokenIndex = position128, tokenIndex128
-								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l127
-								}
-								position++
-							}
-						l128:
-							goto l124
-						l127:
-							position, tokenIndex = position124, tokenIndex124
-							if buffer[position] != rune('%') {
-								goto l130
+							position169, tokenIndex169 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l170
 							}
 							position++
-							goto l124
-						l130:
-							position, tokenIndex = position124, tokenIndex124
-							if buffer[position] != rune('+') {
-								goto l131
+							goto l169
+						l170:
+							position, tokenIndex = position169, tokenIndex169
+							if buffer[position] != rune('\t') {
+								goto l171
 							}
 							position++
-							goto l124
-						l131:
-							position, tokenIndex = position124, tokenIndex124
-							if buffer[position] != rune('-') {
-								goto l132
+							goto l169
+						l171:
+							position, tokenIndex = position169, tokenIndex169
+							if buffer[position] != rune('#') {
+								goto l172
 							}
 							position++
-							goto l124
-						l132:
-							position, tokenIndex = position124, tokenIndex124
-							if buffer[position] != rune('*') {
-								goto l133
+							goto l169
+						l172:
+							position, tokenIndex = position169, tokenIndex169
+							if buffer[position] != rune(';') {
+								goto l173
 							}
 							position++
-							goto l124
-						l133:
-							position, tokenIndex = position124, tokenIndex124
-							if buffer[position] != rune('_') {
-								goto l134
+							goto l169
+						l173:
+							position, tokenIndex = position169, tokenIndex169
+							if buffer[position] != rune('\n') {
+								goto l174
 							}
 							position++
-							goto l124
-						l134:
-							position, tokenIndex = position124, tokenIndex124
-							if buffer[position] != rune('@') {
-								goto l135
+							goto l169
+						l174:
+							position, tokenIndex = position169, tokenIndex169
+							if buffer[position] != rune('"') {
+								goto l168
 							}
 							position++
-							goto l124
-						l135:
-							position, tokenIndex = position124, tokenIndex124
-							if buffer[position] != rune('.') {
-								goto l123
+						}
+					l169:
+						goto l162
+					l168:
+						position, tokenIndex = position168, tokenIndex168
+					}
+					if !matchDot() {
+						goto l162
+					}
+				l166:
+					{
+						position167, tokenIndex167 := position, tokenIndex
+						{
+							position175, tokenIndex175 := position, tokenIndex
+							{
+								position176, tokenIndex176 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l177
+								}
+								position++
+								goto l176
+							l177:
+								position, tokenIndex = position176, tokenIndex176
+								if buffer[position] != rune('\t') {
+									goto l178
+								}
+								position++
+								goto l176
+							l178:
+								position, tokenIndex = position176, tokenIndex176
+								if buffer[position] != rune('#') {
+									goto l179
+								}
+								position++
+								goto l176
+							l179:
+								position, tokenIndex = position176, tokenIndex176
+								if buffer[position] != rune(';') {
+									goto l180
+								}
+								position++
+								goto l176
+							l180:
+								position, tokenIndex = position176, tokenIndex176
+								if buffer[position] != rune('\n') {
+									goto l181
+								}
+								position++
+								goto l176
+							l181:
+								position, tokenIndex = position176, tokenIndex176
+								if buffer[position] != rune('"') {
+									goto l175
+								}
+								position++
 							}
-							position++
+						l176:
+							goto l167
+						l175:
+							position, tokenIndex = position175, tokenIndex175
+						}
+						if !matchDot() {
+							goto l167
 						}
-					l124:
-						goto l122
-					l123:
-						position, tokenIndex = position123, tokenIndex123
+						goto l166
+					l167:
+						position, tokenIndex = position167, tokenIndex167
 					}
 				}
-			l120:
-				add(ruleArg, position119)
+			l164:
+				add(ruleFileName, position163)
 			}
 			return true
+		l162:
+			position, tokenIndex = position162, tokenIndex162
+			return false
 		},
-		/* 10 QuotedArg <- <('"' QuotedText '"')> */
+		/* 11 FileMD5 <- <(('m' / 'M') ('d' / 'D') '5' WS Offset)> */
 		func() bool {
-			position136, tokenIndex136 := position, tokenIndex
+			position182, tokenIndex182 := position, tokenIndex
 			{
-				position137 := position
-				if buffer[position] != rune('"') {
-					goto l136
+				position183 := position
+				{
+					position184, tokenIndex184 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l185
+					}
+					position++
+					goto l184
+				l185:
+					position, tokenIndex = position184, tokenIndex184
+					if buffer[position] != rune('M') {
+						goto l182
+					}
+					position++
 				}
-				position++
-				if !_rules[ruleQuotedText]() {
-					goto l136
+			l184:
+				{
+					position186, tokenIndex186 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l187
+					}
+					position++
+					goto l186
+				l187:
+					position, tokenIndex = position186, tokenIndex186
+					if buffer[position] != rune('D') {
+						goto l182
+					}
+					position++
 				}
-				if buffer[position] != rune('"') {
-					goto l136
+			l186:
+				if buffer[position] != rune('5') {
+					goto l182
 				}
 				position++
-				add(ruleQuotedArg, position137)
+				if !_rules[ruleWS]() {
+					goto l182
+				}
+				if !_rules[ruleOffset]() {
+					goto l182
+				}
+				add(ruleFileMD5, position183)
 			}
 			return true
-		l136:
-			position, tokenIndex = position136, tokenIndex136
+		l182:
+			position, tokenIndex = position182, tokenIndex182
 			return false
 		},
-		/* 11 QuotedText <- <(EscapedChar / (!'"' .))*> */
+		/* 12 FileSource <- <(('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E') WS QuotedArg)> */
 		func() bool {
+			position188, tokenIndex188 := position, tokenIndex
 			{
-				position139 := position
-			l140:
+				position189 := position
 				{
-					position141, tokenIndex141 := position, tokenIndex
-					{
-						position142, tokenIndex142 := position, tokenIndex
-						if !_rules[ruleEscapedChar]() {
-							goto l143
-						}
-						goto l142
-					l143:
-						position, tokenIndex = position142, tokenIndex142
-						{
-							position144, tokenIndex144 := position, tokenIndex
-							if buffer[position] != rune('"') {
-								goto l144
-							}
-							position++
-							goto l141
-						l144:
-							position, tokenIndex = position144, tokenIndex144
-						}
-						if !matchDot() {
-							goto l141
-						}
+					position190, tokenIndex190 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l191
 					}
-				l142:
-					goto l140
-				l141:
-					position, tokenIndex = position141, tokenIndex141
+					position++
+					goto l190
+				l191:
+					position, tokenIndex = position190, tokenIndex190
+					if buffer[position] != rune('S') {
+						goto l188
+					}
+					position++
 				}
-				add(ruleQuotedText, position139)
-			}
-			return true
-		},
-		/* 12 LabelContainingDirective <- <(LabelContainingDirectiveName WS SymbolArgs)> */
-		func() bool {
-			position145, tokenIndex145 := position, tokenIndex
-			{
-				position146 := position
-				if !_rules[ruleLabelContainingDirectiveName]() {
-					goto l145
+			l190:
+				{
+					position192, tokenIndex192 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l193
+					}
+					position++
+					goto l192
+				l193:
+					position, tokenIndex = position192, tokenIndex192
+					if buffer[position] != rune('O') {
+						goto l188
+					}
+					position++
+				}
+			l192:
+				{
+					position194, tokenIndex194 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l195
+					}
+					position++
+					goto l194
+				l195:
+					position, tokenIndex = position194, tokenIndex194
+					if buffer[position] != rune('U') {
+						goto l188
+					}
+					position++
+				}
+			l194:
+				{
+					position196, tokenIndex196 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l197
+					}
+					position++
+					goto l196
+				l197:
+					position, tokenIndex = position196, tokenIndex196
+					if buffer[position] != rune('R') {
+						goto l188
+					}
+					position++
+				}
+			l196:
+				{
+					position198, tokenIndex198 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l199
+					}
+					position++
+					goto l198
+				l199:
+					position, tokenIndex = position198, tokenIndex198
+					if buffer[position] != rune('C') {
+						goto l188
+					}
+					position++
+				}
+			l198:
+				{
+					position200, tokenIndex200 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l201
+					}
+					position++
+					goto l200
+				l201:
+					position, tokenIndex = position200, tokenIndex200
+					if buffer[position] != rune('E') {
+						goto l188
+					}
+					position++
 				}
+			l200:
 				if !_rules[ruleWS]() {
-					goto l145
+					goto l188
 				}
-				if !_rules[ruleSymbolArgs]() {
-					goto l145
+				if !_rules[ruleQuotedArg]() {
+					goto l188
 				}
-				add(ruleLabelContainingDirective, position146)
+				add(ruleFileSource, position189)
 			}
 			return true
-		l145:
-			position, tokenIndex = position145, tokenIndex145
+		l188:
+			position, tokenIndex = position188, tokenIndex188
 			return false
 		},
-		/* 13 LabelContainingDirectiveName <- <(('.' ('x' / 'X') ('w' / 'W') ('o' / 'O') ('r' / 'R') ('d' / 'D')) / ('.' ('w' / 'W') ('o' / 'O') ('r' / 'R') ('d' / 'D')) / ('.' ('l' / 'L') ('o' / 'O') ('n' / 'N') ('g' / 'G')) / ('.' ('s' / 'S') ('e' / 'E') ('t' / 'T')) / ('.' '8' ('b' / 'B') ('y' / 'Y') ('t' / 'T') ('e' / 'E')) / ('.' '4' ('b' / 'B') ('y' / 'Y') ('t' / 'T') ('e' / 'E')) / ('.' ('q' / 'Q') ('u' / 'U') ('a' / 'A') ('d' / 'D')) / ('.' ('t' / 'T') ('c' / 'C')) / ('.' ('l' / 'L') ('o' / 'O') ('c' / 'C') ('a' / 'A') ('l' / 'L') ('e' / 'E') ('n' / 'N') ('t' / 'T') ('r' / 'R') ('y' / 'Y')) / ('.' ('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) / ('.' ('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) / ('.' ('u' / 'U') ('l' / 'L') ('e' / 'E') ('b' / 'B') '1' '2' '8') / ('.' ('s' / 'S') ('l' / 'L') ('e' / 'E') ('b' / 'B') '1' '2' '8'))> */
+		/* 13 LocDirective <- <('.' ('l' / 'L') ('o' / 'O') ('c' / 'C') WS (!('#' / '/' / '\n') .)+)> */
 		func() bool {
-			position147, tokenIndex147 := position, tokenIndex
+			position202, tokenIndex202 := position, tokenIndex
 			{
-				position148 := position
-				{
-					position149, tokenIndex149 := position, tokenIndex
+				position203 := position
+				if buffer[position] != rune('.') {
+					goto l202
+				}
+				position++
+				{
+					position204, tokenIndex204 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l205
+					}
+					position++
+					goto l204
+				l205:
+					position, tokenIndex = position204, tokenIndex204
+					if buffer[position] != rune('L') {
+						goto l202
+					}
+					position++
+				}
+			l204:
+				{
+					position206, tokenIndex206 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l207
+					}
+					position++
+					goto l206
+				l207:
+					position, tokenIndex = position206, tokenIndex206
+					if buffer[position] != rune('O') {
+						goto l202
+					}
+					position++
+				}
+			l206:
+				{
+					position208, tokenIndex208 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l209
+					}
+					position++
+					goto l208
+				l209:
+					position, tokenIndex = position208, tokenIndex208
+					if buffer[position] != rune('C') {
+						goto l202
+					}
+					position++
+				}
+			l208:
+				if !_rules[ruleWS]() {
+					goto l202
+				}
+				{
+					position212, tokenIndex212 := position, tokenIndex
+					{
+						position213, tokenIndex213 := position, tokenIndex
+						if buffer[position] != rune('#') {
+							goto l214
+						}
+						position++
+						goto l213
+					l214:
+						position, tokenIndex = position213, tokenIndex213
+						if buffer[position] != rune('/') {
+							goto l215
+						}
+						position++
+						goto l213
+					l215:
+						position, tokenIndex = position213, tokenIndex213
+						if buffer[position] != rune('\n') {
+							goto l212
+						}
+						position++
+					}
+				l213:
+					goto l202
+				l212:
+					position, tokenIndex = position212, tokenIndex212
+				}
+				if !matchDot() {
+					goto l202
+				}
+			l210:
+				{
+					position211, tokenIndex211 := position, tokenIndex
+					{
+						position216, tokenIndex216 := position, tokenIndex
+						{
+							position217, tokenIndex217 := position, tokenIndex
+							if buffer[position] != rune('#') {
+								goto l218
+							}
+							position++
+							goto l217
+						l218:
+							position, tokenIndex = position217, tokenIndex217
+							if buffer[position] != rune('/') {
+								goto l219
+							}
+							position++
+							goto l217
+						l219:
+							position, tokenIndex = position217, tokenIndex217
+							if buffer[position] != rune('\n') {
+								goto l216
+							}
+							position++
+						}
+					l217:
+						goto l211
+					l216:
+						position, tokenIndex = position216, tokenIndex216
+					}
+					if !matchDot() {
+						goto l211
+					}
+					goto l210
+				l211:
+					position, tokenIndex = position211, tokenIndex211
+				}
+				add(ruleLocDirective, position203)
+			}
+			return true
+		l202:
+			position, tokenIndex = position202, tokenIndex202
+			return false
+		},
+		/* 14 CFIDirective <- <(CFIStartProcDirective / CFIEndProcDirective / CFIDefCFAOffsetDirective / CFIAdjustCFAOffsetDirective / CFINoArgDirective / CFIReturnColumnDirective / CFIUndefinedDirective / CFIEscapeDirective / CFIExpressionDirective / CFIValEncodedAddrDirective / CFILabelDirective / CFIPersonalityIDDirective)> */
+		func() bool {
+			position220, tokenIndex220 := position, tokenIndex
+			{
+				position221 := position
+				{
+					position222, tokenIndex222 := position, tokenIndex
+					if !_rules[ruleCFIStartProcDirective]() {
+						goto l223
+					}
+					goto l222
+				l223:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIEndProcDirective]() {
+						goto l224
+					}
+					goto l222
+				l224:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIDefCFAOffsetDirective]() {
+						goto l225
+					}
+					goto l222
+				l225:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIAdjustCFAOffsetDirective]() {
+						goto l226
+					}
+					goto l222
+				l226:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFINoArgDirective]() {
+						goto l227
+					}
+					goto l222
+				l227:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIReturnColumnDirective]() {
+						goto l228
+					}
+					goto l222
+				l228:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIUndefinedDirective]() {
+						goto l229
+					}
+					goto l222
+				l229:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIEscapeDirective]() {
+						goto l230
+					}
+					goto l222
+				l230:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIExpressionDirective]() {
+						goto l231
+					}
+					goto l222
+				l231:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIValEncodedAddrDirective]() {
+						goto l232
+					}
+					goto l222
+				l232:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFILabelDirective]() {
+						goto l233
+					}
+					goto l222
+				l233:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleCFIPersonalityIDDirective]() {
+						goto l220
+					}
+				}
+			l222:
+				add(ruleCFIDirective, position221)
+			}
+			return true
+		l220:
+			position, tokenIndex = position220, tokenIndex220
+			return false
+		},
+		/* 15 CFIStartProcDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('p' / 'P') ('r' / 'R') ('o' / 'O') ('c' / 'C') !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_') (WS (('s' / 'S') ('i' / 'I') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E')) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))?)> */
+		func() bool {
+			position234, tokenIndex234 := position, tokenIndex
+			{
+				position235 := position
+				if buffer[position] != rune('.') {
+					goto l234
+				}
+				position++
+				{
+					position236, tokenIndex236 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l237
+					}
+					position++
+					goto l236
+				l237:
+					position, tokenIndex = position236, tokenIndex236
+					if buffer[position] != rune('C') {
+						goto l234
+					}
+					position++
+				}
+			l236:
+				{
+					position238, tokenIndex238 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l239
+					}
+					position++
+					goto l238
+				l239:
+					position, tokenIndex = position238, tokenIndex238
+					if buffer[position] != rune('F') {
+						goto l234
+					}
+					position++
+				}
+			l238:
+				{
+					position240, tokenIndex240 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l241
+					}
+					position++
+					goto l240
+				l241:
+					position, tokenIndex = position240, tokenIndex240
+					if buffer[position] != rune('I') {
+						goto l234
+					}
+					position++
+				}
+			l240:
+				if buffer[position] != rune('_') {
+					goto l234
+				}
+				position++
+				{
+					position242, tokenIndex242 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l243
+					}
+					position++
+					goto l242
+				l243:
+					position, tokenIndex = position242, tokenIndex242
+					if buffer[position] != rune('S') {
+						goto l234
+					}
+					position++
+				}
+			l242:
+				{
+					position244, tokenIndex244 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l245
+					}
+					position++
+					goto l244
+				l245:
+					position, tokenIndex = position244, tokenIndex244
+					if buffer[position] != rune('T') {
+						goto l234
+					}
+					position++
+				}
+			l244:
+				{
+					position246, tokenIndex246 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l247
+					}
+					position++
+					goto l246
+				l247:
+					position, tokenIndex = position246, tokenIndex246
+					if buffer[position] != rune('A') {
+						goto l234
+					}
+					position++
+				}
+			l246:
+				{
+					position248, tokenIndex248 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l249
+					}
+					position++
+					goto l248
+				l249:
+					position, tokenIndex = position248, tokenIndex248
+					if buffer[position] != rune('R') {
+						goto l234
+					}
+					position++
+				}
+			l248:
+				{
+					position250, tokenIndex250 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l251
+					}
+					position++
+					goto l250
+				l251:
+					position, tokenIndex = position250, tokenIndex250
+					if buffer[position] != rune('T') {
+						goto l234
+					}
+					position++
+				}
+			l250:
+				{
+					position252, tokenIndex252 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l253
+					}
+					position++
+					goto l252
+				l253:
+					position, tokenIndex = position252, tokenIndex252
+					if buffer[position] != rune('P') {
+						goto l234
+					}
+					position++
+				}
+			l252:
+				{
+					position254, tokenIndex254 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l255
+					}
+					position++
+					goto l254
+				l255:
+					position, tokenIndex = position254, tokenIndex254
+					if buffer[position] != rune('R') {
+						goto l234
+					}
+					position++
+				}
+			l254:
+				{
+					position256, tokenIndex256 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l257
+					}
+					position++
+					goto l256
+				l257:
+					position, tokenIndex = position256, tokenIndex256
+					if buffer[position] != rune('O') {
+						goto l234
+					}
+					position++
+				}
+			l256:
+				{
+					position258, tokenIndex258 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l259
+					}
+					position++
+					goto l258
+				l259:
+					position, tokenIndex = position258, tokenIndex258
+					if buffer[position] != rune('C') {
+						goto l234
+					}
+					position++
+				}
+			l258:
+				{
+					position260, tokenIndex260 := position, tokenIndex
+					{
+						position261, tokenIndex261 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l262
+						}
+						position++
+						goto l261
+					l262:
+						position, tokenIndex = position261, tokenIndex261
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l263
+						}
+						position++
+						goto l261
+					l263:
+						position, tokenIndex = position261, tokenIndex261
+						{
+							position265, tokenIndex265 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l266
+							}
+							position++
+							goto l265
+						l266:
+							position, tokenIndex = position265, tokenIndex265
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l264
+							}
+							position++
+						}
+					l265:
+						goto l261
+					l264:
+						position, tokenIndex = position261, tokenIndex261
+						if buffer[position] != rune('_') {
+							goto l260
+						}
+						position++
+					}
+				l261:
+					goto l234
+				l260:
+					position, tokenIndex = position260, tokenIndex260
+				}
+				{
+					position267, tokenIndex267 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l267
+					}
+					{
+						position269, tokenIndex269 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l270
+						}
+						position++
+						goto l269
+					l270:
+						position, tokenIndex = position269, tokenIndex269
+						if buffer[position] != rune('S') {
+							goto l267
+						}
+						position++
+					}
+				l269:
+					{
+						position271, tokenIndex271 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l272
+						}
+						position++
+						goto l271
+					l272:
+						position, tokenIndex = position271, tokenIndex271
+						if buffer[position] != rune('I') {
+							goto l267
+						}
+						position++
+					}
+				l271:
+					{
+						position273, tokenIndex273 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l274
+						}
+						position++
+						goto l273
+					l274:
+						position, tokenIndex = position273, tokenIndex273
+						if buffer[position] != rune('M') {
+							goto l267
+						}
+						position++
+					}
+				l273:
+					{
+						position275, tokenIndex275 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l276
+						}
+						position++
+						goto l275
+					l276:
+						position, tokenIndex = position275, tokenIndex275
+						if buffer[position] != rune('P') {
+							goto l267
+						}
+						position++
+					}
+				l275:
+					{
+						position277, tokenIndex277 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l278
+						}
+						position++
+						goto l277
+					l278:
+						position, tokenIndex = position277, tokenIndex277
+						if buffer[position] != rune('L') {
+							goto l267
+						}
+						position++
+					}
+				l277:
+					{
+						position279, tokenIndex279 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l280
+						}
+						position++
+						goto l279
+					l280:
+						position, tokenIndex = position279, tokenIndex279
+						if buffer[position] != rune('E') {
+							goto l267
+						}
+						position++
+					}
+				l279:
+					{
+						position281, tokenIndex281 := position, tokenIndex
+						{
+							position282, tokenIndex282 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l283
+							}
+							position++
+							goto l282
+						l283:
+							position, tokenIndex = position282, tokenIndex282
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l284
+							}
+							position++
+							goto l282
+						l284:
+							position, tokenIndex = position282, tokenIndex282
+							{
+								position286, tokenIndex286 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l287
+								}
+								position++
+								goto l286
+							l287:
+								position, tokenIndex = position286, tokenIndex286
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l285
+								}
+								position++
+							}
+						l286:
+							goto l282
+						l285:
+							position, tokenIndex = position282, tokenIndex282
+							if buffer[position] != rune('_') {
+								goto l281
+							}
+							position++
+						}
+					l282:
+						goto l267
+					l281:
+						position, tokenIndex = position281, tokenIndex281
+					}
+					goto l268
+				l267:
+					position, tokenIndex = position267, tokenIndex267
+				}
+			l268:
+				add(ruleCFIStartProcDirective, position235)
+			}
+			return true
+		l234:
+			position, tokenIndex = position234, tokenIndex234
+			return false
+		},
+		/* 16 CFIEndProcDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('e' / 'E') ('n' / 'N') ('d' / 'D') ('p' / 'P') ('r' / 'R') ('o' / 'O') ('c' / 'C') !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position288, tokenIndex288 := position, tokenIndex
+			{
+				position289 := position
+				if buffer[position] != rune('.') {
+					goto l288
+				}
+				position++
+				{
+					position290, tokenIndex290 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l291
+					}
+					position++
+					goto l290
+				l291:
+					position, tokenIndex = position290, tokenIndex290
+					if buffer[position] != rune('C') {
+						goto l288
+					}
+					position++
+				}
+			l290:
+				{
+					position292, tokenIndex292 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l293
+					}
+					position++
+					goto l292
+				l293:
+					position, tokenIndex = position292, tokenIndex292
+					if buffer[position] != rune('F') {
+						goto l288
+					}
+					position++
+				}
+			l292:
+				{
+					position294, tokenIndex294 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l295
+					}
+					position++
+					goto l294
+				l295:
+					position, tokenIndex = position294, tokenIndex294
+					if buffer[position] != rune('I') {
+						goto l288
+					}
+					position++
+				}
+			l294:
+				if buffer[position] != rune('_') {
+					goto l288
+				}
+				position++
+				{
+					position296, tokenIndex296 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l297
+					}
+					position++
+					goto l296
+				l297:
+					position, tokenIndex = position296, tokenIndex296
+					if buffer[position] != rune('E') {
+						goto l288
+					}
+					position++
+				}
+			l296:
+				{
+					position298, tokenIndex298 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l299
+					}
+					position++
+					goto l298
+				l299:
+					position, tokenIndex = position298, tokenIndex298
+					if buffer[position] != rune('N') {
+						goto l288
+					}
+					position++
+				}
+			l298:
+				{
+					position300, tokenIndex300 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l301
+					}
+					position++
+					goto l300
+				l301:
+					position, tokenIndex = position300, tokenIndex300
+					if buffer[position] != rune('D') {
+						goto l288
+					}
+					position++
+				}
+			l300:
+				{
+					position302, tokenIndex302 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l303
+					}
+					position++
+					goto l302
+				l303:
+					position, tokenIndex = position302, tokenIndex302
+					if buffer[position] != rune('P') {
+						goto l288
+					}
+					position++
+				}
+			l302:
+				{
+					position304, tokenIndex304 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l305
+					}
+					position++
+					goto l304
+				l305:
+					position, tokenIndex = position304, tokenIndex304
+					if buffer[position] != rune('R') {
+						goto l288
+					}
+					position++
+				}
+			l304:
+				{
+					position306, tokenIndex306 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l307
+					}
+					position++
+					goto l306
+				l307:
+					position, tokenIndex = position306, tokenIndex306
+					if buffer[position] != rune('O') {
+						goto l288
+					}
+					position++
+				}
+			l306:
+				{
+					position308, tokenIndex308 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l309
+					}
+					position++
+					goto l308
+				l309:
+					position, tokenIndex = position308, tokenIndex308
+					if buffer[position] != rune('C') {
+						goto l288
+					}
+					position++
+				}
+			l308:
+				{
+					position310, tokenIndex310 := position, tokenIndex
+					{
+						position311, tokenIndex311 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l312
+						}
+						position++
+						goto l311
+					l312:
+						position, tokenIndex = position311, tokenIndex311
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l313
+						}
+						position++
+						goto l311
+					l313:
+						position, tokenIndex = position311, tokenIndex311
+						{
+							position315, tokenIndex315 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l316
+							}
+							position++
+							goto l315
+						l316:
+							position, tokenIndex = position315, tokenIndex315
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l314
+							}
+							position++
+						}
+					l315:
+						goto l311
+					l314:
+						position, tokenIndex = position311, tokenIndex311
+						if buffer[position] != rune('_') {
+							goto l310
+						}
+						position++
+					}
+				l311:
+					goto l288
+				l310:
+					position, tokenIndex = position310, tokenIndex310
+				}
+				add(ruleCFIEndProcDirective, position289)
+			}
+			return true
+		l288:
+			position, tokenIndex = position288, tokenIndex288
+			return false
+		},
+		/* 17 CFIDefCFAOffsetDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('d' / 'D') ('e' / 'E') ('f' / 'F') '_' ('c' / 'C') ('f' / 'F') ('a' / 'A') '_' ('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') WS Expression &(WS? (Comment / '\n' / ';')))> */
+		func() bool {
+			position317, tokenIndex317 := position, tokenIndex
+			{
+				position318 := position
+				if buffer[position] != rune('.') {
+					goto l317
+				}
+				position++
+				{
+					position319, tokenIndex319 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l320
+					}
+					position++
+					goto l319
+				l320:
+					position, tokenIndex = position319, tokenIndex319
+					if buffer[position] != rune('C') {
+						goto l317
+					}
+					position++
+				}
+			l319:
+				{
+					position321, tokenIndex321 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l322
+					}
+					position++
+					goto l321
+				l322:
+					position, tokenIndex = position321, tokenIndex321
+					if buffer[position] != rune('F') {
+						goto l317
+					}
+					position++
+				}
+			l321:
+				{
+					position323, tokenIndex323 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l324
+					}
+					position++
+					goto l323
+				l324:
+					position, tokenIndex = position323, tokenIndex323
+					if buffer[position] != rune('I') {
+						goto l317
+					}
+					position++
+				}
+			l323:
+				if buffer[position] != rune('_') {
+					goto l317
+				}
+				position++
+				{
+					position325, tokenIndex325 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l326
+					}
+					position++
+					goto l325
+				l326:
+					position, tokenIndex = position325, tokenIndex325
+					if buffer[position] != rune('D') {
+						goto l317
+					}
+					position++
+				}
+			l325:
+				{
+					position327, tokenIndex327 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l328
+					}
+					position++
+					goto l327
+				l328:
+					position, tokenIndex = position327, tokenIndex327
+					if buffer[position] != rune('E') {
+						goto l317
+					}
+					position++
+				}
+			l327:
+				{
+					position329, tokenIndex329 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l330
+					}
+					position++
+					goto l329
+				l330:
+					position, tokenIndex = position329, tokenIndex329
+					if buffer[position] != rune('F') {
+						goto l317
+					}
+					position++
+				}
+			l329:
+				if buffer[position] != rune('_') {
+					goto l317
+				}
+				position++
+				{
+					position331, tokenIndex331 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l332
+					}
+					position++
+					goto l331
+				l332:
+					position, tokenIndex = position331, tokenIndex331
+					if buffer[position] != rune('C') {
+						goto l317
+					}
+					position++
+				}
+			l331:
+				{
+					position333, tokenIndex333 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l334
+					}
+					position++
+					goto l333
+				l334:
+					position, tokenIndex = position333, tokenIndex333
+					if buffer[position] != rune('F') {
+						goto l317
+					}
+					position++
+				}
+			l333:
+				{
+					position335, tokenIndex335 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l336
+					}
+					position++
+					goto l335
+				l336:
+					position, tokenIndex = position335, tokenIndex335
+					if buffer[position] != rune('A') {
+						goto l317
+					}
+					position++
+				}
+			l335:
+				if buffer[position] != rune('_') {
+					goto l317
+				}
+				position++
+				{
+					position337, tokenIndex337 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l338
+					}
+					position++
+					goto l337
+				l338:
+					position, tokenIndex = position337, tokenIndex337
+					if buffer[position] != rune('O') {
+						goto l317
+					}
+					position++
+				}
+			l337:
+				{
+					position339, tokenIndex339 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l340
+					}
+					position++
+					goto l339
+				l340:
+					position, tokenIndex = position339, tokenIndex339
+					if buffer[position] != rune('F') {
+						goto l317
+					}
+					position++
+				}
+			l339:
+				{
+					position341, tokenIndex341 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l342
+					}
+					position++
+					goto l341
+				l342:
+					position, tokenIndex = position341, tokenIndex341
+					if buffer[position] != rune('F') {
+						goto l317
+					}
+					position++
+				}
+			l341:
+				{
+					position343, tokenIndex343 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l344
+					}
+					position++
+					goto l343
+				l344:
+					position, tokenIndex = position343, tokenIndex343
+					if buffer[position] != rune('S') {
+						goto l317
+					}
+					position++
+				}
+			l343:
+				{
+					position345, tokenIndex345 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l346
+					}
+					position++
+					goto l345
+				l346:
+					position, tokenIndex = position345, tokenIndex345
+					if buffer[position] != rune('E') {
+						goto l317
+					}
+					position++
+				}
+			l345:
+				{
+					position347, tokenIndex347 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l348
+					}
+					position++
+					goto l347
+				l348:
+					position, tokenIndex = position347, tokenIndex347
+					if buffer[position] != rune('T') {
+						goto l317
+					}
+					position++
+				}
+			l347:
+				if !_rules[ruleWS]() {
+					goto l317
+				}
+				if !_rules[ruleExpression]() {
+					goto l317
+				}
+				position349, tokenIndex349 := position, tokenIndex
+				{
+					position350, tokenIndex350 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l350
+					}
+					goto l351
+				l350:
+					position, tokenIndex = position350, tokenIndex350
+				}
+			l351:
+				{
+					position352, tokenIndex352 := position, tokenIndex
+					if !_rules[ruleComment]() {
+						goto l353
+					}
+					goto l352
+				l353:
+					position, tokenIndex = position352, tokenIndex352
+					if buffer[position] != rune('\n') {
+						goto l354
+					}
+					position++
+					goto l352
+				l354:
+					position, tokenIndex = position352, tokenIndex352
+					if buffer[position] != rune(';') {
+						goto l317
+					}
+					position++
+				}
+			l352:
+				position, tokenIndex = position349, tokenIndex349
+				add(ruleCFIDefCFAOffsetDirective, position318)
+			}
+			return true
+		l317:
+			position, tokenIndex = position317, tokenIndex317
+			return false
+		},
+		/* 18 CFIAdjustCFAOffsetDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('a' / 'A') ('d' / 'D') ('j' / 'J') ('u' / 'U') ('s' / 'S') ('t' / 'T') '_' ('c' / 'C') ('f' / 'F') ('a' / 'A') '_' ('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') WS Expression &(WS? (Comment / '\n' / ';')))> */
+		func() bool {
+			position355, tokenIndex355 := position, tokenIndex
+			{
+				position356 := position
+				if buffer[position] != rune('.') {
+					goto l355
+				}
+				position++
+				{
+					position357, tokenIndex357 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l358
+					}
+					position++
+					goto l357
+				l358:
+					position, tokenIndex = position357, tokenIndex357
+					if buffer[position] != rune('C') {
+						goto l355
+					}
+					position++
+				}
+			l357:
+				{
+					position359, tokenIndex359 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l360
+					}
+					position++
+					goto l359
+				l360:
+					position, tokenIndex = position359, tokenIndex359
+					if buffer[position] != rune('F') {
+						goto l355
+					}
+					position++
+				}
+			l359:
+				{
+					position361, tokenIndex361 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l362
+					}
+					position++
+					goto l361
+				l362:
+					position, tokenIndex = position361, tokenIndex361
+					if buffer[position] != rune('I') {
+						goto l355
+					}
+					position++
+				}
+			l361:
+				if buffer[position] != rune('_') {
+					goto l355
+				}
+				position++
+				{
+					position363, tokenIndex363 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l364
+					}
+					position++
+					goto l363
+				l364:
+					position, tokenIndex = position363, tokenIndex363
+					if buffer[position] != rune('A') {
+						goto l355
+					}
+					position++
+				}
+			l363:
+				{
+					position365, tokenIndex365 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l366
+					}
+					position++
+					goto l365
+				l366:
+					position, tokenIndex = position365, tokenIndex365
+					if buffer[position] != rune('D') {
+						goto l355
+					}
+					position++
+				}
+			l365:
+				{
+					position367, tokenIndex367 := position, tokenIndex
+					if buffer[position] != rune('j') {
+						goto l368
+					}
+					position++
+					goto l367
+				l368:
+					position, tokenIndex = position367, tokenIndex367
+					if buffer[position] != rune('J') {
+						goto l355
+					}
+					position++
+				}
+			l367:
+				{
+					position369, tokenIndex369 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l370
+					}
+					position++
+					goto l369
+				l370:
+					position, tokenIndex = position369, tokenIndex369
+					if buffer[position] != rune('U') {
+						goto l355
+					}
+					position++
+				}
+			l369:
+				{
+					position371, tokenIndex371 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l372
+					}
+					position++
+					goto l371
+				l372:
+					position, tokenIndex = position371, tokenIndex371
+					if buffer[position] != rune('S') {
+						goto l355
+					}
+					position++
+				}
+			l371:
+				{
+					position373, tokenIndex373 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l374
+					}
+					position++
+					goto l373
+				l374:
+					position, tokenIndex = position373, tokenIndex373
+					if buffer[position] != rune('T') {
+						goto l355
+					}
+					position++
+				}
+			l373:
+				if buffer[position] != rune('_') {
+					goto l355
+				}
+				position++
+				{
+					position375, tokenIndex375 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l376
+					}
+					position++
+					goto l375
+				l376:
+					position, tokenIndex = position375, tokenIndex375
+					if buffer[position] != rune('C') {
+						goto l355
+					}
+					position++
+				}
+			l375:
+				{
+					position377, tokenIndex377 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l378
+					}
+					position++
+					goto l377
+				l378:
+					position, tokenIndex = position377, tokenIndex377
+					if buffer[position] != rune('F') {
+						goto l355
+					}
+					position++
+				}
+			l377:
+				{
+					position379, tokenIndex379 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l380
+					}
+					position++
+					goto l379
+				l380:
+					position, tokenIndex = position379, tokenIndex379
+					if buffer[position] != rune('A') {
+						goto l355
+					}
+					position++
+				}
+			l379:
+				if buffer[position] != rune('_') {
+					goto l355
+				}
+				position++
+				{
+					position381, tokenIndex381 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l382
+					}
+					position++
+					goto l381
+				l382:
+					position, tokenIndex = position381, tokenIndex381
+					if buffer[position] != rune('O') {
+						goto l355
+					}
+					position++
+				}
+			l381:
+				{
+					position383, tokenIndex383 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l384
+					}
+					position++
+					goto l383
+				l384:
+					position, tokenIndex = position383, tokenIndex383
+					if buffer[position] != rune('F') {
+						goto l355
+					}
+					position++
+				}
+			l383:
+				{
+					position385, tokenIndex385 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l386
+					}
+					position++
+					goto l385
+				l386:
+					position, tokenIndex = position385, tokenIndex385
+					if buffer[position] != rune('F') {
+						goto l355
+					}
+					position++
+				}
+			l385:
+				{
+					position387, tokenIndex387 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l388
+					}
+					position++
+					goto l387
+				l388:
+					position, tokenIndex = position387, tokenIndex387
+					if buffer[position] != rune('S') {
+						goto l355
+					}
+					position++
+				}
+			l387:
+				{
+					position389, tokenIndex389 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l390
+					}
+					position++
+					goto l389
+				l390:
+					position, tokenIndex = position389, tokenIndex389
+					if buffer[position] != rune('E') {
+						goto l355
+					}
+					position++
+				}
+			l389:
+				{
+					position391, tokenIndex391 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l392
+					}
+					position++
+					goto l391
+				l392:
+					position, tokenIndex = position391, tokenIndex391
+					if buffer[position] != rune('T') {
+						goto l355
+					}
+					position++
+				}
+			l391:
+				if !_rules[ruleWS]() {
+					goto l355
+				}
+				if !_rules[ruleExpression]() {
+					goto l355
+				}
+				position393, tokenIndex393 := position, tokenIndex
+				{
+					position394, tokenIndex394 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l394
+					}
+					goto l395
+				l394:
+					position, tokenIndex = position394, tokenIndex394
+				}
+			l395:
+				{
+					position396, tokenIndex396 := position, tokenIndex
+					if !_rules[ruleComment]() {
+						goto l397
+					}
+					goto l396
+				l397:
+					position, tokenIndex = position396, tokenIndex396
+					if buffer[position] != rune('\n') {
+						goto l398
+					}
+					position++
+					goto l396
+				l398:
+					position, tokenIndex = position396, tokenIndex396
+					if buffer[position] != rune(';') {
+						goto l355
+					}
+					position++
+				}
+			l396:
+				position, tokenIndex = position393, tokenIndex393
+				add(ruleCFIAdjustCFAOffsetDirective, position356)
+			}
+			return true
+		l355:
+			position, tokenIndex = position355, tokenIndex355
+			return false
+		},
+		/* 19 CFINoArgDirective <- <((('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('s' / 'S') ('i' / 'I') ('g' / 'G') ('n' / 'N') ('a' / 'A') ('l' / 'L') '_' ('f' / 'F') ('r' / 'R') ('a' / 'A') ('m' / 'M') ('e' / 'E')) / ('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('m' / 'M') ('t' / 'T') ('e' / 'E') '_' ('t' / 'T') ('a' / 'A') ('g' / 'G') ('g' / 'G') ('e' / 'E') ('d' / 'D') '_' ('f' / 'F') ('r' / 'R') ('a' / 'A') ('m' / 'M') ('e' / 'E')) / ('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('n' / 'N') ('e' / 'E') ('g' / 'G') ('a' / 'A') ('t' / 'T') ('e' / 'E') '_' ('r' / 'R') ('a' / 'A') '_' ('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E') '_' ('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H') '_' ('p' / 'P') ('c' / 'C')) / ('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('n' / 'N') ('e' / 'E') ('g' / 'G') ('a' / 'A') ('t' / 'T') ('e' / 'E') '_' ('r' / 'R') ('a' / 'A') '_' ('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) / ('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('b' / 'B') '_' ('k' / 'K') ('e' / 'E') ('y' / 'Y') '_' ('f' / 'F') ('r' / 'R') ('a' / 'A') ('m' / 'M') ('e' / 'E'))) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position399, tokenIndex399 := position, tokenIndex
+			{
+				position400 := position
+				{
+					position401, tokenIndex401 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l402
+					}
+					position++
+					{
+						position403, tokenIndex403 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l404
+						}
+						position++
+						goto l403
+					l404:
+						position, tokenIndex = position403, tokenIndex403
+						if buffer[position] != rune('C') {
+							goto l402
+						}
+						position++
+					}
+				l403:
+					{
+						position405, tokenIndex405 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l406
+						}
+						position++
+						goto l405
+					l406:
+						position, tokenIndex = position405, tokenIndex405
+						if buffer[position] != rune('F') {
+							goto l402
+						}
+						position++
+					}
+				l405:
+					{
+						position407, tokenIndex407 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l408
+						}
+						position++
+						goto l407
+					l408:
+						position, tokenIndex = position407, tokenIndex407
+						if buffer[position] != rune('I') {
+							goto l402
+						}
+						position++
+					}
+				l407:
+					if buffer[position] != rune('_') {
+						goto l402
+					}
+					position++
+					{
+						position409, tokenIndex409 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l410
+						}
+						position++
+						goto l409
+					l410:
+						position, tokenIndex = position409, tokenIndex409
+						if buffer[position] != rune('S') {
+							goto l402
+						}
+						position++
+					}
+				l409:
+					{
+						position411, tokenIndex411 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l412
+						}
+						position++
+						goto l411
+					l412:
+						position, tokenIndex = position411, tokenIndex411
+						if buffer[position] != rune('I') {
+							goto l402
+						}
+						position++
+					}
+				l411:
+					{
+						position413, tokenIndex413 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l414
+						}
+						position++
+						goto l413
+					l414:
+						position, tokenIndex = position413, tokenIndex413
+						if buffer[position] != rune('G') {
+							goto l402
+						}
+						position++
+					}
+				l413:
+					{
+						position415, tokenIndex415 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l416
+						}
+						position++
+						goto l415
+					l416:
+						position, tokenIndex = position415, tokenIndex415
+						if buffer[position] != rune('N') {
+							goto l402
+						}
+						position++
+					}
+				l415:
+					{
+						position417, tokenIndex417 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l418
+						}
+						position++
+						goto l417
+					l418:
+						position, tokenIndex = position417, tokenIndex417
+						if buffer[position] != rune('A') {
+							goto l402
+						}
+						position++
+					}
+				l417:
+					{
+						position419, tokenIndex419 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l420
+						}
+						position++
+						goto l419
+					l420:
+						position, tokenIndex = position419, tokenIndex419
+						if buffer[position] != rune('L') {
+							goto l402
+						}
+						position++
+					}
+				l419:
+					if buffer[position] != rune('_') {
+						goto l402
+					}
+					position++
+					{
+						position421, tokenIndex421 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l422
+						}
+						position++
+						goto l421
+					l422:
+						position, tokenIndex = position421, tokenIndex421
+						if buffer[position] != rune('F') {
+							goto l402
+						}
+						position++
+					}
+				l421:
+					{
+						position423, tokenIndex423 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l424
+						}
+						position++
+						goto l423
+					l424:
+						position, tokenIndex = position423, tokenIndex423
+						if buffer[position] != rune('R') {
+							goto l402
+						}
+						position++
+					}
+				l423:
+					{
+						position425, tokenIndex425 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l426
+						}
+						position++
+						goto l425
+					l426:
+						position, tokenIndex = position425, tokenIndex425
+						if buffer[position] != rune('A') {
+							goto l402
+						}
+						position++
+					}
+				l425:
+					{
+						position427, tokenIndex427 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l428
+						}
+						position++
+						goto l427
+					l428:
+						position, tokenIndex = position427, tokenIndex427
+						if buffer[position] != rune('M') {
+							goto l402
+						}
+						position++
+					}
+				l427:
+					{
+						position429, tokenIndex429 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l430
+						}
+						position++
+						goto l429
+					l430:
+						position, tokenIndex = position429, tokenIndex429
+						if buffer[position] != rune('E') {
+							goto l402
+						}
+						position++
+					}
+				l429:
+					goto l401
+				l402:
+					position, tokenIndex = position401, tokenIndex401
+					if buffer[position] != rune('.') {
+						goto l431
+					}
+					position++
+					{
+						position432, tokenIndex432 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l433
+						}
+						position++
+						goto l432
+					l433:
+						position, tokenIndex = position432, tokenIndex432
+						if buffer[position] != rune('C') {
+							goto l431
+						}
+						position++
+					}
+				l432:
+					{
+						position434, tokenIndex434 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l435
+						}
+						position++
+						goto l434
+					l435:
+						position, tokenIndex = position434, tokenIndex434
+						if buffer[position] != rune('F') {
+							goto l431
+						}
+						position++
+					}
+				l434:
+					{
+						position436, tokenIndex436 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l437
+						}
+						position++
+						goto l436
+					l437:
+						position, tokenIndex = position436, tokenIndex436
+						if buffer[position] != rune('I') {
+							goto l431
+						}
+						position++
+					}
+				l436:
+					if buffer[position] != rune('_') {
+						goto l431
+					}
+					position++
+					{
+						position438, tokenIndex438 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l439
+						}
+						position++
+						goto l438
+					l439:
+						position, tokenIndex = position438, tokenIndex438
+						if buffer[position] != rune('M') {
+							goto l431
+						}
+						position++
+					}
+				l438:
+					{
+						position440, tokenIndex440 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l441
+						}
+						position++
+						goto l440
+					l441:
+						position, tokenIndex = position440, tokenIndex440
+						if buffer[position] != rune('T') {
+							goto l431
+						}
+						position++
+					}
+				l440:
+					{
+						position442, tokenIndex442 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l443
+						}
+						position++
+						goto l442
+					l443:
+						position, tokenIndex = position442, tokenIndex442
+						if buffer[position] != rune('E') {
+							goto l431
+						}
+						position++
+					}
+				l442:
+					if buffer[position] != rune('_') {
+						goto l431
+					}
+					position++
+					{
+						position444, tokenIndex444 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l445
+						}
+						position++
+						goto l444
+					l445:
+						position, tokenIndex = position444, tokenIndex444
+						if buffer[position] != rune('T') {
+							goto l431
+						}
+						position++
+					}
+				l444:
+					{
+						position446, tokenIndex446 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l447
+						}
+						position++
+						goto l446
+					l447:
+						position, tokenIndex = position446, tokenIndex446
+						if buffer[position] != rune('A') {
+							goto l431
+						}
+						position++
+					}
+				l446:
+					{
+						position448, tokenIndex448 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l449
+						}
+						position++
+						goto l448
+					l449:
+						position, tokenIndex = position448, tokenIndex448
+						if buffer[position] != rune('G') {
+							goto l431
+						}
+						position++
+					}
+				l448:
+					{
+						position450, tokenIndex450 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l451
+						}
+						position++
+						goto l450
+					l451:
+						position, tokenIndex = position450, tokenIndex450
+						if buffer[position] != rune('G') {
+							goto l431
+						}
+						position++
+					}
+				l450:
+					{
+						position452, tokenIndex452 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l453
+						}
+						position++
+						goto l452
+					l453:
+						position, tokenIndex = position452, tokenIndex452
+						if buffer[position] != rune('E') {
+							goto l431
+						}
+						position++
+					}
+				l452:
+					{
+						position454, tokenIndex454 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l455
+						}
+						position++
+						goto l454
+					l455:
+						position, tokenIndex = position454, tokenIndex454
+						if buffer[position] != rune('D') {
+							goto l431
+						}
+						position++
+					}
+				l454:
+					if buffer[position] != rune('_') {
+						goto l431
+					}
+					position++
+					{
+						position456, tokenIndex456 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l457
+						}
+						position++
+						goto l456
+					l457:
+						position, tokenIndex = position456, tokenIndex456
+						if buffer[position] != rune('F') {
+							goto l431
+						}
+						position++
+					}
+				l456:
+					{
+						position458, tokenIndex458 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l459
+						}
+						position++
+						goto l458
+					l459:
+						position, tokenIndex = position458, tokenIndex458
+						if buffer[position] != rune('R') {
+							goto l431
+						}
+						position++
+					}
+				l458:
+					{
+						position460, tokenIndex460 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l461
+						}
+						position++
+						goto l460
+					l461:
+						position, tokenIndex = position460, tokenIndex460
+						if buffer[position] != rune('A') {
+							goto l431
+						}
+						position++
+					}
+				l460:
+					{
+						position462, tokenIndex462 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l463
+						}
+						position++
+						goto l462
+					l463:
+						position, tokenIndex = position462, tokenIndex462
+						if buffer[position] != rune('M') {
+							goto l431
+						}
+						position++
+					}
+				l462:
+					{
+						position464, tokenIndex464 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l465
+						}
+						position++
+						goto l464
+					l465:
+						position, tokenIndex = position464, tokenIndex464
+						if buffer[position] != rune('E') {
+							goto l431
+						}
+						position++
+					}
+				l464:
+					goto l401
+				l431:
+					position, tokenIndex = position401, tokenIndex401
+					if buffer[position] != rune('.') {
+						goto l466
+					}
+					position++
+					{
+						position467, tokenIndex467 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l468
+						}
+						position++
+						goto l467
+					l468:
+						position, tokenIndex = position467, tokenIndex467
+						if buffer[position] != rune('C') {
+							goto l466
+						}
+						position++
+					}
+				l467:
+					{
+						position469, tokenIndex469 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l470
+						}
+						position++
+						goto l469
+					l470:
+						position, tokenIndex = position469, tokenIndex469
+						if buffer[position] != rune('F') {
+							goto l466
+						}
+						position++
+					}
+				l469:
+					{
+						position471, tokenIndex471 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l472
+						}
+						position++
+						goto l471
+					l472:
+						position, tokenIndex = position471, tokenIndex471
+						if buffer[position] != rune('I') {
+							goto l466
+						}
+						position++
+					}
+				l471:
+					if buffer[position] != rune('_') {
+						goto l466
+					}
+					position++
+					{
+						position473, tokenIndex473 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l474
+						}
+						position++
+						goto l473
+					l474:
+						position, tokenIndex = position473, tokenIndex473
+						if buffer[position] != rune('N') {
+							goto l466
+						}
+						position++
+					}
+				l473:
+					{
+						position475, tokenIndex475 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l476
+						}
+						position++
+						goto l475
+					l476:
+						position, tokenIndex = position475, tokenIndex475
+						if buffer[position] != rune('E') {
+							goto l466
+						}
+						position++
+					}
+				l475:
+					{
+						position477, tokenIndex477 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l478
+						}
+						position++
+						goto l477
+					l478:
+						position, tokenIndex = position477, tokenIndex477
+						if buffer[position] != rune('G') {
+							goto l466
+						}
+						position++
+					}
+				l477:
+					{
+						position479, tokenIndex479 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l480
+						}
+						position++
+						goto l479
+					l480:
+						position, tokenIndex = position479, tokenIndex479
+						if buffer[position] != rune('A') {
+							goto l466
+						}
+						position++
+					}
+				l479:
+					{
+						position481, tokenIndex481 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l482
+						}
+						position++
+						goto l481
+					l482:
+						position, tokenIndex = position481, tokenIndex481
+						if buffer[position] != rune('T') {
+							goto l466
+						}
+						position++
+					}
+				l481:
+					{
+						position483, tokenIndex483 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l484
+						}
+						position++
+						goto l483
+					l484:
+						position, tokenIndex = position483, tokenIndex483
+						if buffer[position] != rune('E') {
+							goto l466
+						}
+						position++
+					}
+				l483:
+					if buffer[position] != rune('_') {
+						goto l466
+					}
+					position++
+					{
+						position485, tokenIndex485 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l486
+						}
+						position++
+						goto l485
+					l486:
+						position, tokenIndex = position485, tokenIndex485
+						if buffer[position] != rune('R') {
+							goto l466
+						}
+						position++
+					}
+				l485:
+					{
+						position487, tokenIndex487 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l488
+						}
+						position++
+						goto l487
+					l488:
+						position, tokenIndex = position487, tokenIndex487
+						if buffer[position] != rune('A') {
+							goto l466
+						}
+						position++
+					}
+				l487:
+					if buffer[position] != rune('_') {
+						goto l466
+					}
+					position++
+					{
+						position489, tokenIndex489 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l490
+						}
+						position++
+						goto l489
+					l490:
+						position, tokenIndex = position489, tokenIndex489
+						if buffer[position] != rune('S') {
+							goto l466
+						}
+						position++
+					}
+				l489:
+					{
+						position491, tokenIndex491 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l492
+						}
+						position++
+						goto l491
+					l492:
+						position, tokenIndex = position491, tokenIndex491
+						if buffer[position] != rune('T') {
+							goto l466
+						}
+						position++
+					}
+				l491:
+					{
+						position493, tokenIndex493 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l494
+						}
+						position++
+						goto l493
+					l494:
+						position, tokenIndex = position493, tokenIndex493
+						if buffer[position] != rune('A') {
+							goto l466
+						}
+						position++
+					}
+				l493:
+					{
+						position495, tokenIndex495 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l496
+						}
+						position++
+						goto l495
+					l496:
+						position, tokenIndex = position495, tokenIndex495
+						if buffer[position] != rune('T') {
+							goto l466
+						}
+						position++
+					}
+				l495:
+					{
+						position497, tokenIndex497 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l498
+						}
+						position++
+						goto l497
+					l498:
+						position, tokenIndex = position497, tokenIndex497
+						if buffer[position] != rune('E') {
+							goto l466
+						}
+						position++
+					}
+				l497:
+					if buffer[position] != rune('_') {
+						goto l466
+					}
+					position++
+					{
+						position499, tokenIndex499 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l500
+						}
+						position++
+						goto l499
+					l500:
+						position, tokenIndex = position499, tokenIndex499
+						if buffer[position] != rune('W') {
+							goto l466
+						}
+						position++
+					}
+				l499:
+					{
+						position501, tokenIndex501 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l502
+						}
+						position++
+						goto l501
+					l502:
+						position, tokenIndex = position501, tokenIndex501
+						if buffer[position] != rune('I') {
+							goto l466
+						}
+						position++
+					}
+				l501:
+					{
+						position503, tokenIndex503 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l504
+						}
+						position++
+						goto l503
+					l504:
+						position, tokenIndex = position503, tokenIndex503
+						if buffer[position] != rune('T') {
+							goto l466
+						}
+						position++
+					}
+				l503:
+					{
+						position505, tokenIndex505 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l506
+						}
+						position++
+						goto l505
+					l506:
+						position, tokenIndex = position505, tokenIndex505
+						if buffer[position] != rune('H') {
+							goto l466
+						}
+						position++
+					}
+				l505:
+					if buffer[position] != rune('_') {
+						goto l466
+					}
+					position++
+					{
+						position507, tokenIndex507 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l508
+						}
+						position++
+						goto l507
+					l508:
+						position, tokenIndex = position507, tokenIndex507
+						if buffer[position] != rune('P') {
+							goto l466
+						}
+						position++
+					}
+				l507:
+					{
+						position509, tokenIndex509 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l510
+						}
+						position++
+						goto l509
+					l510:
+						position, tokenIndex = position509, tokenIndex509
+						if buffer[position] != rune('C') {
+							goto l466
+						}
+						position++
+					}
+				l509:
+					goto l401
+				l466:
+					position, tokenIndex = position401, tokenIndex401
+					if buffer[position] != rune('.') {
+						goto l511
+					}
+					position++
+					{
+						position512, tokenIndex512 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l513
+						}
+						position++
+						goto l512
+					l513:
+						position, tokenIndex = position512, tokenIndex512
+						if buffer[position] != rune('C') {
+							goto l511
+						}
+						position++
+					}
+				l512:
+					{
+						position514, tokenIndex514 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l515
+						}
+						position++
+						goto l514
+					l515:
+						position, tokenIndex = position514, tokenIndex514
+						if buffer[position] != rune('F') {
+							goto l511
+						}
+						position++
+					}
+				l514:
+					{
+						position516, tokenIndex516 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l517
+						}
+						position++
+						goto l516
+					l517:
+						position, tokenIndex = position516, tokenIndex516
+						if buffer[position] != rune('I') {
+							goto l511
+						}
+						position++
+					}
+				l516:
+					if buffer[position] != rune('_') {
+						goto l511
+					}
+					position++
+					{
+						position518, tokenIndex518 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l519
+						}
+						position++
+						goto l518
+					l519:
+						position, tokenIndex = position518, tokenIndex518
+						if buffer[position] != rune('N') {
+							goto l511
+						}
+						position++
+					}
+				l518:
+					{
+						position520, tokenIndex520 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l521
+						}
+						position++
+						goto l520
+					l521:
+						position, tokenIndex = position520, tokenIndex520
+						if buffer[position] != rune('E') {
+							goto l511
+						}
+						position++
+					}
+				l520:
+					{
+						position522, tokenIndex522 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l523
+						}
+						position++
+						goto l522
+					l523:
+						position, tokenIndex = position522, tokenIndex522
+						if buffer[position] != rune('G') {
+							goto l511
+						}
+						position++
+					}
+				l522:
+					{
+						position524, tokenIndex524 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l525
+						}
+						position++
+						goto l524
+					l525:
+						position, tokenIndex = position524, tokenIndex524
+						if buffer[position] != rune('A') {
+							goto l511
+						}
+						position++
+					}
+				l524:
+					{
+						position526, tokenIndex526 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l527
+						}
+						position++
+						goto l526
+					l527:
+						position, tokenIndex = position526, tokenIndex526
+						if buffer[position] != rune('T') {
+							goto l511
+						}
+						position++
+					}
+				l526:
+					{
+						position528, tokenIndex528 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l529
+						}
+						position++
+						goto l528
+					l529:
+						position, tokenIndex = position528, tokenIndex528
+						if buffer[position] != rune('E') {
+							goto l511
+						}
+						position++
+					}
+				l528:
+					if buffer[position] != rune('_') {
+						goto l511
+					}
+					position++
+					{
+						position530, tokenIndex530 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l531
+						}
+						position++
+						goto l530
+					l531:
+						position, tokenIndex = position530, tokenIndex530
+						if buffer[position] != rune('R') {
+							goto l511
+						}
+						position++
+					}
+				l530:
+					{
+						position532, tokenIndex532 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l533
+						}
+						position++
+						goto l532
+					l533:
+						position, tokenIndex = position532, tokenIndex532
+						if buffer[position] != rune('A') {
+							goto l511
+						}
+						position++
+					}
+				l532:
+					if buffer[position] != rune('_') {
+						goto l511
+					}
+					position++
+					{
+						position534, tokenIndex534 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l535
+						}
+						position++
+						goto l534
+					l535:
+						position, tokenIndex = position534, tokenIndex534
+						if buffer[position] != rune('S') {
+							goto l511
+						}
+						position++
+					}
+				l534:
+					{
+						position536, tokenIndex536 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l537
+						}
+						position++
+						goto l536
+					l537:
+						position, tokenIndex = position536, tokenIndex536
+						if buffer[position] != rune('T') {
+							goto l511
+						}
+						position++
+					}
+				l536:
+					{
+						position538, tokenIndex538 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l539
+						}
+						position++
+						goto l538
+					l539:
+						position, tokenIndex = position538, tokenIndex538
+						if buffer[position] != rune('A') {
+							goto l511
+						}
+						position++
+					}
+				l538:
+					{
+						position540, tokenIndex540 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l541
+						}
+						position++
+						goto l540
+					l541:
+						position, tokenIndex = position540, tokenIndex540
+						if buffer[position] != rune('T') {
+							goto l511
+						}
+						position++
+					}
+				l540:
+					{
+						position542, tokenIndex542 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l543
+						}
+						position++
+						goto l542
+					l543:
+						position, tokenIndex = position542, tokenIndex542
+						if buffer[position] != rune('E') {
+							goto l511
+						}
+						position++
+					}
+				l542:
+					goto l401
+				l511:
+					position, tokenIndex = position401, tokenIndex401
+					if buffer[position] != rune('.') {
+						goto l399
+					}
+					position++
+					{
+						position544, tokenIndex544 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l545
+						}
+						position++
+						goto l544
+					l545:
+						position, tokenIndex = position544, tokenIndex544
+						if buffer[position] != rune('C') {
+							goto l399
+						}
+						position++
+					}
+				l544:
+					{
+						position546, tokenIndex546 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l547
+						}
+						position++
+						goto l546
+					l547:
+						position, tokenIndex = position546, tokenIndex546
+						if buffer[position] != rune('F') {
+							goto l399
+						}
+						position++
+					}
+				l546:
+					{
+						position548, tokenIndex548 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l549
+						}
+						position++
+						goto l548
+					l549:
+						position, tokenIndex = position548, tokenIndex548
+						if buffer[position] != rune('I') {
+							goto l399
+						}
+						position++
+					}
+				l548:
+					if buffer[position] != rune('_') {
+						goto l399
+					}
+					position++
+					{
+						position550, tokenIndex550 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l551
+						}
+						position++
+						goto l550
+					l551:
+						position, tokenIndex = position550, tokenIndex550
+						if buffer[position] != rune('B') {
+							goto l399
+						}
+						position++
+					}
+				l550:
+					if buffer[position] != rune('_') {
+						goto l399
+					}
+					position++
+					{
+						position552, tokenIndex552 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l553
+						}
+						position++
+						goto l552
+					l553:
+						position, tokenIndex = position552, tokenIndex552
+						if buffer[position] != rune('K') {
+							goto l399
+						}
+						position++
+					}
+				l552:
+					{
+						position554, tokenIndex554 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l555
+						}
+						position++
+						goto l554
+					l555:
+						position, tokenIndex = position554, tokenIndex554
+						if buffer[position] != rune('E') {
+							goto l399
+						}
+						position++
+					}
+				l554:
+					{
+						position556, tokenIndex556 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l557
+						}
+						position++
+						goto l556
+					l557:
+						position, tokenIndex = position556, tokenIndex556
+						if buffer[position] != rune('Y') {
+							goto l399
+						}
+						position++
+					}
+				l556:
+					if buffer[position] != rune('_') {
+						goto l399
+					}
+					position++
+					{
+						position558, tokenIndex558 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l559
+						}
+						position++
+						goto l558
+					l559:
+						position, tokenIndex = position558, tokenIndex558
+						if buffer[position] != rune('F') {
+							goto l399
+						}
+						position++
+					}
+				l558:
+					{
+						position560, tokenIndex560 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l561
+						}
+						position++
+						goto l560
+					l561:
+						position, tokenIndex = position560, tokenIndex560
+						if buffer[position] != rune('R') {
+							goto l399
+						}
+						position++
+					}
+				l560:
+					{
+						position562, tokenIndex562 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l563
+						}
+						position++
+						goto l562
+					l563:
+						position, tokenIndex = position562, tokenIndex562
+						if buffer[position] != rune('A') {
+							goto l399
+						}
+						position++
+					}
+				l562:
+					{
+						position564, tokenIndex564 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l565
+						}
+						position++
+						goto l564
+					l565:
+						position, tokenIndex = position564, tokenIndex564
+						if buffer[position] != rune('M') {
+							goto l399
+						}
+						position++
+					}
+				l564:
+					{
+						position566, tokenIndex566 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l567
+						}
+						position++
+						goto l566
+					l567:
+						position, tokenIndex = position566, tokenIndex566
+						if buffer[position] != rune('E') {
+							goto l399
+						}
+						position++
+					}
+				l566:
+				}
+			l401:
+				{
+					position568, tokenIndex568 := position, tokenIndex
+					{
+						position569, tokenIndex569 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l570
+						}
+						position++
+						goto l569
+					l570:
+						position, tokenIndex = position569, tokenIndex569
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l571
+						}
+						position++
+						goto l569
+					l571:
+						position, tokenIndex = position569, tokenIndex569
+						{
+							position573, tokenIndex573 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l574
+							}
+							position++
+							goto l573
+						l574:
+							position, tokenIndex = position573, tokenIndex573
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l572
+							}
+							position++
+						}
+					l573:
+						goto l569
+					l572:
+						position, tokenIndex = position569, tokenIndex569
+						if buffer[position] != rune('_') {
+							goto l568
+						}
+						position++
+					}
+				l569:
+					goto l399
+				l568:
+					position, tokenIndex = position568, tokenIndex568
+				}
+				add(ruleCFINoArgDirective, position400)
+			}
+			return true
+		l399:
+			position, tokenIndex = position399, tokenIndex399
+			return false
+		},
+		/* 20 CFIReturnColumnDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('r' / 'R') ('e' / 'E') ('t' / 'T') ('u' / 'U') ('r' / 'R') ('n' / 'N') '_' ('c' / 'C') ('o' / 'O') ('l' / 'L') ('u' / 'U') ('m' / 'M') ('n' / 'N') WS CFIRegister)> */
+		func() bool {
+			position575, tokenIndex575 := position, tokenIndex
+			{
+				position576 := position
+				if buffer[position] != rune('.') {
+					goto l575
+				}
+				position++
+				{
+					position577, tokenIndex577 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l578
+					}
+					position++
+					goto l577
+				l578:
+					position, tokenIndex = position577, tokenIndex577
+					if buffer[position] != rune('C') {
+						goto l575
+					}
+					position++
+				}
+			l577:
+				{
+					position579, tokenIndex579 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l580
+					}
+					position++
+					goto l579
+				l580:
+					position, tokenIndex = position579, tokenIndex579
+					if buffer[position] != rune('F') {
+						goto l575
+					}
+					position++
+				}
+			l579:
+				{
+					position581, tokenIndex581 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l582
+					}
+					position++
+					goto l581
+				l582:
+					position, tokenIndex = position581, tokenIndex581
+					if buffer[position] != rune('I') {
+						goto l575
+					}
+					position++
+				}
+			l581:
+				if buffer[position] != rune('_') {
+					goto l575
+				}
+				position++
+				{
+					position583, tokenIndex583 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l584
+					}
+					position++
+					goto l583
+				l584:
+					position, tokenIndex = position583, tokenIndex583
+					if buffer[position] != rune('R') {
+						goto l575
+					}
+					position++
+				}
+			l583:
+				{
+					position585, tokenIndex585 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l586
+					}
+					position++
+					goto l585
+				l586:
+					position, tokenIndex = position585, tokenIndex585
+					if buffer[position] != rune('E') {
+						goto l575
+					}
+					position++
+				}
+			l585:
+				{
+					position587, tokenIndex587 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l588
+					}
+					position++
+					goto l587
+				l588:
+					position, tokenIndex = position587, tokenIndex587
+					if buffer[position] != rune('T') {
+						goto l575
+					}
+					position++
+				}
+			l587:
+				{
+					position589, tokenIndex589 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l590
+					}
+					position++
+					goto l589
+				l590:
+					position, tokenIndex = position589, tokenIndex589
+					if buffer[position] != rune('U') {
+						goto l575
+					}
+					position++
+				}
+			l589:
+				{
+					position591, tokenIndex591 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l592
+					}
+					position++
+					goto l591
+				l592:
+					position, tokenIndex = position591, tokenIndex591
+					if buffer[position] != rune('R') {
+						goto l575
+					}
+					position++
+				}
+			l591:
+				{
+					position593, tokenIndex593 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l594
+					}
+					position++
+					goto l593
+				l594:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('N') {
+						goto l575
+					}
+					position++
+				}
+			l593:
+				if buffer[position] != rune('_') {
+					goto l575
+				}
+				position++
+				{
+					position595, tokenIndex595 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l596
+					}
+					position++
+					goto l595
+				l596:
+					position, tokenIndex = position595, tokenIndex595
+					if buffer[position] != rune('C') {
+						goto l575
+					}
+					position++
+				}
+			l595:
+				{
+					position597, tokenIndex597 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l598
+					}
+					position++
+					goto l597
+				l598:
+					position, tokenIndex = position597, tokenIndex597
+					if buffer[position] != rune('O') {
+						goto l575
+					}
+					position++
+				}
+			l597:
+				{
+					position599, tokenIndex599 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l600
+					}
+					position++
+					goto l599
+				l600:
+					position, tokenIndex = position599, tokenIndex599
+					if buffer[position] != rune('L') {
+						goto l575
+					}
+					position++
+				}
+			l599:
+				{
+					position601, tokenIndex601 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l602
+					}
+					position++
+					goto l601
+				l602:
+					position, tokenIndex = position601, tokenIndex601
+					if buffer[position] != rune('U') {
+						goto l575
+					}
+					position++
+				}
+			l601:
+				{
+					position603, tokenIndex603 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l604
+					}
+					position++
+					goto l603
+				l604:
+					position, tokenIndex = position603, tokenIndex603
+					if buffer[position] != rune('M') {
+						goto l575
+					}
+					position++
+				}
+			l603:
+				{
+					position605, tokenIndex605 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l606
+					}
+					position++
+					goto l605
+				l606:
+					position, tokenIndex = position605, tokenIndex605
+					if buffer[position] != rune('N') {
+						goto l575
+					}
+					position++
+				}
+			l605:
+				if !_rules[ruleWS]() {
+					goto l575
+				}
+				if !_rules[ruleCFIRegister]() {
+					goto l575
+				}
+				add(ruleCFIReturnColumnDirective, position576)
+			}
+			return true
+		l575:
+			position, tokenIndex = position575, tokenIndex575
+			return false
+		},
+		/* 21 CFIUndefinedDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('u' / 'U') ('n' / 'N') ('d' / 'D') ('e' / 'E') ('f' / 'F') ('i' / 'I') ('n' / 'N') ('e' / 'E') ('d' / 'D') WS CFIRegister)> */
+		func() bool {
+			position607, tokenIndex607 := position, tokenIndex
+			{
+				position608 := position
+				if buffer[position] != rune('.') {
+					goto l607
+				}
+				position++
+				{
+					position609, tokenIndex609 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l610
+					}
+					position++
+					goto l609
+				l610:
+					position, tokenIndex = position609, tokenIndex609
+					if buffer[position] != rune('C') {
+						goto l607
+					}
+					position++
+				}
+			l609:
+				{
+					position611, tokenIndex611 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l612
+					}
+					position++
+					goto l611
+				l612:
+					position, tokenIndex = position611, tokenIndex611
+					if buffer[position] != rune('F') {
+						goto l607
+					}
+					position++
+				}
+			l611:
+				{
+					position613, tokenIndex613 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l614
+					}
+					position++
+					goto l613
+				l614:
+					position, tokenIndex = position613, tokenIndex613
+					if buffer[position] != rune('I') {
+						goto l607
+					}
+					position++
+				}
+			l613:
+				if buffer[position] != rune('_') {
+					goto l607
+				}
+				position++
+				{
+					position615, tokenIndex615 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l616
+					}
+					position++
+					goto l615
+				l616:
+					position, tokenIndex = position615, tokenIndex615
+					if buffer[position] != rune('U') {
+						goto l607
+					}
+					position++
+				}
+			l615:
+				{
+					position617, tokenIndex617 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l618
+					}
+					position++
+					goto l617
+				l618:
+					position, tokenIndex = position617, tokenIndex617
+					if buffer[position] != rune('N') {
+						goto l607
+					}
+					position++
+				}
+			l617:
+				{
+					position619, tokenIndex619 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l620
+					}
+					position++
+					goto l619
+				l620:
+					position, tokenIndex = position619, tokenIndex619
+					if buffer[position] != rune('D') {
+						goto l607
+					}
+					position++
+				}
+			l619:
+				{
+					position621, tokenIndex621 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l622
+					}
+					position++
+					goto l621
+				l622:
+					position, tokenIndex = position621, tokenIndex621
+					if buffer[position] != rune('E') {
+						goto l607
+					}
+					position++
+				}
+			l621:
+				{
+					position623, tokenIndex623 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l624
+					}
+					position++
+					goto l623
+				l624:
+					position, tokenIndex = position623, tokenIndex623
+					if buffer[position] != rune('F') {
+						goto l607
+					}
+					position++
+				}
+			l623:
+				{
+					position625, tokenIndex625 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l626
+					}
+					position++
+					goto l625
+				l626:
+					position, tokenIndex = position625, tokenIndex625
+					if buffer[position] != rune('I') {
+						goto l607
+					}
+					position++
+				}
+			l625:
+				{
+					position627, tokenIndex627 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l628
+					}
+					position++
+					goto l627
+				l628:
+					position, tokenIndex = position627, tokenIndex627
+					if buffer[position] != rune('N') {
+						goto l607
+					}
+					position++
+				}
+			l627:
+				{
+					position629, tokenIndex629 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l630
+					}
+					position++
+					goto l629
+				l630:
+					position, tokenIndex = position629, tokenIndex629
+					if buffer[position] != rune('E') {
+						goto l607
+					}
+					position++
+				}
+			l629:
+				{
+					position631, tokenIndex631 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l632
+					}
+					position++
+					goto l631
+				l632:
+					position, tokenIndex = position631, tokenIndex631
+					if buffer[position] != rune('D') {
+						goto l607
+					}
+					position++
+				}
+			l631:
+				if !_rules[ruleWS]() {
+					goto l607
+				}
+				if !_rules[ruleCFIRegister]() {
+					goto l607
+				}
+				add(ruleCFIUndefinedDirective, position608)
+			}
+			return true
+		l607:
+			position, tokenIndex = position607, tokenIndex607
+			return false
+		},
+		/* 22 CFIEscapeDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('e' / 'E') ('s' / 'S') ('c' / 'C') ('a' / 'A') ('p' / 'P') ('e' / 'E') WS CFIEscapeArg (WS? ',' WS? CFIEscapeArg)*)> */
+		func() bool {
+			position633, tokenIndex633 := position, tokenIndex
+			{
+				position634 := position
+				if buffer[position] != rune('.') {
+					goto l633
+				}
+				position++
+				{
+					position635, tokenIndex635 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l636
+					}
+					position++
+					goto l635
+				l636:
+					position, tokenIndex = position635, tokenIndex635
+					if buffer[position] != rune('C') {
+						goto l633
+					}
+					position++
+				}
+			l635:
+				{
+					position637, tokenIndex637 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l638
+					}
+					position++
+					goto l637
+				l638:
+					position, tokenIndex = position637, tokenIndex637
+					if buffer[position] != rune('F') {
+						goto l633
+					}
+					position++
+				}
+			l637:
+				{
+					position639, tokenIndex639 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l640
+					}
+					position++
+					goto l639
+				l640:
+					position, tokenIndex = position639, tokenIndex639
+					if buffer[position] != rune('I') {
+						goto l633
+					}
+					position++
+				}
+			l639:
+				if buffer[position] != rune('_') {
+					goto l633
+				}
+				position++
+				{
+					position641, tokenIndex641 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l642
+					}
+					position++
+					goto l641
+				l642:
+					position, tokenIndex = position641, tokenIndex641
+					if buffer[position] != rune('E') {
+						goto l633
+					}
+					position++
+				}
+			l641:
+				{
+					position643, tokenIndex643 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l644
+					}
+					position++
+					goto l643
+				l644:
+					position, tokenIndex = position643, tokenIndex643
+					if buffer[position] != rune('S') {
+						goto l633
+					}
+					position++
+				}
+			l643:
+				{
+					position645, tokenIndex645 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l646
+					}
+					position++
+					goto l645
+				l646:
+					position, tokenIndex = position645, tokenIndex645
+					if buffer[position] != rune('C') {
+						goto l633
+					}
+					position++
+				}
+			l645:
+				{
+					position647, tokenIndex647 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l648
+					}
+					position++
+					goto l647
+				l648:
+					position, tokenIndex = position647, tokenIndex647
+					if buffer[position] != rune('A') {
+						goto l633
+					}
+					position++
+				}
+			l647:
+				{
+					position649, tokenIndex649 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l650
+					}
+					position++
+					goto l649
+				l650:
+					position, tokenIndex = position649, tokenIndex649
+					if buffer[position] != rune('P') {
+						goto l633
+					}
+					position++
+				}
+			l649:
+				{
+					position651, tokenIndex651 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l652
+					}
+					position++
+					goto l651
+				l652:
+					position, tokenIndex = position651, tokenIndex651
+					if buffer[position] != rune('E') {
+						goto l633
+					}
+					position++
+				}
+			l651:
+				if !_rules[ruleWS]() {
+					goto l633
+				}
+				if !_rules[ruleCFIEscapeArg]() {
+					goto l633
+				}
+			l653:
+				{
+					position654, tokenIndex654 := position, tokenIndex
+					{
+						position655, tokenIndex655 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l655
+						}
+						goto l656
+					l655:
+						position, tokenIndex = position655, tokenIndex655
+					}
+				l656:
+					if buffer[position] != rune(',') {
+						goto l654
+					}
+					position++
+					{
+						position657, tokenIndex657 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l657
+						}
+						goto l658
+					l657:
+						position, tokenIndex = position657, tokenIndex657
+					}
+				l658:
+					if !_rules[ruleCFIEscapeArg]() {
+						goto l654
+					}
+					goto l653
+				l654:
+					position, tokenIndex = position654, tokenIndex654
+				}
+				add(ruleCFIEscapeDirective, position634)
+			}
+			return true
+		l633:
+			position, tokenIndex = position633, tokenIndex633
+			return false
+		},
+		/* 23 CFIEscapeArg <- <(SymbolArg / Expression)> */
+		func() bool {
+			position659, tokenIndex659 := position, tokenIndex
+			{
+				position660 := position
+				{
+					position661, tokenIndex661 := position, tokenIndex
+					if !_rules[ruleSymbolArg]() {
+						goto l662
+					}
+					goto l661
+				l662:
+					position, tokenIndex = position661, tokenIndex661
+					if !_rules[ruleExpression]() {
+						goto l659
+					}
+				}
+			l661:
+				add(ruleCFIEscapeArg, position660)
+			}
+			return true
+		l659:
+			position, tokenIndex = position659, tokenIndex659
+			return false
+		},
+		/* 24 CFIExpressionDirective <- <((('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('d' / 'D') ('e' / 'E') ('f' / 'F') '_' ('c' / 'C') ('f' / 'F') ('a' / 'A') '_' ('e' / 'E') ('x' / 'X') ('p' / 'P') ('r' / 'R') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N') WS) / ((('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('v' / 'V') ('a' / 'A') ('l' / 'L') '_' ('e' / 'E') ('x' / 'X') ('p' / 'P') ('r' / 'R') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N')) / ('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('e' / 'E') ('x' / 'X') ('p' / 'P') ('r' / 'R') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N'))) WS CFIRegister WS? ',' WS?)) CFIExpressionOperand (WS? ',' WS? CFIExpressionOperand)*)> */
+		func() bool {
+			position663, tokenIndex663 := position, tokenIndex
+			{
+				position664 := position
+				{
+					position665, tokenIndex665 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l666
+					}
+					position++
+					{
+						position667, tokenIndex667 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l668
+						}
+						position++
+						goto l667
+					l668:
+						position, tokenIndex = position667, tokenIndex667
+						if buffer[position] != rune('C') {
+							goto l666
+						}
+						position++
+					}
+				l667:
+					{
+						position669, tokenIndex669 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l670
+						}
+						position++
+						goto l669
+					l670:
+						position, tokenIndex = position669, tokenIndex669
+						if buffer[position] != rune('F') {
+							goto l666
+						}
+						position++
+					}
+				l669:
+					{
+						position671, tokenIndex671 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l672
+						}
+						position++
+						goto l671
+					l672:
+						position, tokenIndex = position671, tokenIndex671
+						if buffer[position] != rune('I') {
+							goto l666
+						}
+						position++
+					}
+				l671:
+					if buffer[position] != rune('_') {
+						goto l666
+					}
+					position++
+					{
+						position673, tokenIndex673 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l674
+						}
+						position++
+						goto l673
+					l674:
+						position, tokenIndex = position673, tokenIndex673
+						if buffer[position] != rune('D') {
+							goto l666
+						}
+						position++
+					}
+				l673:
+					{
+						position675, tokenIndex675 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l676
+						}
+						position++
+						goto l675
+					l676:
+						position, tokenIndex = position675, tokenIndex675
+						if buffer[position] != rune('E') {
+							goto l666
+						}
+						position++
+					}
+				l675:
+					{
+						position677, tokenIndex677 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l678
+						}
+						position++
+						goto l677
+					l678:
+						position, tokenIndex = position677, tokenIndex677
+						if buffer[position] != rune('F') {
+							goto l666
+						}
+						position++
+					}
+				l677:
+					if buffer[position] != rune('_') {
+						goto l666
+					}
+					position++
+					{
+						position679, tokenIndex679 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l680
+						}
+						position++
+						goto l679
+					l680:
+						position, tokenIndex = position679, tokenIndex679
+						if buffer[position] != rune('C') {
+							goto l666
+						}
+						position++
+					}
+				l679:
+					{
+						position681, tokenIndex681 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l682
+						}
+						position++
+						goto l681
+					l682:
+						position, tokenIndex = position681, tokenIndex681
+						if buffer[position] != rune('F') {
+							goto l666
+						}
+						position++
+					}
+				l681:
+					{
+						position683, tokenIndex683 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l684
+						}
+						position++
+						goto l683
+					l684:
+						position, tokenIndex = position683, tokenIndex683
+						if buffer[position] != rune('A') {
+							goto l666
+						}
+						position++
+					}
+				l683:
+					if buffer[position] != rune('_') {
+						goto l666
+					}
+					position++
+					{
+						position685, tokenIndex685 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l686
+						}
+						position++
+						goto l685
+					l686:
+						position, tokenIndex = position685, tokenIndex685
+						if buffer[position] != rune('E') {
+							goto l666
+						}
+						position++
+					}
+				l685:
+					{
+						position687, tokenIndex687 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l688
+						}
+						position++
+						goto l687
+					l688:
+						position, tokenIndex = position687, tokenIndex687
+						if buffer[position] != rune('X') {
+							goto l666
+						}
+						position++
+					}
+				l687:
+					{
+						position689, tokenIndex689 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l690
+						}
+						position++
+						goto l689
+					l690:
+						position, tokenIndex = position689, tokenIndex689
+						if buffer[position] != rune('P') {
+							goto l666
+						}
+						position++
+					}
+				l689:
+					{
+						position691, tokenIndex691 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l692
+						}
+						position++
+						goto l691
+					l692:
+						position, tokenIndex = position691, tokenIndex691
+						if buffer[position] != rune('R') {
+							goto l666
+						}
+						position++
+					}
+				l691:
+					{
+						position693, tokenIndex693 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l694
+						}
+						position++
+						goto l693
+					l694:
+						position, tokenIndex = position693, tokenIndex693
+						if buffer[position] != rune('E') {
+							goto l666
+						}
+						position++
+					}
+				l693:
+					{
+						position695, tokenIndex695 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l696
+						}
+						position++
+						goto l695
+					l696:
+						position, tokenIndex = position695, tokenIndex695
+						if buffer[position] != rune('S') {
+							goto l666
+						}
+						position++
+					}
+				l695:
+					{
+						position697, tokenIndex697 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l698
+						}
+						position++
+						goto l697
+					l698:
+						position, tokenIndex = position697, tokenIndex697
+						if buffer[position] != rune('S') {
+							goto l666
+						}
+						position++
+					}
+				l697:
+					{
+						position699, tokenIndex699 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l700
+						}
+						position++
+						goto l699
+					l700:
+						position, tokenIndex = position699, tokenIndex699
+						if buffer[position] != rune('I') {
+							goto l666
+						}
+						position++
+					}
+				l699:
+					{
+						position701, tokenIndex701 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l702
+						}
+						position++
+						goto l701
+					l702:
+						position, tokenIndex = position701, tokenIndex701
+						if buffer[position] != rune('O') {
+							goto l666
+						}
+						position++
+					}
+				l701:
+					{
+						position703, tokenIndex703 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l704
+						}
+						position++
+						goto l703
+					l704:
+						position, tokenIndex = position703, tokenIndex703
+						if buffer[position] != rune('N') {
+							goto l666
+						}
+						position++
+					}
+				l703:
+					if !_rules[ruleWS]() {
+						goto l666
+					}
+					goto l665
+				l666:
+					position, tokenIndex = position665, tokenIndex665
+					{
+						position705, tokenIndex705 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l706
+						}
+						position++
+						{
+							position707, tokenIndex707 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l708
+							}
+							position++
+							goto l707
+						l708:
+							position, tokenIndex = position707, tokenIndex707
+							if buffer[position] != rune('C') {
+								goto l706
+							}
+							position++
+						}
+					l707:
+						{
+							position709, tokenIndex709 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l710
+							}
+							position++
+							goto l709
+						l710:
+							position, tokenIndex = position709, tokenIndex709
+							if buffer[position] != rune('F') {
+								goto l706
+							}
+							position++
+						}
+					l709:
+						{
+							position711, tokenIndex711 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l712
+							}
+							position++
+							goto l711
+						l712:
+							position, tokenIndex = position711, tokenIndex711
+							if buffer[position] != rune('I') {
+								goto l706
+							}
+							position++
+						}
+					l711:
+						if buffer[position] != rune('_') {
+							goto l706
+						}
+						position++
+						{
+							position713, tokenIndex713 := position, tokenIndex
+							if buffer[position] != rune('v') {
+								goto l714
+							}
+							position++
+							goto l713
+						l714:
+							position, tokenIndex = position713, tokenIndex713
+							if buffer[position] != rune('V') {
+								goto l706
+							}
+							position++
+						}
+					l713:
+						{
+							position715, tokenIndex715 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l716
+							}
+							position++
+							goto l715
+						l716:
+							position, tokenIndex = position715, tokenIndex715
+							if buffer[position] != rune('A') {
+								goto l706
+							}
+							position++
+						}
+					l715:
+						{
+							position717, tokenIndex717 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l718
+							}
+							position++
+							goto l717
+						l718:
+							position, tokenIndex = position717, tokenIndex717
+							if buffer[position] != rune('L') {
+								goto l706
+							}
+							position++
+						}
+					l717:
+						if buffer[position] != rune('_') {
+							goto l706
+						}
+						position++
+						{
+							position719, tokenIndex719 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l720
+							}
+							position++
+							goto l719
+						l720:
+							position, tokenIndex = position719, tokenIndex719
+							if buffer[position] != rune('E') {
+								goto l706
+							}
+							position++
+						}
+					l719:
+						{
+							position721, tokenIndex721 := position, tokenIndex
+							if buffer[position] != rune('x') {
+								goto l722
+							}
+							position++
+							goto l721
+						l722:
+							position, tokenIndex = position721, tokenIndex721
+							if buffer[position] != rune('X') {
+								goto l706
+							}
+							position++
+						}
+					l721:
+						{
+							position723, tokenIndex723 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l724
+							}
+							position++
+							goto l723
+						l724:
+							position, tokenIndex = position723, tokenIndex723
+							if buffer[position] != rune('P') {
+								goto l706
+							}
+							position++
+						}
+					l723:
+						{
+							position725, tokenIndex725 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l726
+							}
+							position++
+							goto l725
+						l726:
+							position, tokenIndex = position725, tokenIndex725
+							if buffer[position] != rune('R') {
+								goto l706
+							}
+							position++
+						}
+					l725:
+						{
+							position727, tokenIndex727 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l728
+							}
+							position++
+							goto l727
+						l728:
+							position, tokenIndex = position727, tokenIndex727
+							if buffer[position] != rune('E') {
+								goto l706
+							}
+							position++
+						}
+					l727:
+						{
+							position729, tokenIndex729 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l730
+							}
+							position++
+							goto l729
+						l730:
+							position, tokenIndex = position729, tokenIndex729
+							if buffer[position] != rune('S') {
+								goto l706
+							}
+							position++
+						}
+					l729:
+						{
+							position731, tokenIndex731 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l732
+							}
+							position++
+							goto l731
+						l732:
+							position, tokenIndex = position731, tokenIndex731
+							if buffer[position] != rune('S') {
+								goto l706
+							}
+							position++
+						}
+					l731:
+						{
+							position733, tokenIndex733 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l734
+							}
+							position++
+							goto l733
+						l734:
+							position, tokenIndex = position733, tokenIndex733
+							if buffer[position] != rune('I') {
+								goto l706
+							}
+							position++
+						}
+					l733:
+						{
+							position735, tokenIndex735 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l736
+							}
+							position++
+							goto l735
+						l736:
+							position, tokenIndex = position735, tokenIndex735
+							if buffer[position] != rune('O') {
+								goto l706
+							}
+							position++
+						}
+					l735:
+						{
+							position737, tokenIndex737 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l738
+							}
+							position++
+							goto l737
+						l738:
+							position, tokenIndex = position737, tokenIndex737
+							if buffer[position] != rune('N') {
+								goto l706
+							}
+							position++
+						}
+					l737:
+						goto l705
+					l706:
+						position, tokenIndex = position705, tokenIndex705
+						if buffer[position] != rune('.') {
+							goto l663
+						}
+						position++
+						{
+							position739, tokenIndex739 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l740
+							}
+							position++
+							goto l739
+						l740:
+							position, tokenIndex = position739, tokenIndex739
+							if buffer[position] != rune('C') {
+								goto l663
+							}
+							position++
+						}
+					l739:
+						{
+							position741, tokenIndex741 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l742
+							}
+							position++
+							goto l741
+						l742:
+							position, tokenIndex = position741, tokenIndex741
+							if buffer[position] != rune('F') {
+								goto l663
+							}
+							position++
+						}
+					l741:
+						{
+							position743, tokenIndex743 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l744
+							}
+							position++
+							goto l743
+						l744:
+							position, tokenIndex = position743, tokenIndex743
+							if buffer[position] != rune('I') {
+								goto l663
+							}
+							position++
+						}
+					l743:
+						if buffer[position] != rune('_') {
+							goto l663
+						}
+						position++
+						{
+							position745, tokenIndex745 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l746
+							}
+							position++
+							goto l745
+						l746:
+							position, tokenIndex = position745, tokenIndex745
+							if buffer[position] != rune('E') {
+								goto l663
+							}
+							position++
+						}
+					l745:
+						{
+							position747, tokenIndex747 := position, tokenIndex
+							if buffer[position] != rune('x') {
+								goto l748
+							}
+							position++
+							goto l747
+						l748:
+							position, tokenIndex = position747, tokenIndex747
+							if buffer[position] != rune('X') {
+								goto l663
+							}
+							position++
+						}
+					l747:
+						{
+							position749, tokenIndex749 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l750
+							}
+							position++
+							goto l749
+						l750:
+							position, tokenIndex = position749, tokenIndex749
+							if buffer[position] != rune('P') {
+								goto l663
+							}
+							position++
+						}
+					l749:
+						{
+							position751, tokenIndex751 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l752
+							}
+							position++
+							goto l751
+						l752:
+							position, tokenIndex = position751, tokenIndex751
+							if buffer[position] != rune('R') {
+								goto l663
+							}
+							position++
+						}
+					l751:
+						{
+							position753, tokenIndex753 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l754
+							}
+							position++
+							goto l753
+						l754:
+							position, tokenIndex = position753, tokenIndex753
+							if buffer[position] != rune('E') {
+								goto l663
+							}
+							position++
+						}
+					l753:
+						{
+							position755, tokenIndex755 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l756
+							}
+							position++
+							goto l755
+						l756:
+							position, tokenIndex = position755, tokenIndex755
+							if buffer[position] != rune('S') {
+								goto l663
+							}
+							position++
+						}
+					l755:
+						{
+							position757, tokenIndex757 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l758
+							}
+							position++
+							goto l757
+						l758:
+							position, tokenIndex = position757, tokenIndex757
+							if buffer[position] != rune('S') {
+								goto l663
+							}
+							position++
+						}
+					l757:
+						{
+							position759, tokenIndex759 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l760
+							}
+							position++
+							goto l759
+						l760:
+							position, tokenIndex = position759, tokenIndex759
+							if buffer[position] != rune('I') {
+								goto l663
+							}
+							position++
+						}
+					l759:
+						{
+							position761, tokenIndex761 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l762
+							}
+							position++
+							goto l761
+						l762:
+							position, tokenIndex = position761, tokenIndex761
+							if buffer[position] != rune('O') {
+								goto l663
+							}
+							position++
+						}
+					l761:
+						{
+							position763, tokenIndex763 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l764
+							}
+							position++
+							goto l763
+						l764:
+							position, tokenIndex = position763, tokenIndex763
+							if buffer[position] != rune('N') {
+								goto l663
+							}
+							position++
+						}
+					l763:
+					}
+				l705:
+					if !_rules[ruleWS]() {
+						goto l663
+					}
+					if !_rules[ruleCFIRegister]() {
+						goto l663
+					}
+					{
+						position765, tokenIndex765 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l765
+						}
+						goto l766
+					l765:
+						position, tokenIndex = position765, tokenIndex765
+					}
+				l766:
+					if buffer[position] != rune(',') {
+						goto l663
+					}
+					position++
+					{
+						position767, tokenIndex767 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l767
+						}
+						goto l768
+					l767:
+						position, tokenIndex = position767, tokenIndex767
+					}
+				l768:
+				}
+			l665:
+				if !_rules[ruleCFIExpressionOperand]() {
+					goto l663
+				}
+			l769:
+				{
+					position770, tokenIndex770 := position, tokenIndex
+					{
+						position771, tokenIndex771 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l771
+						}
+						goto l772
+					l771:
+						position, tokenIndex = position771, tokenIndex771
+					}
+				l772:
+					if buffer[position] != rune(',') {
+						goto l770
+					}
+					position++
+					{
+						position773, tokenIndex773 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l773
+						}
+						goto l774
+					l773:
+						position, tokenIndex = position773, tokenIndex773
+					}
+				l774:
+					if !_rules[ruleCFIExpressionOperand]() {
+						goto l770
+					}
+					goto l769
+				l770:
+					position, tokenIndex = position770, tokenIndex770
+				}
+				add(ruleCFIExpressionDirective, position664)
+			}
+			return true
+		l663:
+			position, tokenIndex = position663, tokenIndex663
+			return false
+		},
+		/* 25 CFIExpressionOperand <- <((!(' ' / '\t' / ',' / '#' / ';' / '\n') .)+ (WS (!(' ' / '\t' / ',' / '#' / ';' / '\n') .)+)*)> */
+		func() bool {
+			position775, tokenIndex775 := position, tokenIndex
+			{
+				position776 := position
+				{
+					position779, tokenIndex779 := position, tokenIndex
+					{
+						position780, tokenIndex780 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l781
+						}
+						position++
+						goto l780
+					l781:
+						position, tokenIndex = position780, tokenIndex780
+						if buffer[position] != rune('\t') {
+							goto l782
+						}
+						position++
+						goto l780
+					l782:
+						position, tokenIndex = position780, tokenIndex780
+						if buffer[position] != rune(',') {
+							goto l783
+						}
+						position++
+						goto l780
+					l783:
+						position, tokenIndex = position780, tokenIndex780
+						if buffer[position] != rune('#') {
+							goto l784
+						}
+						position++
+						goto l780
+					l784:
+						position, tokenIndex = position780, tokenIndex780
+						if buffer[position] != rune(';') {
+							goto l785
+						}
+						position++
+						goto l780
+					l785:
+						position, tokenIndex = position780, tokenIndex780
+						if buffer[position] != rune('\n') {
+							goto l779
+						}
+						position++
+					}
+				l780:
+					goto l775
+				l779:
+					position, tokenIndex = position779, tokenIndex779
+				}
+				if !matchDot() {
+					goto l775
+				}
+			l777:
+				{
+					position778, tokenIndex778 := position, tokenIndex
+					{
+						position786, tokenIndex786 := position, tokenIndex
+						{
+							position787, tokenIndex787 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l788
+							}
+							position++
+							goto l787
+						l788:
+							position, tokenIndex = position787, tokenIndex787
+							if buffer[position] != rune('\t') {
+								goto l789
+							}
+							position++
+							goto l787
+						l789:
+							position, tokenIndex = position787, tokenIndex787
+							if buffer[position] != rune(',') {
+								goto l790
+							}
+							position++
+							goto l787
+						l790:
+							position, tokenIndex = position787, tokenIndex787
+							if buffer[position] != rune('#') {
+								goto l791
+							}
+							position++
+							goto l787
+						l791:
+							position, tokenIndex = position787, tokenIndex787
+							if buffer[position] != rune(';') {
+								goto l792
+							}
+							position++
+							goto l787
+						l792:
+							position, tokenIndex = position787, tokenIndex787
+							if buffer[position] != rune('\n') {
+								goto l786
+							}
+							position++
+						}
+					l787:
+						goto l778
+					l786:
+						position, tokenIndex = position786, tokenIndex786
+					}
+					if !matchDot() {
+						goto l778
+					}
+					goto l777
+				l778:
+					position, tokenIndex = position778, tokenIndex778
+				}
+			l793:
+				{
+					position794, tokenIndex794 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l794
+					}
+					{
+						position797, tokenIndex797 := position, tokenIndex
+						{
+							position798, tokenIndex798 := position, tokenIndex
+							if buffer[position] != rune(' ') {
+								goto l799
+							}
+							position++
+							goto l798
+						l799:
+							position, tokenIndex = position798, tokenIndex798
+							if buffer[position] != rune('\t') {
+								goto l800
+							}
+							position++
+							goto l798
+						l800:
+							position, tokenIndex = position798, tokenIndex798
+							if buffer[position] != rune(',') {
+								goto l801
+							}
+							position++
+							goto l798
+						l801:
+							position, tokenIndex = position798, tokenIndex798
+							if buffer[position] != rune('#') {
+								goto l802
+							}
+							position++
+							goto l798
+						l802:
+							position, tokenIndex = position798, tokenIndex798
+							if buffer[position] != rune(';') {
+								goto l803
+							}
+							position++
+							goto l798
+						l803:
+							position, tokenIndex = position798, tokenIndex798
+							if buffer[position] != rune('\n') {
+								goto l797
+							}
+							position++
+						}
+					l798:
+						goto l794
+					l797:
+						position, tokenIndex = position797, tokenIndex797
+					}
+					if !matchDot() {
+						goto l794
+					}
+				l795:
+					{
+						position796, tokenIndex796 := position, tokenIndex
+						{
+							position804, tokenIndex804 := position, tokenIndex
+							{
+								position805, tokenIndex805 := position, tokenIndex
+								if buffer[position] != rune(' ') {
+									goto l806
+								}
+								position++
+								goto l805
+							l806:
+								position, tokenIndex = position805, tokenIndex805
+								if buffer[position] != rune('\t') {
+									goto l807
+								}
+								position++
+								goto l805
+							l807:
+								position, tokenIndex = position805, tokenIndex805
+								if buffer[position] != rune(',') {
+									goto l808
+								}
+								position++
+								goto l805
+							l808:
+								position, tokenIndex = position805, tokenIndex805
+								if buffer[position] != rune('#') {
+									goto l809
+								}
+								position++
+								goto l805
+							l809:
+								position, tokenIndex = position805, tokenIndex805
+								if buffer[position] != rune(';') {
+									goto l810
+								}
+								position++
+								goto l805
+							l810:
+								position, tokenIndex = position805, tokenIndex805
+								if buffer[position] != rune('\n') {
+									goto l804
+								}
+								position++
+							}
+						l805:
+							goto l796
+						l804:
+							position, tokenIndex = position804, tokenIndex804
+						}
+						if !matchDot() {
+							goto l796
+						}
+						goto l795
+					l796:
+						position, tokenIndex = position796, tokenIndex796
+					}
+					goto l793
+				l794:
+					position, tokenIndex = position794, tokenIndex794
+				}
+				add(ruleCFIExpressionOperand, position776)
+			}
+			return true
+		l775:
+			position, tokenIndex = position775, tokenIndex775
+			return false
+		},
+		/* 26 CFIValEncodedAddrDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('v' / 'V') ('a' / 'A') ('l' / 'L') '_' ('e' / 'E') ('n' / 'N') ('c' / 'C') ('o' / 'O') ('d' / 'D') ('e' / 'E') ('d' / 'D') '_' ('a' / 'A') ('d' / 'D') ('d' / 'D') ('r' / 'R') WS CFIRegister WS? ',' WS? CFIEncoding WS? ',' WS? SymbolArg)> */
+		func() bool {
+			position811, tokenIndex811 := position, tokenIndex
+			{
+				position812 := position
+				if buffer[position] != rune('.') {
+					goto l811
+				}
+				position++
+				{
+					position813, tokenIndex813 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l814
+					}
+					position++
+					goto l813
+				l814:
+					position, tokenIndex = position813, tokenIndex813
+					if buffer[position] != rune('C') {
+						goto l811
+					}
+					position++
+				}
+			l813:
+				{
+					position815, tokenIndex815 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l816
+					}
+					position++
+					goto l815
+				l816:
+					position, tokenIndex = position815, tokenIndex815
+					if buffer[position] != rune('F') {
+						goto l811
+					}
+					position++
+				}
+			l815:
+				{
+					position817, tokenIndex817 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l818
+					}
+					position++
+					goto l817
+				l818:
+					position, tokenIndex = position817, tokenIndex817
+					if buffer[position] != rune('I') {
+						goto l811
+					}
+					position++
+				}
+			l817:
+				if buffer[position] != rune('_') {
+					goto l811
+				}
+				position++
+				{
+					position819, tokenIndex819 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l820
+					}
+					position++
+					goto l819
+				l820:
+					position, tokenIndex = position819, tokenIndex819
+					if buffer[position] != rune('V') {
+						goto l811
+					}
+					position++
+				}
+			l819:
+				{
+					position821, tokenIndex821 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l822
+					}
+					position++
+					goto l821
+				l822:
+					position, tokenIndex = position821, tokenIndex821
+					if buffer[position] != rune('A') {
+						goto l811
+					}
+					position++
+				}
+			l821:
+				{
+					position823, tokenIndex823 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l824
+					}
+					position++
+					goto l823
+				l824:
+					position, tokenIndex = position823, tokenIndex823
+					if buffer[position] != rune('L') {
+						goto l811
+					}
+					position++
+				}
+			l823:
+				if buffer[position] != rune('_') {
+					goto l811
+				}
+				position++
+				{
+					position825, tokenIndex825 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l826
+					}
+					position++
+					goto l825
+				l826:
+					position, tokenIndex = position825, tokenIndex825
+					if buffer[position] != rune('E') {
+						goto l811
+					}
+					position++
+				}
+			l825:
+				{
+					position827, tokenIndex827 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l828
+					}
+					position++
+					goto l827
+				l828:
+					position, tokenIndex = position827, tokenIndex827
+					if buffer[position] != rune('N') {
+						goto l811
+					}
+					position++
+				}
+			l827:
+				{
+					position829, tokenIndex829 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l830
+					}
+					position++
+					goto l829
+				l830:
+					position, tokenIndex = position829, tokenIndex829
+					if buffer[position] != rune('C') {
+						goto l811
+					}
+					position++
+				}
+			l829:
+				{
+					position831, tokenIndex831 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l832
+					}
+					position++
+					goto l831
+				l832:
+					position, tokenIndex = position831, tokenIndex831
+					if buffer[position] != rune('O') {
+						goto l811
+					}
+					position++
+				}
+			l831:
+				{
+					position833, tokenIndex833 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l834
+					}
+					position++
+					goto l833
+				l834:
+					position, tokenIndex = position833, tokenIndex833
+					if buffer[position] != rune('D') {
+						goto l811
+					}
+					position++
+				}
+			l833:
+				{
+					position835, tokenIndex835 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l836
+					}
+					position++
+					goto l835
+				l836:
+					position, tokenIndex = position835, tokenIndex835
+					if buffer[position] != rune('E') {
+						goto l811
+					}
+					position++
+				}
+			l835:
+				{
+					position837, tokenIndex837 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l838
+					}
+					position++
+					goto l837
+				l838:
+					position, tokenIndex = position837, tokenIndex837
+					if buffer[position] != rune('D') {
+						goto l811
+					}
+					position++
+				}
+			l837:
+				if buffer[position] != rune('_') {
+					goto l811
+				}
+				position++
+				{
+					position839, tokenIndex839 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l840
+					}
+					position++
+					goto l839
+				l840:
+					position, tokenIndex = position839, tokenIndex839
+					if buffer[position] != rune('A') {
+						goto l811
+					}
+					position++
+				}
+			l839:
+				{
+					position841, tokenIndex841 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l842
+					}
+					position++
+					goto l841
+				l842:
+					position, tokenIndex = position841, tokenIndex841
+					if buffer[position] != rune('D') {
+						goto l811
+					}
+					position++
+				}
+			l841:
+				{
+					position843, tokenIndex843 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l844
+					}
+					position++
+					goto l843
+				l844:
+					position, tokenIndex = position843, tokenIndex843
+					if buffer[position] != rune('D') {
+						goto l811
+					}
+					position++
+				}
+			l843:
+				{
+					position845, tokenIndex845 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l846
+					}
+					position++
+					goto l845
+				l846:
+					position, tokenIndex = position845, tokenIndex845
+					if buffer[position] != rune('R') {
+						goto l811
+					}
+					position++
+				}
+			l845:
+				if !_rules[ruleWS]() {
+					goto l811
+				}
+				if !_rules[ruleCFIRegister]() {
+					goto l811
+				}
+				{
+					position847, tokenIndex847 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l847
+					}
+					goto l848
+				l847:
+					position, tokenIndex = position847, tokenIndex847
+				}
+			l848:
+				if buffer[position] != rune(',') {
+					goto l811
+				}
+				position++
+				{
+					position849, tokenIndex849 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l849
+					}
+					goto l850
+				l849:
+					position, tokenIndex = position849, tokenIndex849
+				}
+			l850:
+				if !_rules[ruleCFIEncoding]() {
+					goto l811
+				}
+				{
+					position851, tokenIndex851 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l851
+					}
+					goto l852
+				l851:
+					position, tokenIndex = position851, tokenIndex851
+				}
+			l852:
+				if buffer[position] != rune(',') {
+					goto l811
+				}
+				position++
+				{
+					position853, tokenIndex853 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l853
+					}
+					goto l854
+				l853:
+					position, tokenIndex = position853, tokenIndex853
+				}
+			l854:
+				if !_rules[ruleSymbolArg]() {
+					goto l811
+				}
+				add(ruleCFIValEncodedAddrDirective, position812)
+			}
+			return true
+		l811:
+			position, tokenIndex = position811, tokenIndex811
+			return false
+		},
+		/* 27 CFIEncoding <- <Offset> */
+		func() bool {
+			position855, tokenIndex855 := position, tokenIndex
+			{
+				position856 := position
+				if !_rules[ruleOffset]() {
+					goto l855
+				}
+				add(ruleCFIEncoding, position856)
+			}
+			return true
+		l855:
+			position, tokenIndex = position855, tokenIndex855
+			return false
+		},
+		/* 28 CFILabelDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('l' / 'L') ('a' / 'A') ('b' / 'B') ('e' / 'E') ('l' / 'L') WS (LocalSymbol / SymbolName))> */
+		func() bool {
+			position857, tokenIndex857 := position, tokenIndex
+			{
+				position858 := position
+				if buffer[position] != rune('.') {
+					goto l857
+				}
+				position++
+				{
+					position859, tokenIndex859 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l860
+					}
+					position++
+					goto l859
+				l860:
+					position, tokenIndex = position859, tokenIndex859
+					if buffer[position] != rune('C') {
+						goto l857
+					}
+					position++
+				}
+			l859:
+				{
+					position861, tokenIndex861 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l862
+					}
+					position++
+					goto l861
+				l862:
+					position, tokenIndex = position861, tokenIndex861
+					if buffer[position] != rune('F') {
+						goto l857
+					}
+					position++
+				}
+			l861:
+				{
+					position863, tokenIndex863 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l864
+					}
+					position++
+					goto l863
+				l864:
+					position, tokenIndex = position863, tokenIndex863
+					if buffer[position] != rune('I') {
+						goto l857
+					}
+					position++
+				}
+			l863:
+				if buffer[position] != rune('_') {
+					goto l857
+				}
+				position++
+				{
+					position865, tokenIndex865 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l866
+					}
+					position++
+					goto l865
+				l866:
+					position, tokenIndex = position865, tokenIndex865
+					if buffer[position] != rune('L') {
+						goto l857
+					}
+					position++
+				}
+			l865:
+				{
+					position867, tokenIndex867 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l868
+					}
+					position++
+					goto l867
+				l868:
+					position, tokenIndex = position867, tokenIndex867
+					if buffer[position] != rune('A') {
+						goto l857
+					}
+					position++
+				}
+			l867:
+				{
+					position869, tokenIndex869 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l870
+					}
+					position++
+					goto l869
+				l870:
+					position, tokenIndex = position869, tokenIndex869
+					if buffer[position] != rune('B') {
+						goto l857
+					}
+					position++
+				}
+			l869:
+				{
+					position871, tokenIndex871 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l872
+					}
+					position++
+					goto l871
+				l872:
+					position, tokenIndex = position871, tokenIndex871
+					if buffer[position] != rune('E') {
+						goto l857
+					}
+					position++
+				}
+			l871:
+				{
+					position873, tokenIndex873 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l874
+					}
+					position++
+					goto l873
+				l874:
+					position, tokenIndex = position873, tokenIndex873
+					if buffer[position] != rune('L') {
+						goto l857
+					}
+					position++
+				}
+			l873:
+				if !_rules[ruleWS]() {
+					goto l857
+				}
+				{
+					position875, tokenIndex875 := position, tokenIndex
+					if !_rules[ruleLocalSymbol]() {
+						goto l876
+					}
+					goto l875
+				l876:
+					position, tokenIndex = position875, tokenIndex875
+					if !_rules[ruleSymbolName]() {
+						goto l857
+					}
+				}
+			l875:
+				add(ruleCFILabelDirective, position858)
+			}
+			return true
+		l857:
+			position, tokenIndex = position857, tokenIndex857
+			return false
+		},
+		/* 29 CFIPersonalityIDDirective <- <('.' ('c' / 'C') ('f' / 'F') ('i' / 'I') '_' ('p' / 'P') ('e' / 'E') ('r' / 'R') ('s' / 'S') ('o' / 'O') ('n' / 'N') ('a' / 'A') ('l' / 'L') ('i' / 'I') ('t' / 'T') ('y' / 'Y') '_' ('i' / 'I') ('d' / 'D') WS Offset)> */
+		func() bool {
+			position877, tokenIndex877 := position, tokenIndex
+			{
+				position878 := position
+				if buffer[position] != rune('.') {
+					goto l877
+				}
+				position++
+				{
+					position879, tokenIndex879 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l880
+					}
+					position++
+					goto l879
+				l880:
+					position, tokenIndex = position879, tokenIndex879
+					if buffer[position] != rune('C') {
+						goto l877
+					}
+					position++
+				}
+			l879:
+				{
+					position881, tokenIndex881 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l882
+					}
+					position++
+					goto l881
+				l882:
+					position, tokenIndex = position881, tokenIndex881
+					if buffer[position] != rune('F') {
+						goto l877
+					}
+					position++
+				}
+			l881:
+				{
+					position883, tokenIndex883 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l884
+					}
+					position++
+					goto l883
+				l884:
+					position, tokenIndex = position883, tokenIndex883
+					if buffer[position] != rune('I') {
+						goto l877
+					}
+					position++
+				}
+			l883:
+				if buffer[position] != rune('_') {
+					goto l877
+				}
+				position++
+				{
+					position885, tokenIndex885 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l886
+					}
+					position++
+					goto l885
+				l886:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('P') {
+						goto l877
+					}
+					position++
+				}
+			l885:
+				{
+					position887, tokenIndex887 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l888
+					}
+					position++
+					goto l887
+				l888:
+					position, tokenIndex = position887, tokenIndex887
+					if buffer[position] != rune('E') {
+						goto l877
+					}
+					position++
+				}
+			l887:
+				{
+					position889, tokenIndex889 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l890
+					}
+					position++
+					goto l889
+				l890:
+					position, tokenIndex = position889, tokenIndex889
+					if buffer[position] != rune('R') {
+						goto l877
+					}
+					position++
+				}
+			l889:
+				{
+					position891, tokenIndex891 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l892
+					}
+					position++
+					goto l891
+				l892:
+					position, tokenIndex = position891, tokenIndex891
+					if buffer[position] != rune('S') {
+						goto l877
+					}
+					position++
+				}
+			l891:
+				{
+					position893, tokenIndex893 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l894
+					}
+					position++
+					goto l893
+				l894:
+					position, tokenIndex = position893, tokenIndex893
+					if buffer[position] != rune('O') {
+						goto l877
+					}
+					position++
+				}
+			l893:
+				{
+					position895, tokenIndex895 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l896
+					}
+					position++
+					goto l895
+				l896:
+					position, tokenIndex = position895, tokenIndex895
+					if buffer[position] != rune('N') {
+						goto l877
+					}
+					position++
+				}
+			l895:
+				{
+					position897, tokenIndex897 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l898
+					}
+					position++
+					goto l897
+				l898:
+					position, tokenIndex = position897, tokenIndex897
+					if buffer[position] != rune('A') {
+						goto l877
+					}
+					position++
+				}
+			l897:
+				{
+					position899, tokenIndex899 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l900
+					}
+					position++
+					goto l899
+				l900:
+					position, tokenIndex = position899, tokenIndex899
+					if buffer[position] != rune('L') {
+						goto l877
+					}
+					position++
+				}
+			l899:
+				{
+					position901, tokenIndex901 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l902
+					}
+					position++
+					goto l901
+				l902:
+					position, tokenIndex = position901, tokenIndex901
+					if buffer[position] != rune('I') {
+						goto l877
+					}
+					position++
+				}
+			l901:
+				{
+					position903, tokenIndex903 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l904
+					}
+					position++
+					goto l903
+				l904:
+					position, tokenIndex = position903, tokenIndex903
+					if buffer[position] != rune('T') {
+						goto l877
+					}
+					position++
+				}
+			l903:
+				{
+					position905, tokenIndex905 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l906
+					}
+					position++
+					goto l905
+				l906:
+					position, tokenIndex = position905, tokenIndex905
+					if buffer[position] != rune('Y') {
+						goto l877
+					}
+					position++
+				}
+			l905:
+				if buffer[position] != rune('_') {
+					goto l877
+				}
+				position++
+				{
+					position907, tokenIndex907 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l908
+					}
+					position++
+					goto l907
+				l908:
+					position, tokenIndex = position907, tokenIndex907
+					if buffer[position] != rune('I') {
+						goto l877
+					}
+					position++
+				}
+			l907:
+				{
+					position909, tokenIndex909 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l910
+					}
+					position++
+					goto l909
+				l910:
+					position, tokenIndex = position909, tokenIndex909
+					if buffer[position] != rune('D') {
+						goto l877
+					}
+					position++
+				}
+			l909:
+				if !_rules[ruleWS]() {
+					goto l877
+				}
+				if !_rules[ruleOffset]() {
+					goto l877
+				}
+				add(ruleCFIPersonalityIDDirective, position878)
+			}
+			return true
+		l877:
+			position, tokenIndex = position877, tokenIndex877
+			return false
+		},
+		/* 30 CFIRegister <- <(('%' ([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))*) / (([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))*) / [0-9]+)> */
+		func() bool {
+			position911, tokenIndex911 := position, tokenIndex
+			{
+				position912 := position
+				{
+					position913, tokenIndex913 := position, tokenIndex
+					if buffer[position] != rune('%') {
+						goto l914
+					}
+					position++
+					{
+						position915, tokenIndex915 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l916
+						}
+						position++
+						goto l915
+					l916:
+						position, tokenIndex = position915, tokenIndex915
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l914
+						}
+						position++
+					}
+				l915:
+				l917:
+					{
+						position918, tokenIndex918 := position, tokenIndex
+						{
+							position919, tokenIndex919 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l920
+							}
+							position++
+							goto l919
+						l920:
+							position, tokenIndex = position919, tokenIndex919
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l921
+							}
+							position++
+							goto l919
+						l921:
+							position, tokenIndex = position919, tokenIndex919
+							{
+								position922, tokenIndex922 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l923
+								}
+								position++
+								goto l922
+							l923:
+								position, tokenIndex = position922, tokenIndex922
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l918
+								}
+								position++
+							}
+						l922:
+						}
+					l919:
+						goto l917
+					l918:
+						position, tokenIndex = position918, tokenIndex918
+					}
+					goto l913
+				l914:
+					position, tokenIndex = position913, tokenIndex913
+					{
+						position925, tokenIndex925 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l926
+						}
+						position++
+						goto l925
+					l926:
+						position, tokenIndex = position925, tokenIndex925
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l924
+						}
+						position++
+					}
+				l925:
+				l927:
+					{
+						position928, tokenIndex928 := position, tokenIndex
+						{
+							position929, tokenIndex929 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l930
+							}
+							position++
+							goto l929
+						l930:
+							position, tokenIndex = position929, tokenIndex929
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l931
+							}
+							position++
+							goto l929
+						l931:
+							position, tokenIndex = position929, tokenIndex929
+							{
+								position932, tokenIndex932 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l933
+								}
+								position++
+								goto l932
+							l933:
+								position, tokenIndex = position932, tokenIndex932
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l928
+								}
+								position++
+							}
+						l932:
+						}
+					l929:
+						goto l927
+					l928:
+						position, tokenIndex = position928, tokenIndex928
+					}
+					goto l913
+				l924:
+					position, tokenIndex = position913, tokenIndex913
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l911
+					}
+					position++
+				l934:
+					{
+						position935, tokenIndex935 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l935
+						}
+						position++
+						goto l934
+					l935:
+						position, tokenIndex = position935, tokenIndex935
+					}
+				}
+			l913:
+				add(ruleCFIRegister, position912)
+			}
+			return true
+		l911:
+			position, tokenIndex = position911, tokenIndex911
+			return false
+		},
+		/* 31 GnuAttributeDirective <- <('.' ('g' / 'G') ('n' / 'N') ('u' / 'U') '_' ('a' / 'A') ('t' / 'T') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('u' / 'U') ('t' / 'T') ('e' / 'E') WS Offset WS? ',' WS? Offset)> */
+		func() bool {
+			position936, tokenIndex936 := position, tokenIndex
+			{
+				position937 := position
+				if buffer[position] != rune('.') {
+					goto l936
+				}
+				position++
+				{
+					position938, tokenIndex938 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l939
+					}
+					position++
+					goto l938
+				l939:
+					position, tokenIndex = position938, tokenIndex938
+					if buffer[position] != rune('G') {
+						goto l936
+					}
+					position++
+				}
+			l938:
+				{
+					position940, tokenIndex940 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l941
+					}
+					position++
+					goto l940
+				l941:
+					position, tokenIndex = position940, tokenIndex940
+					if buffer[position] != rune('N') {
+						goto l936
+					}
+					position++
+				}
+			l940:
+				{
+					position942, tokenIndex942 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l943
+					}
+					position++
+					goto l942
+				l943:
+					position, tokenIndex = position942, tokenIndex942
+					if buffer[position] != rune('U') {
+						goto l936
+					}
+					position++
+				}
+			l942:
+				if buffer[position] != rune('_') {
+					goto l936
+				}
+				position++
+				{
+					position944, tokenIndex944 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l945
+					}
+					position++
+					goto l944
+				l945:
+					position, tokenIndex = position944, tokenIndex944
+					if buffer[position] != rune('A') {
+						goto l936
+					}
+					position++
+				}
+			l944:
+				{
+					position946, tokenIndex946 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l947
+					}
+					position++
+					goto l946
+				l947:
+					position, tokenIndex = position946, tokenIndex946
+					if buffer[position] != rune('T') {
+						goto l936
+					}
+					position++
+				}
+			l946:
+				{
+					position948, tokenIndex948 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l949
+					}
+					position++
+					goto l948
+				l949:
+					position, tokenIndex = position948, tokenIndex948
+					if buffer[position] != rune('T') {
+						goto l936
+					}
+					position++
+				}
+			l948:
+				{
+					position950, tokenIndex950 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l951
+					}
+					position++
+					goto l950
+				l951:
+					position, tokenIndex = position950, tokenIndex950
+					if buffer[position] != rune('R') {
+						goto l936
+					}
+					position++
+				}
+			l950:
+				{
+					position952, tokenIndex952 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l953
+					}
+					position++
+					goto l952
+				l953:
+					position, tokenIndex = position952, tokenIndex952
+					if buffer[position] != rune('I') {
+						goto l936
+					}
+					position++
+				}
+			l952:
+				{
+					position954, tokenIndex954 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l955
+					}
+					position++
+					goto l954
+				l955:
+					position, tokenIndex = position954, tokenIndex954
+					if buffer[position] != rune('B') {
+						goto l936
+					}
+					position++
+				}
+			l954:
+				{
+					position956, tokenIndex956 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l957
+					}
+					position++
+					goto l956
+				l957:
+					position, tokenIndex = position956, tokenIndex956
+					if buffer[position] != rune('U') {
+						goto l936
+					}
+					position++
+				}
+			l956:
+				{
+					position958, tokenIndex958 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l959
+					}
+					position++
+					goto l958
+				l959:
+					position, tokenIndex = position958, tokenIndex958
+					if buffer[position] != rune('T') {
+						goto l936
+					}
+					position++
+				}
+			l958:
+				{
+					position960, tokenIndex960 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l961
+					}
+					position++
+					goto l960
+				l961:
+					position, tokenIndex = position960, tokenIndex960
+					if buffer[position] != rune('E') {
+						goto l936
+					}
+					position++
+				}
+			l960:
+				if !_rules[ruleWS]() {
+					goto l936
+				}
+				if !_rules[ruleOffset]() {
+					goto l936
+				}
+				{
+					position962, tokenIndex962 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l962
+					}
+					goto l963
+				l962:
+					position, tokenIndex = position962, tokenIndex962
+				}
+			l963:
+				if buffer[position] != rune(',') {
+					goto l936
+				}
+				position++
+				{
+					position964, tokenIndex964 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l964
+					}
+					goto l965
+				l964:
+					position, tokenIndex = position964, tokenIndex964
+				}
+			l965:
+				if !_rules[ruleOffset]() {
+					goto l936
+				}
+				add(ruleGnuAttributeDirective, position937)
+			}
+			return true
+		l936:
+			position, tokenIndex = position936, tokenIndex936
+			return false
+		},
+		/* 32 AttributeDirective <- <((('.' ('e' / 'E') ('a' / 'A') ('b' / 'B') ('i' / 'I') '_' ('a' / 'A') ('t' / 'T') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('u' / 'U') ('t' / 'T') ('e' / 'E')) / ('.' ('a' / 'A') ('t' / 'T') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('u' / 'U') ('t' / 'T') ('e' / 'E'))) WS AttributeTag WS? ',' WS? AttributeValue)> */
+		func() bool {
+			position966, tokenIndex966 := position, tokenIndex
+			{
+				position967 := position
+				{
+					position968, tokenIndex968 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l969
+					}
+					position++
+					{
+						position970, tokenIndex970 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l971
+						}
+						position++
+						goto l970
+					l971:
+						position, tokenIndex = position970, tokenIndex970
+						if buffer[position] != rune('E') {
+							goto l969
+						}
+						position++
+					}
+				l970:
+					{
+						position972, tokenIndex972 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l973
+						}
+						position++
+						goto l972
+					l973:
+						position, tokenIndex = position972, tokenIndex972
+						if buffer[position] != rune('A') {
+							goto l969
+						}
+						position++
+					}
+				l972:
+					{
+						position974, tokenIndex974 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l975
+						}
+						position++
+						goto l974
+					l975:
+						position, tokenIndex = position974, tokenIndex974
+						if buffer[position] != rune('B') {
+							goto l969
+						}
+						position++
+					}
+				l974:
+					{
+						position976, tokenIndex976 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l977
+						}
+						position++
+						goto l976
+					l977:
+						position, tokenIndex = position976, tokenIndex976
+						if buffer[position] != rune('I') {
+							goto l969
+						}
+						position++
+					}
+				l976:
+					if buffer[position] != rune('_') {
+						goto l969
+					}
+					position++
+					{
+						position978, tokenIndex978 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l979
+						}
+						position++
+						goto l978
+					l979:
+						position, tokenIndex = position978, tokenIndex978
+						if buffer[position] != rune('A') {
+							goto l969
+						}
+						position++
+					}
+				l978:
+					{
+						position980, tokenIndex980 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l981
+						}
+						position++
+						goto l980
+					l981:
+						position, tokenIndex = position980, tokenIndex980
+						if buffer[position] != rune('T') {
+							goto l969
+						}
+						position++
+					}
+				l980:
+					{
+						position982, tokenIndex982 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l983
+						}
+						position++
+						goto l982
+					l983:
+						position, tokenIndex = position982, tokenIndex982
+						if buffer[position] != rune('T') {
+							goto l969
+						}
+						position++
+					}
+				l982:
+					{
+						position984, tokenIndex984 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l985
+						}
+						position++
+						goto l984
+					l985:
+						position, tokenIndex = position984, tokenIndex984
+						if buffer[position] != rune('R') {
+							goto l969
+						}
+						position++
+					}
+				l984:
+					{
+						position986, tokenIndex986 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l987
+						}
+						position++
+						goto l986
+					l987:
+						position, tokenIndex = position986, tokenIndex986
+						if buffer[position] != rune('I') {
+							goto l969
+						}
+						position++
+					}
+				l986:
+					{
+						position988, tokenIndex988 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l989
+						}
+						position++
+						goto l988
+					l989:
+						position, tokenIndex = position988, tokenIndex988
+						if buffer[position] != rune('B') {
+							goto l969
+						}
+						position++
+					}
+				l988:
+					{
+						position990, tokenIndex990 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l991
+						}
+						position++
+						goto l990
+					l991:
+						position, tokenIndex = position990, tokenIndex990
+						if buffer[position] != rune('U') {
+							goto l969
+						}
+						position++
+					}
+				l990:
+					{
+						position992, tokenIndex992 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l993
+						}
+						position++
+						goto l992
+					l993:
+						position, tokenIndex = position992, tokenIndex992
+						if buffer[position] != rune('T') {
+							goto l969
+						}
+						position++
+					}
+				l992:
+					{
+						position994, tokenIndex994 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l995
+						}
+						position++
+						goto l994
+					l995:
+						position, tokenIndex = position994, tokenIndex994
+						if buffer[position] != rune('E') {
+							goto l969
+						}
+						position++
+					}
+				l994:
+					goto l968
+				l969:
+					position, tokenIndex = position968, tokenIndex968
+					if buffer[position] != rune('.') {
+						goto l966
+					}
+					position++
+					{
+						position996, tokenIndex996 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l997
+						}
+						position++
+						goto l996
+					l997:
+						position, tokenIndex = position996, tokenIndex996
+						if buffer[position] != rune('A') {
+							goto l966
+						}
+						position++
+					}
+				l996:
+					{
+						position998, tokenIndex998 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l999
+						}
+						position++
+						goto l998
+					l999:
+						position, tokenIndex = position998, tokenIndex998
+						if buffer[position] != rune('T') {
+							goto l966
+						}
+						position++
+					}
+				l998:
+					{
+						position1000, tokenIndex1000 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1001
+						}
+						position++
+						goto l1000
+					l1001:
+						position, tokenIndex = position1000, tokenIndex1000
+						if buffer[position] != rune('T') {
+							goto l966
+						}
+						position++
+					}
+				l1000:
+					{
+						position1002, tokenIndex1002 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1003
+						}
+						position++
+						goto l1002
+					l1003:
+						position, tokenIndex = position1002, tokenIndex1002
+						if buffer[position] != rune('R') {
+							goto l966
+						}
+						position++
+					}
+				l1002:
+					{
+						position1004, tokenIndex1004 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1005
+						}
+						position++
+						goto l1004
+					l1005:
+						position, tokenIndex = position1004, tokenIndex1004
+						if buffer[position] != rune('I') {
+							goto l966
+						}
+						position++
+					}
+				l1004:
+					{
+						position1006, tokenIndex1006 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1007
+						}
+						position++
+						goto l1006
+					l1007:
+						position, tokenIndex = position1006, tokenIndex1006
+						if buffer[position] != rune('B') {
+							goto l966
+						}
+						position++
+					}
+				l1006:
+					{
+						position1008, tokenIndex1008 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1009
+						}
+						position++
+						goto l1008
+					l1009:
+						position, tokenIndex = position1008, tokenIndex1008
+						if buffer[position] != rune('U') {
+							goto l966
+						}
+						position++
+					}
+				l1008:
+					{
+						position1010, tokenIndex1010 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1011
+						}
+						position++
+						goto l1010
+					l1011:
+						position, tokenIndex = position1010, tokenIndex1010
+						if buffer[position] != rune('T') {
+							goto l966
+						}
+						position++
+					}
+				l1010:
+					{
+						position1012, tokenIndex1012 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1013
+						}
+						position++
+						goto l1012
+					l1013:
+						position, tokenIndex = position1012, tokenIndex1012
+						if buffer[position] != rune('E') {
+							goto l966
+						}
+						position++
+					}
+				l1012:
+				}
+			l968:
+				if !_rules[ruleWS]() {
+					goto l966
+				}
+				if !_rules[ruleAttributeTag]() {
+					goto l966
+				}
+				{
+					position1014, tokenIndex1014 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1014
+					}
+					goto l1015
+				l1014:
+					position, tokenIndex = position1014, tokenIndex1014
+				}
+			l1015:
+				if buffer[position] != rune(',') {
+					goto l966
+				}
+				position++
+				{
+					position1016, tokenIndex1016 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1016
+					}
+					goto l1017
+				l1016:
+					position, tokenIndex = position1016, tokenIndex1016
+				}
+			l1017:
+				if !_rules[ruleAttributeValue]() {
+					goto l966
+				}
+				add(ruleAttributeDirective, position967)
+			}
+			return true
+		l966:
+			position, tokenIndex = position966, tokenIndex966
+			return false
+		},
+		/* 33 AttributeTag <- <([a-z] / [A-Z] / ([0-9] / [0-9]) / '_')+> */
+		func() bool {
+			position1018, tokenIndex1018 := position, tokenIndex
+			{
+				position1019 := position
+				{
+					position1022, tokenIndex1022 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1023
+					}
+					position++
+					goto l1022
+				l1023:
+					position, tokenIndex = position1022, tokenIndex1022
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l1024
+					}
+					position++
+					goto l1022
+				l1024:
+					position, tokenIndex = position1022, tokenIndex1022
+					{
+						position1026, tokenIndex1026 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1027
+						}
+						position++
+						goto l1026
+					l1027:
+						position, tokenIndex = position1026, tokenIndex1026
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1025
+						}
+						position++
+					}
+				l1026:
+					goto l1022
+				l1025:
+					position, tokenIndex = position1022, tokenIndex1022
+					if buffer[position] != rune('_') {
+						goto l1018
+					}
+					position++
+				}
+			l1022:
+			l1020:
+				{
+					position1021, tokenIndex1021 := position, tokenIndex
+					{
+						position1028, tokenIndex1028 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1029
+						}
+						position++
+						goto l1028
+					l1029:
+						position, tokenIndex = position1028, tokenIndex1028
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1030
+						}
+						position++
+						goto l1028
+					l1030:
+						position, tokenIndex = position1028, tokenIndex1028
+						{
+							position1032, tokenIndex1032 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1033
+							}
+							position++
+							goto l1032
+						l1033:
+							position, tokenIndex = position1032, tokenIndex1032
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1031
+							}
+							position++
+						}
+					l1032:
+						goto l1028
+					l1031:
+						position, tokenIndex = position1028, tokenIndex1028
+						if buffer[position] != rune('_') {
+							goto l1021
+						}
+						position++
+					}
+				l1028:
+					goto l1020
+				l1021:
+					position, tokenIndex = position1021, tokenIndex1021
+				}
+				add(ruleAttributeTag, position1019)
+			}
+			return true
+		l1018:
+			position, tokenIndex = position1018, tokenIndex1018
+			return false
+		},
+		/* 34 AttributeValue <- <(QuotedArg / Offset)> */
+		func() bool {
+			position1034, tokenIndex1034 := position, tokenIndex
+			{
+				position1035 := position
+				{
+					position1036, tokenIndex1036 := position, tokenIndex
+					if !_rules[ruleQuotedArg]() {
+						goto l1037
+					}
+					goto l1036
+				l1037:
+					position, tokenIndex = position1036, tokenIndex1036
+					if !_rules[ruleOffset]() {
+						goto l1034
+					}
+				}
+			l1036:
+				add(ruleAttributeValue, position1035)
+			}
+			return true
+		l1034:
+			position, tokenIndex = position1034, tokenIndex1034
+			return false
+		},
+		/* 35 SEHDirective <- <(&{p.COFF} (('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('p' / 'P') ('r' / 'R') ('o' / 'O') ('c' / 'C') WS SymbolName) / ((('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('p' / 'P') ('u' / 'U') ('s' / 'S') ('h' / 'H') ('r' / 'R') ('e' / 'E') ('g' / 'G')) / ('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('s' / 'S') ('e' / 'E') ('t' / 'T') ('f' / 'F') ('r' / 'R') ('a' / 'A') ('m' / 'M') ('e' / 'E')) / ('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('e' / 'E') ('g' / 'G')) / ('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('x' / 'X') ('m' / 'M') ('m' / 'M'))) WS SEHRegister (WS? ',' WS? Offset)?) / ('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('s' / 'S') ('t' / 'T') ('a' / 'A') ('c' / 'C') ('k' / 'K') ('a' / 'A') ('l' / 'L') ('l' / 'L') ('o' / 'O') ('c' / 'C') WS Offset) / ((('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('e' / 'E') ('n' / 'N') ('d' / 'D') ('p' / 'P') ('r' / 'R') ('o' / 'O') ('l' / 'L') ('o' / 'O') ('g' / 'G') ('u' / 'U') ('e' / 'E')) / ('.' ('s' / 'S') ('e' / 'E') ('h' / 'H') '_' ('e' / 'E') ('n' / 'N') ('d' / 'D') ('p' / 'P') ('r' / 'R') ('o' / 'O') ('c' / 'C'))) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))))> */
+		func() bool {
+			position1038, tokenIndex1038 := position, tokenIndex
+			{
+				position1039 := position
+				if !(p.COFF) {
+					goto l1038
+				}
+				{
+					position1040, tokenIndex1040 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l1041
+					}
+					position++
+					{
+						position1042, tokenIndex1042 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1043
+						}
+						position++
+						goto l1042
+					l1043:
+						position, tokenIndex = position1042, tokenIndex1042
+						if buffer[position] != rune('S') {
+							goto l1041
+						}
+						position++
+					}
+				l1042:
+					{
+						position1044, tokenIndex1044 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1045
+						}
+						position++
+						goto l1044
+					l1045:
+						position, tokenIndex = position1044, tokenIndex1044
+						if buffer[position] != rune('E') {
+							goto l1041
+						}
+						position++
+					}
+				l1044:
+					{
+						position1046, tokenIndex1046 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l1047
+						}
+						position++
+						goto l1046
+					l1047:
+						position, tokenIndex = position1046, tokenIndex1046
+						if buffer[position] != rune('H') {
+							goto l1041
+						}
+						position++
+					}
+				l1046:
+					if buffer[position] != rune('_') {
+						goto l1041
+					}
+					position++
+					{
+						position1048, tokenIndex1048 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1049
+						}
+						position++
+						goto l1048
+					l1049:
+						position, tokenIndex = position1048, tokenIndex1048
+						if buffer[position] != rune('P') {
+							goto l1041
+						}
+						position++
+					}
+				l1048:
+					{
+						position1050, tokenIndex1050 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1051
+						}
+						position++
+						goto l1050
+					l1051:
+						position, tokenIndex = position1050, tokenIndex1050
+						if buffer[position] != rune('R') {
+							goto l1041
+						}
+						position++
+					}
+				l1050:
+					{
+						position1052, tokenIndex1052 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1053
+						}
+						position++
+						goto l1052
+					l1053:
+						position, tokenIndex = position1052, tokenIndex1052
+						if buffer[position] != rune('O') {
+							goto l1041
+						}
+						position++
+					}
+				l1052:
+					{
+						position1054, tokenIndex1054 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1055
+						}
+						position++
+						goto l1054
+					l1055:
+						position, tokenIndex = position1054, tokenIndex1054
+						if buffer[position] != rune('C') {
+							goto l1041
+						}
+						position++
+					}
+				l1054:
+					if !_rules[ruleWS]() {
+						goto l1041
+					}
+					if !_rules[ruleSymbolName]() {
+						goto l1041
+					}
+					goto l1040
+				l1041:
+					position, tokenIndex = position1040, tokenIndex1040
+					{
+						position1057, tokenIndex1057 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l1058
+						}
+						position++
+						{
+							position1059, tokenIndex1059 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1060
+							}
+							position++
+							goto l1059
+						l1060:
+							position, tokenIndex = position1059, tokenIndex1059
+							if buffer[position] != rune('S') {
+								goto l1058
+							}
+							position++
+						}
+					l1059:
+						{
+							position1061, tokenIndex1061 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1062
+							}
+							position++
+							goto l1061
+						l1062:
+							position, tokenIndex = position1061, tokenIndex1061
+							if buffer[position] != rune('E') {
+								goto l1058
+							}
+							position++
+						}
+					l1061:
+						{
+							position1063, tokenIndex1063 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1064
+							}
+							position++
+							goto l1063
+						l1064:
+							position, tokenIndex = position1063, tokenIndex1063
+							if buffer[position] != rune('H') {
+								goto l1058
+							}
+							position++
+						}
+					l1063:
+						if buffer[position] != rune('_') {
+							goto l1058
+						}
+						position++
+						{
+							position1065, tokenIndex1065 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1066
+							}
+							position++
+							goto l1065
+						l1066:
+							position, tokenIndex = position1065, tokenIndex1065
+							if buffer[position] != rune('P') {
+								goto l1058
+							}
+							position++
+						}
+					l1065:
+						{
+							position1067, tokenIndex1067 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1068
+							}
+							position++
+							goto l1067
+						l1068:
+							position, tokenIndex = position1067, tokenIndex1067
+							if buffer[position] != rune('U') {
+								goto l1058
+							}
+							position++
+						}
+					l1067:
+						{
+							position1069, tokenIndex1069 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1070
+							}
+							position++
+							goto l1069
+						l1070:
+							position, tokenIndex = position1069, tokenIndex1069
+							if buffer[position] != rune('S') {
+								goto l1058
+							}
+							position++
+						}
+					l1069:
+						{
+							position1071, tokenIndex1071 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1072
+							}
+							position++
+							goto l1071
+						l1072:
+							position, tokenIndex = position1071, tokenIndex1071
+							if buffer[position] != rune('H') {
+								goto l1058
+							}
+							position++
+						}
+					l1071:
+						{
+							position1073, tokenIndex1073 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1074
+							}
+							position++
+							goto l1073
+						l1074:
+							position, tokenIndex = position1073, tokenIndex1073
+							if buffer[position] != rune('R') {
+								goto l1058
+							}
+							position++
+						}
+					l1073:
+						{
+							position1075, tokenIndex1075 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1076
+							}
+							position++
+							goto l1075
+						l1076:
+							position, tokenIndex = position1075, tokenIndex1075
+							if buffer[position] != rune('E') {
+								goto l1058
+							}
+							position++
+						}
+					l1075:
+						{
+							position1077, tokenIndex1077 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1078
+							}
+							position++
+							goto l1077
+						l1078:
+							position, tokenIndex = position1077, tokenIndex1077
+							if buffer[position] != rune('G') {
+								goto l1058
+							}
+							position++
+						}
+					l1077:
+						goto l1057
+					l1058:
+						position, tokenIndex = position1057, tokenIndex1057
+						if buffer[position] != rune('.') {
+							goto l1079
+						}
+						position++
+						{
+							position1080, tokenIndex1080 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1081
+							}
+							position++
+							goto l1080
+						l1081:
+							position, tokenIndex = position1080, tokenIndex1080
+							if buffer[position] != rune('S') {
+								goto l1079
+							}
+							position++
+						}
+					l1080:
+						{
+							position1082, tokenIndex1082 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1083
+							}
+							position++
+							goto l1082
+						l1083:
+							position, tokenIndex = position1082, tokenIndex1082
+							if buffer[position] != rune('E') {
+								goto l1079
+							}
+							position++
+						}
+					l1082:
+						{
+							position1084, tokenIndex1084 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1085
+							}
+							position++
+							goto l1084
+						l1085:
+							position, tokenIndex = position1084, tokenIndex1084
+							if buffer[position] != rune('H') {
+								goto l1079
+							}
+							position++
+						}
+					l1084:
+						if buffer[position] != rune('_') {
+							goto l1079
+						}
+						position++
+						{
+							position1086, tokenIndex1086 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1087
+							}
+							position++
+							goto l1086
+						l1087:
+							position, tokenIndex = position1086, tokenIndex1086
+							if buffer[position] != rune('S') {
+								goto l1079
+							}
+							position++
+						}
+					l1086:
+						{
+							position1088, tokenIndex1088 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1089
+							}
+							position++
+							goto l1088
+						l1089:
+							position, tokenIndex = position1088, tokenIndex1088
+							if buffer[position] != rune('E') {
+								goto l1079
+							}
+							position++
+						}
+					l1088:
+						{
+							position1090, tokenIndex1090 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1091
+							}
+							position++
+							goto l1090
+						l1091:
+							position, tokenIndex = position1090, tokenIndex1090
+							if buffer[position] != rune('T') {
+								goto l1079
+							}
+							position++
+						}
+					l1090:
+						{
+							position1092, tokenIndex1092 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1093
+							}
+							position++
+							goto l1092
+						l1093:
+							position, tokenIndex = position1092, tokenIndex1092
+							if buffer[position] != rune('F') {
+								goto l1079
+							}
+							position++
+						}
+					l1092:
+						{
+							position1094, tokenIndex1094 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1095
+							}
+							position++
+							goto l1094
+						l1095:
+							position, tokenIndex = position1094, tokenIndex1094
+							if buffer[position] != rune('R') {
+								goto l1079
+							}
+							position++
+						}
+					l1094:
+						{
+							position1096, tokenIndex1096 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1097
+							}
+							position++
+							goto l1096
+						l1097:
+							position, tokenIndex = position1096, tokenIndex1096
+							if buffer[position] != rune('A') {
+								goto l1079
+							}
+							position++
+						}
+					l1096:
+						{
+							position1098, tokenIndex1098 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1099
+							}
+							position++
+							goto l1098
+						l1099:
+							position, tokenIndex = position1098, tokenIndex1098
+							if buffer[position] != rune('M') {
+								goto l1079
+							}
+							position++
+						}
+					l1098:
+						{
+							position1100, tokenIndex1100 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1101
+							}
+							position++
+							goto l1100
+						l1101:
+							position, tokenIndex = position1100, tokenIndex1100
+							if buffer[position] != rune('E') {
+								goto l1079
+							}
+							position++
+						}
+					l1100:
+						goto l1057
+					l1079:
+						position, tokenIndex = position1057, tokenIndex1057
+						if buffer[position] != rune('.') {
+							goto l1102
+						}
+						position++
+						{
+							position1103, tokenIndex1103 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1104
+							}
+							position++
+							goto l1103
+						l1104:
+							position, tokenIndex = position1103, tokenIndex1103
+							if buffer[position] != rune('S') {
+								goto l1102
+							}
+							position++
+						}
+					l1103:
+						{
+							position1105, tokenIndex1105 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1106
+							}
+							position++
+							goto l1105
+						l1106:
+							position, tokenIndex = position1105, tokenIndex1105
+							if buffer[position] != rune('E') {
+								goto l1102
+							}
+							position++
+						}
+					l1105:
+						{
+							position1107, tokenIndex1107 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1108
+							}
+							position++
+							goto l1107
+						l1108:
+							position, tokenIndex = position1107, tokenIndex1107
+							if buffer[position] != rune('H') {
+								goto l1102
+							}
+							position++
+						}
+					l1107:
+						if buffer[position] != rune('_') {
+							goto l1102
+						}
+						position++
+						{
+							position1109, tokenIndex1109 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1110
+							}
+							position++
+							goto l1109
+						l1110:
+							position, tokenIndex = position1109, tokenIndex1109
+							if buffer[position] != rune('S') {
+								goto l1102
+							}
+							position++
+						}
+					l1109:
+						{
+							position1111, tokenIndex1111 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1112
+							}
+							position++
+							goto l1111
+						l1112:
+							position, tokenIndex = position1111, tokenIndex1111
+							if buffer[position] != rune('A') {
+								goto l1102
+							}
+							position++
+						}
+					l1111:
+						{
+							position1113, tokenIndex1113 := position, tokenIndex
+							if buffer[position] != rune('v') {
+								goto l1114
+							}
+							position++
+							goto l1113
+						l1114:
+							position, tokenIndex = position1113, tokenIndex1113
+							if buffer[position] != rune('V') {
+								goto l1102
+							}
+							position++
+						}
+					l1113:
+						{
+							position1115, tokenIndex1115 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1116
+							}
+							position++
+							goto l1115
+						l1116:
+							position, tokenIndex = position1115, tokenIndex1115
+							if buffer[position] != rune('E') {
+								goto l1102
+							}
+							position++
+						}
+					l1115:
+						{
+							position1117, tokenIndex1117 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1118
+							}
+							position++
+							goto l1117
+						l1118:
+							position, tokenIndex = position1117, tokenIndex1117
+							if buffer[position] != rune('R') {
+								goto l1102
+							}
+							position++
+						}
+					l1117:
+						{
+							position1119, tokenIndex1119 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1120
+							}
+							position++
+							goto l1119
+						l1120:
+							position, tokenIndex = position1119, tokenIndex1119
+							if buffer[position] != rune('E') {
+								goto l1102
+							}
+							position++
+						}
+					l1119:
+						{
+							position1121, tokenIndex1121 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1122
+							}
+							position++
+							goto l1121
+						l1122:
+							position, tokenIndex = position1121, tokenIndex1121
+							if buffer[position] != rune('G') {
+								goto l1102
+							}
+							position++
+						}
+					l1121:
+						goto l1057
+					l1102:
+						position, tokenIndex = position1057, tokenIndex1057
+						if buffer[position] != rune('.') {
+							goto l1056
+						}
+						position++
+						{
+							position1123, tokenIndex1123 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1124
+							}
+							position++
+							goto l1123
+						l1124:
+							position, tokenIndex = position1123, tokenIndex1123
+							if buffer[position] != rune('S') {
+								goto l1056
+							}
+							position++
+						}
+					l1123:
+						{
+							position1125, tokenIndex1125 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1126
+							}
+							position++
+							goto l1125
+						l1126:
+							position, tokenIndex = position1125, tokenIndex1125
+							if buffer[position] != rune('E') {
+								goto l1056
+							}
+							position++
+						}
+					l1125:
+						{
+							position1127, tokenIndex1127 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1128
+							}
+							position++
+							goto l1127
+						l1128:
+							position, tokenIndex = position1127, tokenIndex1127
+							if buffer[position] != rune('H') {
+								goto l1056
+							}
+							position++
+						}
+					l1127:
+						if buffer[position] != rune('_') {
+							goto l1056
+						}
+						position++
+						{
+							position1129, tokenIndex1129 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1130
+							}
+							position++
+							goto l1129
+						l1130:
+							position, tokenIndex = position1129, tokenIndex1129
+							if buffer[position] != rune('S') {
+								goto l1056
+							}
+							position++
+						}
+					l1129:
+						{
+							position1131, tokenIndex1131 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1132
+							}
+							position++
+							goto l1131
+						l1132:
+							position, tokenIndex = position1131, tokenIndex1131
+							if buffer[position] != rune('A') {
+								goto l1056
+							}
+							position++
+						}
+					l1131:
+						{
+							position1133, tokenIndex1133 := position, tokenIndex
+							if buffer[position] != rune('v') {
+								goto l1134
+							}
+							position++
+							goto l1133
+						l1134:
+							position, tokenIndex = position1133, tokenIndex1133
+							if buffer[position] != rune('V') {
+								goto l1056
+							}
+							position++
+						}
+					l1133:
+						{
+							position1135, tokenIndex1135 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1136
+							}
+							position++
+							goto l1135
+						l1136:
+							position, tokenIndex = position1135, tokenIndex1135
+							if buffer[position] != rune('E') {
+								goto l1056
+							}
+							position++
+						}
+					l1135:
+						{
+							position1137, tokenIndex1137 := position, tokenIndex
+							if buffer[position] != rune('x') {
+								goto l1138
+							}
+							position++
+							goto l1137
+						l1138:
+							position, tokenIndex = position1137, tokenIndex1137
+							if buffer[position] != rune('X') {
+								goto l1056
+							}
+							position++
+						}
+					l1137:
+						{
+							position1139, tokenIndex1139 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1140
+							}
+							position++
+							goto l1139
+						l1140:
+							position, tokenIndex = position1139, tokenIndex1139
+							if buffer[position] != rune('M') {
+								goto l1056
+							}
+							position++
+						}
+					l1139:
+						{
+							position1141, tokenIndex1141 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1142
+							}
+							position++
+							goto l1141
+						l1142:
+							position, tokenIndex = position1141, tokenIndex1141
+							if buffer[position] != rune('M') {
+								goto l1056
+							}
+							position++
+						}
+					l1141:
+					}
+				l1057:
+					if !_rules[ruleWS]() {
+						goto l1056
+					}
+					if !_rules[ruleSEHRegister]() {
+						goto l1056
+					}
+					{
+						position1143, tokenIndex1143 := position, tokenIndex
+						{
+							position1145, tokenIndex1145 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l1145
+							}
+							goto l1146
+						l1145:
+							position, tokenIndex = position1145, tokenIndex1145
+						}
+					l1146:
+						if buffer[position] != rune(',') {
+							goto l1143
+						}
+						position++
+						{
+							position1147, tokenIndex1147 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l1147
+							}
+							goto l1148
+						l1147:
+							position, tokenIndex = position1147, tokenIndex1147
+						}
+					l1148:
+						if !_rules[ruleOffset]() {
+							goto l1143
+						}
+						goto l1144
+					l1143:
+						position, tokenIndex = position1143, tokenIndex1143
+					}
+				l1144:
+					goto l1040
+				l1056:
+					position, tokenIndex = position1040, tokenIndex1040
+					if buffer[position] != rune('.') {
+						goto l1149
+					}
+					position++
+					{
+						position1150, tokenIndex1150 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1151
+						}
+						position++
+						goto l1150
+					l1151:
+						position, tokenIndex = position1150, tokenIndex1150
+						if buffer[position] != rune('S') {
+							goto l1149
+						}
+						position++
+					}
+				l1150:
+					{
+						position1152, tokenIndex1152 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1153
+						}
+						position++
+						goto l1152
+					l1153:
+						position, tokenIndex = position1152, tokenIndex1152
+						if buffer[position] != rune('E') {
+							goto l1149
+						}
+						position++
+					}
+				l1152:
+					{
+						position1154, tokenIndex1154 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l1155
+						}
+						position++
+						goto l1154
+					l1155:
+						position, tokenIndex = position1154, tokenIndex1154
+						if buffer[position] != rune('H') {
+							goto l1149
+						}
+						position++
+					}
+				l1154:
+					if buffer[position] != rune('_') {
+						goto l1149
+					}
+					position++
+					{
+						position1156, tokenIndex1156 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1157
+						}
+						position++
+						goto l1156
+					l1157:
+						position, tokenIndex = position1156, tokenIndex1156
+						if buffer[position] != rune('S') {
+							goto l1149
+						}
+						position++
+					}
+				l1156:
+					{
+						position1158, tokenIndex1158 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1159
+						}
+						position++
+						goto l1158
+					l1159:
+						position, tokenIndex = position1158, tokenIndex1158
+						if buffer[position] != rune('T') {
+							goto l1149
+						}
+						position++
+					}
+				l1158:
+					{
+						position1160, tokenIndex1160 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1161
+						}
+						position++
+						goto l1160
+					l1161:
+						position, tokenIndex = position1160, tokenIndex1160
+						if buffer[position] != rune('A') {
+							goto l1149
+						}
+						position++
+					}
+				l1160:
+					{
+						position1162, tokenIndex1162 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1163
+						}
+						position++
+						goto l1162
+					l1163:
+						position, tokenIndex = position1162, tokenIndex1162
+						if buffer[position] != rune('C') {
+							goto l1149
+						}
+						position++
+					}
+				l1162:
+					{
+						position1164, tokenIndex1164 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l1165
+						}
+						position++
+						goto l1164
+					l1165:
+						position, tokenIndex = position1164, tokenIndex1164
+						if buffer[position] != rune('K') {
+							goto l1149
+						}
+						position++
+					}
+				l1164:
+					{
+						position1166, tokenIndex1166 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1167
+						}
+						position++
+						goto l1166
+					l1167:
+						position, tokenIndex = position1166, tokenIndex1166
+						if buffer[position] != rune('A') {
+							goto l1149
+						}
+						position++
+					}
+				l1166:
+					{
+						position1168, tokenIndex1168 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1169
+						}
+						position++
+						goto l1168
+					l1169:
+						position, tokenIndex = position1168, tokenIndex1168
+						if buffer[position] != rune('L') {
+							goto l1149
+						}
+						position++
+					}
+				l1168:
+					{
+						position1170, tokenIndex1170 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1171
+						}
+						position++
+						goto l1170
+					l1171:
+						position, tokenIndex = position1170, tokenIndex1170
+						if buffer[position] != rune('L') {
+							goto l1149
+						}
+						position++
+					}
+				l1170:
+					{
+						position1172, tokenIndex1172 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1173
+						}
+						position++
+						goto l1172
+					l1173:
+						position, tokenIndex = position1172, tokenIndex1172
+						if buffer[position] != rune('O') {
+							goto l1149
+						}
+						position++
+					}
+				l1172:
+					{
+						position1174, tokenIndex1174 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1175
+						}
+						position++
+						goto l1174
+					l1175:
+						position, tokenIndex = position1174, tokenIndex1174
+						if buffer[position] != rune('C') {
+							goto l1149
+						}
+						position++
+					}
+				l1174:
+					if !_rules[ruleWS]() {
+						goto l1149
+					}
+					if !_rules[ruleOffset]() {
+						goto l1149
+					}
+					goto l1040
+				l1149:
+					position, tokenIndex = position1040, tokenIndex1040
+					{
+						position1176, tokenIndex1176 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l1177
+						}
+						position++
+						{
+							position1178, tokenIndex1178 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1179
+							}
+							position++
+							goto l1178
+						l1179:
+							position, tokenIndex = position1178, tokenIndex1178
+							if buffer[position] != rune('S') {
+								goto l1177
+							}
+							position++
+						}
+					l1178:
+						{
+							position1180, tokenIndex1180 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1181
+							}
+							position++
+							goto l1180
+						l1181:
+							position, tokenIndex = position1180, tokenIndex1180
+							if buffer[position] != rune('E') {
+								goto l1177
+							}
+							position++
+						}
+					l1180:
+						{
+							position1182, tokenIndex1182 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1183
+							}
+							position++
+							goto l1182
+						l1183:
+							position, tokenIndex = position1182, tokenIndex1182
+							if buffer[position] != rune('H') {
+								goto l1177
+							}
+							position++
+						}
+					l1182:
+						if buffer[position] != rune('_') {
+							goto l1177
+						}
+						position++
+						{
+							position1184, tokenIndex1184 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1185
+							}
+							position++
+							goto l1184
+						l1185:
+							position, tokenIndex = position1184, tokenIndex1184
+							if buffer[position] != rune('E') {
+								goto l1177
+							}
+							position++
+						}
+					l1184:
+						{
+							position1186, tokenIndex1186 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1187
+							}
+							position++
+							goto l1186
+						l1187:
+							position, tokenIndex = position1186, tokenIndex1186
+							if buffer[position] != rune('N') {
+								goto l1177
+							}
+							position++
+						}
+					l1186:
+						{
+							position1188, tokenIndex1188 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1189
+							}
+							position++
+							goto l1188
+						l1189:
+							position, tokenIndex = position1188, tokenIndex1188
+							if buffer[position] != rune('D') {
+								goto l1177
+							}
+							position++
+						}
+					l1188:
+						{
+							position1190, tokenIndex1190 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1191
+							}
+							position++
+							goto l1190
+						l1191:
+							position, tokenIndex = position1190, tokenIndex1190
+							if buffer[position] != rune('P') {
+								goto l1177
+							}
+							position++
+						}
+					l1190:
+						{
+							position1192, tokenIndex1192 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1193
+							}
+							position++
+							goto l1192
+						l1193:
+							position, tokenIndex = position1192, tokenIndex1192
+							if buffer[position] != rune('R') {
+								goto l1177
+							}
+							position++
+						}
+					l1192:
+						{
+							position1194, tokenIndex1194 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1195
+							}
+							position++
+							goto l1194
+						l1195:
+							position, tokenIndex = position1194, tokenIndex1194
+							if buffer[position] != rune('O') {
+								goto l1177
+							}
+							position++
+						}
+					l1194:
+						{
+							position1196, tokenIndex1196 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1197
+							}
+							position++
+							goto l1196
+						l1197:
+							position, tokenIndex = position1196, tokenIndex1196
+							if buffer[position] != rune('L') {
+								goto l1177
+							}
+							position++
+						}
+					l1196:
+						{
+							position1198, tokenIndex1198 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1199
+							}
+							position++
+							goto l1198
+						l1199:
+							position, tokenIndex = position1198, tokenIndex1198
+							if buffer[position] != rune('O') {
+								goto l1177
+							}
+							position++
+						}
+					l1198:
+						{
+							position1200, tokenIndex1200 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1201
+							}
+							position++
+							goto l1200
+						l1201:
+							position, tokenIndex = position1200, tokenIndex1200
+							if buffer[position] != rune('G') {
+								goto l1177
+							}
+							position++
+						}
+					l1200:
+						{
+							position1202, tokenIndex1202 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1203
+							}
+							position++
+							goto l1202
+						l1203:
+							position, tokenIndex = position1202, tokenIndex1202
+							if buffer[position] != rune('U') {
+								goto l1177
+							}
+							position++
+						}
+					l1202:
+						{
+							position1204, tokenIndex1204 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1205
+							}
+							position++
+							goto l1204
+						l1205:
+							position, tokenIndex = position1204, tokenIndex1204
+							if buffer[position] != rune('E') {
+								goto l1177
+							}
+							position++
+						}
+					l1204:
+						goto l1176
+					l1177:
+						position, tokenIndex = position1176, tokenIndex1176
+						if buffer[position] != rune('.') {
+							goto l1038
+						}
+						position++
+						{
+							position1206, tokenIndex1206 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1207
+							}
+							position++
+							goto l1206
+						l1207:
+							position, tokenIndex = position1206, tokenIndex1206
+							if buffer[position] != rune('S') {
+								goto l1038
+							}
+							position++
+						}
+					l1206:
+						{
+							position1208, tokenIndex1208 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1209
+							}
+							position++
+							goto l1208
+						l1209:
+							position, tokenIndex = position1208, tokenIndex1208
+							if buffer[position] != rune('E') {
+								goto l1038
+							}
+							position++
+						}
+					l1208:
+						{
+							position1210, tokenIndex1210 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1211
+							}
+							position++
+							goto l1210
+						l1211:
+							position, tokenIndex = position1210, tokenIndex1210
+							if buffer[position] != rune('H') {
+								goto l1038
+							}
+							position++
+						}
+					l1210:
+						if buffer[position] != rune('_') {
+							goto l1038
+						}
+						position++
+						{
+							position1212, tokenIndex1212 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1213
+							}
+							position++
+							goto l1212
+						l1213:
+							position, tokenIndex = position1212, tokenIndex1212
+							if buffer[position] != rune('E') {
+								goto l1038
+							}
+							position++
+						}
+					l1212:
+						{
+							position1214, tokenIndex1214 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1215
+							}
+							position++
+							goto l1214
+						l1215:
+							position, tokenIndex = position1214, tokenIndex1214
+							if buffer[position] != rune('N') {
+								goto l1038
+							}
+							position++
+						}
+					l1214:
+						{
+							position1216, tokenIndex1216 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1217
+							}
+							position++
+							goto l1216
+						l1217:
+							position, tokenIndex = position1216, tokenIndex1216
+							if buffer[position] != rune('D') {
+								goto l1038
+							}
+							position++
+						}
+					l1216:
+						{
+							position1218, tokenIndex1218 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1219
+							}
+							position++
+							goto l1218
+						l1219:
+							position, tokenIndex = position1218, tokenIndex1218
+							if buffer[position] != rune('P') {
+								goto l1038
+							}
+							position++
+						}
+					l1218:
+						{
+							position1220, tokenIndex1220 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1221
+							}
+							position++
+							goto l1220
+						l1221:
+							position, tokenIndex = position1220, tokenIndex1220
+							if buffer[position] != rune('R') {
+								goto l1038
+							}
+							position++
+						}
+					l1220:
+						{
+							position1222, tokenIndex1222 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1223
+							}
+							position++
+							goto l1222
+						l1223:
+							position, tokenIndex = position1222, tokenIndex1222
+							if buffer[position] != rune('O') {
+								goto l1038
+							}
+							position++
+						}
+					l1222:
+						{
+							position1224, tokenIndex1224 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l1225
+							}
+							position++
+							goto l1224
+						l1225:
+							position, tokenIndex = position1224, tokenIndex1224
+							if buffer[position] != rune('C') {
+								goto l1038
+							}
+							position++
+						}
+					l1224:
+					}
+				l1176:
+					{
+						position1226, tokenIndex1226 := position, tokenIndex
+						{
+							position1227, tokenIndex1227 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l1228
+							}
+							position++
+							goto l1227
+						l1228:
+							position, tokenIndex = position1227, tokenIndex1227
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l1229
+							}
+							position++
+							goto l1227
+						l1229:
+							position, tokenIndex = position1227, tokenIndex1227
+							{
+								position1231, tokenIndex1231 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1232
+								}
+								position++
+								goto l1231
+							l1232:
+								position, tokenIndex = position1231, tokenIndex1231
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1230
+								}
+								position++
+							}
+						l1231:
+							goto l1227
+						l1230:
+							position, tokenIndex = position1227, tokenIndex1227
+							if buffer[position] != rune('_') {
+								goto l1226
+							}
+							position++
+						}
+					l1227:
+						goto l1038
+					l1226:
+						position, tokenIndex = position1226, tokenIndex1226
+					}
+				}
+			l1040:
+				add(ruleSEHDirective, position1039)
+			}
+			return true
+		l1038:
+			position, tokenIndex = position1038, tokenIndex1038
+			return false
+		},
+		/* 36 SEHRegister <- <('%' ([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))*)> */
+		func() bool {
+			position1233, tokenIndex1233 := position, tokenIndex
+			{
+				position1234 := position
+				if buffer[position] != rune('%') {
+					goto l1233
+				}
+				position++
+				{
+					position1235, tokenIndex1235 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1236
+					}
+					position++
+					goto l1235
+				l1236:
+					position, tokenIndex = position1235, tokenIndex1235
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l1233
+					}
+					position++
+				}
+			l1235:
+			l1237:
+				{
+					position1238, tokenIndex1238 := position, tokenIndex
+					{
+						position1239, tokenIndex1239 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1240
+						}
+						position++
+						goto l1239
+					l1240:
+						position, tokenIndex = position1239, tokenIndex1239
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1241
+						}
+						position++
+						goto l1239
+					l1241:
+						position, tokenIndex = position1239, tokenIndex1239
+						{
+							position1242, tokenIndex1242 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1243
+							}
+							position++
+							goto l1242
+						l1243:
+							position, tokenIndex = position1242, tokenIndex1242
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1238
+							}
+							position++
+						}
+					l1242:
+					}
+				l1239:
+					goto l1237
+				l1238:
+					position, tokenIndex = position1238, tokenIndex1238
+				}
+				add(ruleSEHRegister, position1234)
+			}
+			return true
+		l1233:
+			position, tokenIndex = position1233, tokenIndex1233
+			return false
+		},
+		/* 37 COFFSectionDirective <- <(&{p.COFF} ('.' ('s' / 'S') ('e' / 'E') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N')) WS COFFSectionName (WS? ',' WS? QuotedArg (WS? ',' WS? COFFSectionSelection (WS? ',' WS? SymbolName)?)?)?)> */
+		func() bool {
+			position1244, tokenIndex1244 := position, tokenIndex
+			{
+				position1245 := position
+				if !(p.COFF) {
+					goto l1244
+				}
+				if buffer[position] != rune('.') {
+					goto l1244
+				}
+				position++
+				{
+					position1246, tokenIndex1246 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1247
+					}
+					position++
+					goto l1246
+				l1247:
+					position, tokenIndex = position1246, tokenIndex1246
+					if buffer[position] != rune('S') {
+						goto l1244
+					}
+					position++
+				}
+			l1246:
+				{
+					position1248, tokenIndex1248 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1249
+					}
+					position++
+					goto l1248
+				l1249:
+					position, tokenIndex = position1248, tokenIndex1248
+					if buffer[position] != rune('E') {
+						goto l1244
+					}
+					position++
+				}
+			l1248:
+				{
+					position1250, tokenIndex1250 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1251
+					}
+					position++
+					goto l1250
+				l1251:
+					position, tokenIndex = position1250, tokenIndex1250
+					if buffer[position] != rune('C') {
+						goto l1244
+					}
+					position++
+				}
+			l1250:
+				{
+					position1252, tokenIndex1252 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1253
+					}
+					position++
+					goto l1252
+				l1253:
+					position, tokenIndex = position1252, tokenIndex1252
+					if buffer[position] != rune('T') {
+						goto l1244
+					}
+					position++
+				}
+			l1252:
+				{
+					position1254, tokenIndex1254 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1255
+					}
+					position++
+					goto l1254
+				l1255:
+					position, tokenIndex = position1254, tokenIndex1254
+					if buffer[position] != rune('I') {
+						goto l1244
+					}
+					position++
+				}
+			l1254:
+				{
+					position1256, tokenIndex1256 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1257
+					}
+					position++
+					goto l1256
+				l1257:
+					position, tokenIndex = position1256, tokenIndex1256
+					if buffer[position] != rune('O') {
+						goto l1244
+					}
+					position++
+				}
+			l1256:
+				{
+					position1258, tokenIndex1258 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1259
+					}
+					position++
+					goto l1258
+				l1259:
+					position, tokenIndex = position1258, tokenIndex1258
+					if buffer[position] != rune('N') {
+						goto l1244
+					}
+					position++
+				}
+			l1258:
+				if !_rules[ruleWS]() {
+					goto l1244
+				}
+				if !_rules[ruleCOFFSectionName]() {
+					goto l1244
+				}
+				{
+					position1260, tokenIndex1260 := position, tokenIndex
+					{
+						position1262, tokenIndex1262 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1262
+						}
+						goto l1263
+					l1262:
+						position, tokenIndex = position1262, tokenIndex1262
+					}
+				l1263:
+					if buffer[position] != rune(',') {
+						goto l1260
+					}
+					position++
+					{
+						position1264, tokenIndex1264 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1264
+						}
+						goto l1265
+					l1264:
+						position, tokenIndex = position1264, tokenIndex1264
+					}
+				l1265:
+					if !_rules[ruleQuotedArg]() {
+						goto l1260
+					}
+					{
+						position1266, tokenIndex1266 := position, tokenIndex
+						{
+							position1268, tokenIndex1268 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l1268
+							}
+							goto l1269
+						l1268:
+							position, tokenIndex = position1268, tokenIndex1268
+						}
+					l1269:
+						if buffer[position] != rune(',') {
+							goto l1266
+						}
+						position++
+						{
+							position1270, tokenIndex1270 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l1270
+							}
+							goto l1271
+						l1270:
+							position, tokenIndex = position1270, tokenIndex1270
+						}
+					l1271:
+						if !_rules[ruleCOFFSectionSelection]() {
+							goto l1266
+						}
+						{
+							position1272, tokenIndex1272 := position, tokenIndex
+							{
+								position1274, tokenIndex1274 := position, tokenIndex
+								if !_rules[ruleWS]() {
+									goto l1274
+								}
+								goto l1275
+							l1274:
+								position, tokenIndex = position1274, tokenIndex1274
+							}
+						l1275:
+							if buffer[position] != rune(',') {
+								goto l1272
+							}
+							position++
+							{
+								position1276, tokenIndex1276 := position, tokenIndex
+								if !_rules[ruleWS]() {
+									goto l1276
+								}
+								goto l1277
+							l1276:
+								position, tokenIndex = position1276, tokenIndex1276
+							}
+						l1277:
+							if !_rules[ruleSymbolName]() {
+								goto l1272
+							}
+							goto l1273
+						l1272:
+							position, tokenIndex = position1272, tokenIndex1272
+						}
+					l1273:
+						goto l1267
+					l1266:
+						position, tokenIndex = position1266, tokenIndex1266
+					}
+				l1267:
+					goto l1261
+				l1260:
+					position, tokenIndex = position1260, tokenIndex1260
+				}
+			l1261:
+				add(ruleCOFFSectionDirective, position1245)
+			}
+			return true
+		l1244:
+			position, tokenIndex = position1244, tokenIndex1244
+			return false
+		},
+		/* 38 COFFSectionName <- <(([a-z] / [A-Z] / '.' / '_') ([a-z] / [A-Z] / ([0-9] / [0-9]) / '.' / '_' / '$')*)> */
+		func() bool {
+			position1278, tokenIndex1278 := position, tokenIndex
+			{
+				position1279 := position
+				{
+					position1280, tokenIndex1280 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1281
+					}
+					position++
+					goto l1280
+				l1281:
+					position, tokenIndex = position1280, tokenIndex1280
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l1282
+					}
+					position++
+					goto l1280
+				l1282:
+					position, tokenIndex = position1280, tokenIndex1280
+					if buffer[position] != rune('.') {
+						goto l1283
+					}
+					position++
+					goto l1280
+				l1283:
+					position, tokenIndex = position1280, tokenIndex1280
+					if buffer[position] != rune('_') {
+						goto l1278
+					}
+					position++
+				}
+			l1280:
+			l1284:
+				{
+					position1285, tokenIndex1285 := position, tokenIndex
+					{
+						position1286, tokenIndex1286 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1287
+						}
+						position++
+						goto l1286
+					l1287:
+						position, tokenIndex = position1286, tokenIndex1286
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1288
+						}
+						position++
+						goto l1286
+					l1288:
+						position, tokenIndex = position1286, tokenIndex1286
+						{
+							position1290, tokenIndex1290 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1291
+							}
+							position++
+							goto l1290
+						l1291:
+							position, tokenIndex = position1290, tokenIndex1290
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1289
+							}
+							position++
+						}
+					l1290:
+						goto l1286
+					l1289:
+						position, tokenIndex = position1286, tokenIndex1286
+						if buffer[position] != rune('.') {
+							goto l1292
+						}
+						position++
+						goto l1286
+					l1292:
+						position, tokenIndex = position1286, tokenIndex1286
+						if buffer[position] != rune('_') {
+							goto l1293
+						}
+						position++
+						goto l1286
+					l1293:
+						position, tokenIndex = position1286, tokenIndex1286
+						if buffer[position] != rune('$') {
+							goto l1285
+						}
+						position++
+					}
+				l1286:
+					goto l1284
+				l1285:
+					position, tokenIndex = position1285, tokenIndex1285
+				}
+				add(ruleCOFFSectionName, position1279)
+			}
+			return true
+		l1278:
+			position, tokenIndex = position1278, tokenIndex1278
+			return false
+		},
+		/* 39 COFFSectionSelection <- <((('d' / 'D') ('i' / 'I') ('s' / 'S') ('c' / 'C') ('a' / 'A') ('r' / 'R') ('d' / 'D')) / (('o' / 'O') ('n' / 'N') ('e' / 'E') '_' ('o' / 'O') ('n' / 'N') ('l' / 'L') ('y' / 'Y')) / (('s' / 'S') ('a' / 'A') ('m' / 'M') ('e' / 'E') '_' ('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) / (('s' / 'S') ('a' / 'A') ('m' / 'M') ('e' / 'E') '_' ('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('e' / 'E') ('n' / 'N') ('t' / 'T') ('s' / 'S')) / (('a' / 'A') ('s' / 'S') ('s' / 'S') ('o' / 'O') ('c' / 'C') ('i' / 'I') ('a' / 'A') ('t' / 'T') ('i' / 'I') ('v' / 'V') ('e' / 'E')) / (('l' / 'L') ('a' / 'A') ('r' / 'R') ('g' / 'G') ('e' / 'E') ('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> */
+		func() bool {
+			position1294, tokenIndex1294 := position, tokenIndex
+			{
+				position1295 := position
+				{
+					position1296, tokenIndex1296 := position, tokenIndex
+					{
+						position1298, tokenIndex1298 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1299
+						}
+						position++
+						goto l1298
+					l1299:
+						position, tokenIndex = position1298, tokenIndex1298
+						if buffer[position] != rune('D') {
+							goto l1297
+						}
+						position++
+					}
+				l1298:
+					{
+						position1300, tokenIndex1300 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1301
+						}
+						position++
+						goto l1300
+					l1301:
+						position, tokenIndex = position1300, tokenIndex1300
+						if buffer[position] != rune('I') {
+							goto l1297
+						}
+						position++
+					}
+				l1300:
+					{
+						position1302, tokenIndex1302 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1303
+						}
+						position++
+						goto l1302
+					l1303:
+						position, tokenIndex = position1302, tokenIndex1302
+						if buffer[position] != rune('S') {
+							goto l1297
+						}
+						position++
+					}
+				l1302:
+					{
+						position1304, tokenIndex1304 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1305
+						}
+						position++
+						goto l1304
+					l1305:
+						position, tokenIndex = position1304, tokenIndex1304
+						if buffer[position] != rune('C') {
+							goto l1297
+						}
+						position++
+					}
+				l1304:
+					{
+						position1306, tokenIndex1306 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1307
+						}
+						position++
+						goto l1306
+					l1307:
+						position, tokenIndex = position1306, tokenIndex1306
+						if buffer[position] != rune('A') {
+							goto l1297
+						}
+						position++
+					}
+				l1306:
+					{
+						position1308, tokenIndex1308 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1309
+						}
+						position++
+						goto l1308
+					l1309:
+						position, tokenIndex = position1308, tokenIndex1308
+						if buffer[position] != rune('R') {
+							goto l1297
+						}
+						position++
+					}
+				l1308:
+					{
+						position1310, tokenIndex1310 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1311
+						}
+						position++
+						goto l1310
+					l1311:
+						position, tokenIndex = position1310, tokenIndex1310
+						if buffer[position] != rune('D') {
+							goto l1297
+						}
+						position++
+					}
+				l1310:
+					goto l1296
+				l1297:
+					position, tokenIndex = position1296, tokenIndex1296
+					{
+						position1313, tokenIndex1313 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1314
+						}
+						position++
+						goto l1313
+					l1314:
+						position, tokenIndex = position1313, tokenIndex1313
+						if buffer[position] != rune('O') {
+							goto l1312
+						}
+						position++
+					}
+				l1313:
+					{
+						position1315, tokenIndex1315 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1316
+						}
+						position++
+						goto l1315
+					l1316:
+						position, tokenIndex = position1315, tokenIndex1315
+						if buffer[position] != rune('N') {
+							goto l1312
+						}
+						position++
+					}
+				l1315:
+					{
+						position1317, tokenIndex1317 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1318
+						}
+						position++
+						goto l1317
+					l1318:
+						position, tokenIndex = position1317, tokenIndex1317
+						if buffer[position] != rune('E') {
+							goto l1312
+						}
+						position++
+					}
+				l1317:
+					if buffer[position] != rune('_') {
+						goto l1312
+					}
+					position++
+					{
+						position1319, tokenIndex1319 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1320
+						}
+						position++
+						goto l1319
+					l1320:
+						position, tokenIndex = position1319, tokenIndex1319
+						if buffer[position] != rune('O') {
+							goto l1312
+						}
+						position++
+					}
+				l1319:
+					{
+						position1321, tokenIndex1321 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1322
+						}
+						position++
+						goto l1321
+					l1322:
+						position, tokenIndex = position1321, tokenIndex1321
+						if buffer[position] != rune('N') {
+							goto l1312
+						}
+						position++
+					}
+				l1321:
+					{
+						position1323, tokenIndex1323 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1324
+						}
+						position++
+						goto l1323
+					l1324:
+						position, tokenIndex = position1323, tokenIndex1323
+						if buffer[position] != rune('L') {
+							goto l1312
+						}
+						position++
+					}
+				l1323:
+					{
+						position1325, tokenIndex1325 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l1326
+						}
+						position++
+						goto l1325
+					l1326:
+						position, tokenIndex = position1325, tokenIndex1325
+						if buffer[position] != rune('Y') {
+							goto l1312
+						}
+						position++
+					}
+				l1325:
+					goto l1296
+				l1312:
+					position, tokenIndex = position1296, tokenIndex1296
+					{
+						position1328, tokenIndex1328 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1329
+						}
+						position++
+						goto l1328
+					l1329:
+						position, tokenIndex = position1328, tokenIndex1328
+						if buffer[position] != rune('S') {
+							goto l1327
+						}
+						position++
+					}
+				l1328:
+					{
+						position1330, tokenIndex1330 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1331
+						}
+						position++
+						goto l1330
+					l1331:
+						position, tokenIndex = position1330, tokenIndex1330
+						if buffer[position] != rune('A') {
+							goto l1327
+						}
+						position++
+					}
+				l1330:
+					{
+						position1332, tokenIndex1332 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1333
+						}
+						position++
+						goto l1332
+					l1333:
+						position, tokenIndex = position1332, tokenIndex1332
+						if buffer[position] != rune('M') {
+							goto l1327
+						}
+						position++
+					}
+				l1332:
+					{
+						position1334, tokenIndex1334 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1335
+						}
+						position++
+						goto l1334
+					l1335:
+						position, tokenIndex = position1334, tokenIndex1334
+						if buffer[position] != rune('E') {
+							goto l1327
+						}
+						position++
+					}
+				l1334:
+					if buffer[position] != rune('_') {
+						goto l1327
+					}
+					position++
+					{
+						position1336, tokenIndex1336 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1337
+						}
+						position++
+						goto l1336
+					l1337:
+						position, tokenIndex = position1336, tokenIndex1336
+						if buffer[position] != rune('S') {
+							goto l1327
+						}
+						position++
+					}
+				l1336:
+					{
+						position1338, tokenIndex1338 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1339
+						}
+						position++
+						goto l1338
+					l1339:
+						position, tokenIndex = position1338, tokenIndex1338
+						if buffer[position] != rune('I') {
+							goto l1327
+						}
+						position++
+					}
+				l1338:
+					{
+						position1340, tokenIndex1340 := position, tokenIndex
+						if buffer[position] != rune('z') {
+							goto l1341
+						}
+						position++
+						goto l1340
+					l1341:
+						position, tokenIndex = position1340, tokenIndex1340
+						if buffer[position] != rune('Z') {
+							goto l1327
+						}
+						position++
+					}
+				l1340:
+					{
+						position1342, tokenIndex1342 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1343
+						}
+						position++
+						goto l1342
+					l1343:
+						position, tokenIndex = position1342, tokenIndex1342
+						if buffer[position] != rune('E') {
+							goto l1327
+						}
+						position++
+					}
+				l1342:
+					goto l1296
+				l1327:
+					position, tokenIndex = position1296, tokenIndex1296
+					{
+						position1345, tokenIndex1345 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1346
+						}
+						position++
+						goto l1345
+					l1346:
+						position, tokenIndex = position1345, tokenIndex1345
+						if buffer[position] != rune('S') {
+							goto l1344
+						}
+						position++
+					}
+				l1345:
+					{
+						position1347, tokenIndex1347 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1348
+						}
+						position++
+						goto l1347
+					l1348:
+						position, tokenIndex = position1347, tokenIndex1347
+						if buffer[position] != rune('A') {
+							goto l1344
+						}
+						position++
+					}
+				l1347:
+					{
+						position1349, tokenIndex1349 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1350
+						}
+						position++
+						goto l1349
+					l1350:
+						position, tokenIndex = position1349, tokenIndex1349
+						if buffer[position] != rune('M') {
+							goto l1344
+						}
+						position++
+					}
+				l1349:
+					{
+						position1351, tokenIndex1351 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1352
+						}
+						position++
+						goto l1351
+					l1352:
+						position, tokenIndex = position1351, tokenIndex1351
+						if buffer[position] != rune('E') {
+							goto l1344
+						}
+						position++
+					}
+				l1351:
+					if buffer[position] != rune('_') {
+						goto l1344
+					}
+					position++
+					{
+						position1353, tokenIndex1353 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1354
+						}
+						position++
+						goto l1353
+					l1354:
+						position, tokenIndex = position1353, tokenIndex1353
+						if buffer[position] != rune('C') {
+							goto l1344
+						}
+						position++
+					}
+				l1353:
+					{
+						position1355, tokenIndex1355 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1356
+						}
+						position++
+						goto l1355
+					l1356:
+						position, tokenIndex = position1355, tokenIndex1355
+						if buffer[position] != rune('O') {
+							goto l1344
+						}
+						position++
+					}
+				l1355:
+					{
+						position1357, tokenIndex1357 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1358
+						}
+						position++
+						goto l1357
+					l1358:
+						position, tokenIndex = position1357, tokenIndex1357
+						if buffer[position] != rune('N') {
+							goto l1344
+						}
+						position++
+					}
+				l1357:
+					{
+						position1359, tokenIndex1359 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1360
+						}
+						position++
+						goto l1359
+					l1360:
+						position, tokenIndex = position1359, tokenIndex1359
+						if buffer[position] != rune('T') {
+							goto l1344
+						}
+						position++
+					}
+				l1359:
+					{
+						position1361, tokenIndex1361 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1362
+						}
+						position++
+						goto l1361
+					l1362:
+						position, tokenIndex = position1361, tokenIndex1361
+						if buffer[position] != rune('E') {
+							goto l1344
+						}
+						position++
+					}
+				l1361:
+					{
+						position1363, tokenIndex1363 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1364
+						}
+						position++
+						goto l1363
+					l1364:
+						position, tokenIndex = position1363, tokenIndex1363
+						if buffer[position] != rune('N') {
+							goto l1344
+						}
+						position++
+					}
+				l1363:
+					{
+						position1365, tokenIndex1365 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1366
+						}
+						position++
+						goto l1365
+					l1366:
+						position, tokenIndex = position1365, tokenIndex1365
+						if buffer[position] != rune('T') {
+							goto l1344
+						}
+						position++
+					}
+				l1365:
+					{
+						position1367, tokenIndex1367 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1368
+						}
+						position++
+						goto l1367
+					l1368:
+						position, tokenIndex = position1367, tokenIndex1367
+						if buffer[position] != rune('S') {
+							goto l1344
+						}
+						position++
+					}
+				l1367:
+					goto l1296
+				l1344:
+					position, tokenIndex = position1296, tokenIndex1296
+					{
+						position1370, tokenIndex1370 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1371
+						}
+						position++
+						goto l1370
+					l1371:
+						position, tokenIndex = position1370, tokenIndex1370
+						if buffer[position] != rune('A') {
+							goto l1369
+						}
+						position++
+					}
+				l1370:
+					{
+						position1372, tokenIndex1372 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1373
+						}
+						position++
+						goto l1372
+					l1373:
+						position, tokenIndex = position1372, tokenIndex1372
+						if buffer[position] != rune('S') {
+							goto l1369
+						}
+						position++
+					}
+				l1372:
+					{
+						position1374, tokenIndex1374 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1375
+						}
+						position++
+						goto l1374
+					l1375:
+						position, tokenIndex = position1374, tokenIndex1374
+						if buffer[position] != rune('S') {
+							goto l1369
+						}
+						position++
+					}
+				l1374:
+					{
+						position1376, tokenIndex1376 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1377
+						}
+						position++
+						goto l1376
+					l1377:
+						position, tokenIndex = position1376, tokenIndex1376
+						if buffer[position] != rune('O') {
+							goto l1369
+						}
+						position++
+					}
+				l1376:
+					{
+						position1378, tokenIndex1378 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1379
+						}
+						position++
+						goto l1378
+					l1379:
+						position, tokenIndex = position1378, tokenIndex1378
+						if buffer[position] != rune('C') {
+							goto l1369
+						}
+						position++
+					}
+				l1378:
+					{
+						position1380, tokenIndex1380 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1381
+						}
+						position++
+						goto l1380
+					l1381:
+						position, tokenIndex = position1380, tokenIndex1380
+						if buffer[position] != rune('I') {
+							goto l1369
+						}
+						position++
+					}
+				l1380:
+					{
+						position1382, tokenIndex1382 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1383
+						}
+						position++
+						goto l1382
+					l1383:
+						position, tokenIndex = position1382, tokenIndex1382
+						if buffer[position] != rune('A') {
+							goto l1369
+						}
+						position++
+					}
+				l1382:
+					{
+						position1384, tokenIndex1384 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1385
+						}
+						position++
+						goto l1384
+					l1385:
+						position, tokenIndex = position1384, tokenIndex1384
+						if buffer[position] != rune('T') {
+							goto l1369
+						}
+						position++
+					}
+				l1384:
+					{
+						position1386, tokenIndex1386 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1387
+						}
+						position++
+						goto l1386
+					l1387:
+						position, tokenIndex = position1386, tokenIndex1386
+						if buffer[position] != rune('I') {
+							goto l1369
+						}
+						position++
+					}
+				l1386:
+					{
+						position1388, tokenIndex1388 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l1389
+						}
+						position++
+						goto l1388
+					l1389:
+						position, tokenIndex = position1388, tokenIndex1388
+						if buffer[position] != rune('V') {
+							goto l1369
+						}
+						position++
+					}
+				l1388:
+					{
+						position1390, tokenIndex1390 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1391
+						}
+						position++
+						goto l1390
+					l1391:
+						position, tokenIndex = position1390, tokenIndex1390
+						if buffer[position] != rune('E') {
+							goto l1369
+						}
+						position++
+					}
+				l1390:
+					goto l1296
+				l1369:
+					position, tokenIndex = position1296, tokenIndex1296
+					{
+						position1393, tokenIndex1393 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1394
+						}
+						position++
+						goto l1393
+					l1394:
+						position, tokenIndex = position1393, tokenIndex1393
+						if buffer[position] != rune('L') {
+							goto l1392
+						}
+						position++
+					}
+				l1393:
+					{
+						position1395, tokenIndex1395 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1396
+						}
+						position++
+						goto l1395
+					l1396:
+						position, tokenIndex = position1395, tokenIndex1395
+						if buffer[position] != rune('A') {
+							goto l1392
+						}
+						position++
+					}
+				l1395:
+					{
+						position1397, tokenIndex1397 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1398
+						}
+						position++
+						goto l1397
+					l1398:
+						position, tokenIndex = position1397, tokenIndex1397
+						if buffer[position] != rune('R') {
+							goto l1392
+						}
+						position++
+					}
+				l1397:
+					{
+						position1399, tokenIndex1399 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1400
+						}
+						position++
+						goto l1399
+					l1400:
+						position, tokenIndex = position1399, tokenIndex1399
+						if buffer[position] != rune('G') {
+							goto l1392
+						}
+						position++
+					}
+				l1399:
+					{
+						position1401, tokenIndex1401 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1402
+						}
+						position++
+						goto l1401
+					l1402:
+						position, tokenIndex = position1401, tokenIndex1401
+						if buffer[position] != rune('E') {
+							goto l1392
+						}
+						position++
+					}
+				l1401:
+					{
+						position1403, tokenIndex1403 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1404
+						}
+						position++
+						goto l1403
+					l1404:
+						position, tokenIndex = position1403, tokenIndex1403
+						if buffer[position] != rune('S') {
+							goto l1392
+						}
+						position++
+					}
+				l1403:
+					{
+						position1405, tokenIndex1405 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1406
+						}
+						position++
+						goto l1405
+					l1406:
+						position, tokenIndex = position1405, tokenIndex1405
+						if buffer[position] != rune('T') {
+							goto l1392
+						}
+						position++
+					}
+				l1405:
+					goto l1296
+				l1392:
+					position, tokenIndex = position1296, tokenIndex1296
+					{
+						position1407, tokenIndex1407 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1408
+						}
+						position++
+						goto l1407
+					l1408:
+						position, tokenIndex = position1407, tokenIndex1407
+						if buffer[position] != rune('N') {
+							goto l1294
+						}
+						position++
+					}
+				l1407:
+					{
+						position1409, tokenIndex1409 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1410
+						}
+						position++
+						goto l1409
+					l1410:
+						position, tokenIndex = position1409, tokenIndex1409
+						if buffer[position] != rune('E') {
+							goto l1294
+						}
+						position++
+					}
+				l1409:
+					{
+						position1411, tokenIndex1411 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l1412
+						}
+						position++
+						goto l1411
+					l1412:
+						position, tokenIndex = position1411, tokenIndex1411
+						if buffer[position] != rune('W') {
+							goto l1294
+						}
+						position++
+					}
+				l1411:
+					{
+						position1413, tokenIndex1413 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1414
+						}
+						position++
+						goto l1413
+					l1414:
+						position, tokenIndex = position1413, tokenIndex1413
+						if buffer[position] != rune('E') {
+							goto l1294
+						}
+						position++
+					}
+				l1413:
+					{
+						position1415, tokenIndex1415 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1416
+						}
+						position++
+						goto l1415
+					l1416:
+						position, tokenIndex = position1415, tokenIndex1415
+						if buffer[position] != rune('S') {
+							goto l1294
+						}
+						position++
+					}
+				l1415:
+					{
+						position1417, tokenIndex1417 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1418
+						}
+						position++
+						goto l1417
+					l1418:
+						position, tokenIndex = position1417, tokenIndex1417
+						if buffer[position] != rune('T') {
+							goto l1294
+						}
+						position++
+					}
+				l1417:
+				}
+			l1296:
+				add(ruleCOFFSectionSelection, position1295)
+			}
+			return true
+		l1294:
+			position, tokenIndex = position1294, tokenIndex1294
+			return false
+		},
+		/* 40 COFFDefDirective <- <(&{p.COFF} ('.' ('d' / 'D') ('e' / 'E') ('f' / 'F')) WS SymbolName (WS? ';' WS? (COFFStorageClass / COFFSymbolType))* WS? ';' WS? ('.' ('e' / 'E') ('n' / 'N') ('d' / 'D') ('e' / 'E') ('f' / 'F')))> */
+		func() bool {
+			position1419, tokenIndex1419 := position, tokenIndex
+			{
+				position1420 := position
+				if !(p.COFF) {
+					goto l1419
+				}
+				if buffer[position] != rune('.') {
+					goto l1419
+				}
+				position++
+				{
+					position1421, tokenIndex1421 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l1422
+					}
+					position++
+					goto l1421
+				l1422:
+					position, tokenIndex = position1421, tokenIndex1421
+					if buffer[position] != rune('D') {
+						goto l1419
+					}
+					position++
+				}
+			l1421:
+				{
+					position1423, tokenIndex1423 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1424
+					}
+					position++
+					goto l1423
+				l1424:
+					position, tokenIndex = position1423, tokenIndex1423
+					if buffer[position] != rune('E') {
+						goto l1419
+					}
+					position++
+				}
+			l1423:
+				{
+					position1425, tokenIndex1425 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l1426
+					}
+					position++
+					goto l1425
+				l1426:
+					position, tokenIndex = position1425, tokenIndex1425
+					if buffer[position] != rune('F') {
+						goto l1419
+					}
+					position++
+				}
+			l1425:
+				if !_rules[ruleWS]() {
+					goto l1419
+				}
+				if !_rules[ruleSymbolName]() {
+					goto l1419
+				}
+			l1427:
+				{
+					position1428, tokenIndex1428 := position, tokenIndex
+					{
+						position1429, tokenIndex1429 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1429
+						}
+						goto l1430
+					l1429:
+						position, tokenIndex = position1429, tokenIndex1429
+					}
+				l1430:
+					if buffer[position] != rune(';') {
+						goto l1428
+					}
+					position++
+					{
+						position1431, tokenIndex1431 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1431
+						}
+						goto l1432
+					l1431:
+						position, tokenIndex = position1431, tokenIndex1431
+					}
+				l1432:
+					{
+						position1433, tokenIndex1433 := position, tokenIndex
+						if !_rules[ruleCOFFStorageClass]() {
+							goto l1434
+						}
+						goto l1433
+					l1434:
+						position, tokenIndex = position1433, tokenIndex1433
+						if !_rules[ruleCOFFSymbolType]() {
+							goto l1428
+						}
+					}
+				l1433:
+					goto l1427
+				l1428:
+					position, tokenIndex = position1428, tokenIndex1428
+				}
+				{
+					position1435, tokenIndex1435 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1435
+					}
+					goto l1436
+				l1435:
+					position, tokenIndex = position1435, tokenIndex1435
+				}
+			l1436:
+				if buffer[position] != rune(';') {
+					goto l1419
+				}
+				position++
+				{
+					position1437, tokenIndex1437 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1437
+					}
+					goto l1438
+				l1437:
+					position, tokenIndex = position1437, tokenIndex1437
+				}
+			l1438:
+				if buffer[position] != rune('.') {
+					goto l1419
+				}
+				position++
+				{
+					position1439, tokenIndex1439 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1440
+					}
+					position++
+					goto l1439
+				l1440:
+					position, tokenIndex = position1439, tokenIndex1439
+					if buffer[position] != rune('E') {
+						goto l1419
+					}
+					position++
+				}
+			l1439:
+				{
+					position1441, tokenIndex1441 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1442
+					}
+					position++
+					goto l1441
+				l1442:
+					position, tokenIndex = position1441, tokenIndex1441
+					if buffer[position] != rune('N') {
+						goto l1419
+					}
+					position++
+				}
+			l1441:
+				{
+					position1443, tokenIndex1443 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l1444
+					}
+					position++
+					goto l1443
+				l1444:
+					position, tokenIndex = position1443, tokenIndex1443
+					if buffer[position] != rune('D') {
+						goto l1419
+					}
+					position++
+				}
+			l1443:
+				{
+					position1445, tokenIndex1445 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1446
+					}
+					position++
+					goto l1445
+				l1446:
+					position, tokenIndex = position1445, tokenIndex1445
+					if buffer[position] != rune('E') {
+						goto l1419
+					}
+					position++
+				}
+			l1445:
+				{
+					position1447, tokenIndex1447 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l1448
+					}
+					position++
+					goto l1447
+				l1448:
+					position, tokenIndex = position1447, tokenIndex1447
+					if buffer[position] != rune('F') {
+						goto l1419
+					}
+					position++
+				}
+			l1447:
+				add(ruleCOFFDefDirective, position1420)
+			}
+			return true
+		l1419:
+			position, tokenIndex = position1419, tokenIndex1419
+			return false
+		},
+		/* 41 COFFStorageClass <- <('.' ('s' / 'S') ('c' / 'C') ('l' / 'L') WS Offset)> */
+		func() bool {
+			position1449, tokenIndex1449 := position, tokenIndex
+			{
+				position1450 := position
+				if buffer[position] != rune('.') {
+					goto l1449
+				}
+				position++
+				{
+					position1451, tokenIndex1451 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1452
+					}
+					position++
+					goto l1451
+				l1452:
+					position, tokenIndex = position1451, tokenIndex1451
+					if buffer[position] != rune('S') {
+						goto l1449
+					}
+					position++
+				}
+			l1451:
+				{
+					position1453, tokenIndex1453 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1454
+					}
+					position++
+					goto l1453
+				l1454:
+					position, tokenIndex = position1453, tokenIndex1453
+					if buffer[position] != rune('C') {
+						goto l1449
+					}
+					position++
+				}
+			l1453:
+				{
+					position1455, tokenIndex1455 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1456
+					}
+					position++
+					goto l1455
+				l1456:
+					position, tokenIndex = position1455, tokenIndex1455
+					if buffer[position] != rune('L') {
+						goto l1449
+					}
+					position++
+				}
+			l1455:
+				if !_rules[ruleWS]() {
+					goto l1449
+				}
+				if !_rules[ruleOffset]() {
+					goto l1449
+				}
+				add(ruleCOFFStorageClass, position1450)
+			}
+			return true
+		l1449:
+			position, tokenIndex = position1449, tokenIndex1449
+			return false
+		},
+		/* 42 COFFSymbolType <- <('.' ('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') WS Offset)> */
+		func() bool {
+			position1457, tokenIndex1457 := position, tokenIndex
+			{
+				position1458 := position
+				if buffer[position] != rune('.') {
+					goto l1457
+				}
+				position++
+				{
+					position1459, tokenIndex1459 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1460
+					}
+					position++
+					goto l1459
+				l1460:
+					position, tokenIndex = position1459, tokenIndex1459
+					if buffer[position] != rune('T') {
+						goto l1457
+					}
+					position++
+				}
+			l1459:
+				{
+					position1461, tokenIndex1461 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l1462
+					}
+					position++
+					goto l1461
+				l1462:
+					position, tokenIndex = position1461, tokenIndex1461
+					if buffer[position] != rune('Y') {
+						goto l1457
+					}
+					position++
+				}
+			l1461:
+				{
+					position1463, tokenIndex1463 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1464
+					}
+					position++
+					goto l1463
+				l1464:
+					position, tokenIndex = position1463, tokenIndex1463
+					if buffer[position] != rune('P') {
+						goto l1457
+					}
+					position++
+				}
+			l1463:
+				{
+					position1465, tokenIndex1465 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1466
+					}
+					position++
+					goto l1465
+				l1466:
+					position, tokenIndex = position1465, tokenIndex1465
+					if buffer[position] != rune('E') {
+						goto l1457
+					}
+					position++
+				}
+			l1465:
+				if !_rules[ruleWS]() {
+					goto l1457
+				}
+				if !_rules[ruleOffset]() {
+					goto l1457
+				}
+				add(ruleCOFFSymbolType, position1458)
+			}
+			return true
+		l1457:
+			position, tokenIndex = position1457, tokenIndex1457
+			return false
+		},
+		/* 43 Args <- <(Arg (WS? ',' WS? Arg)*)> */
+		func() bool {
+			position1467, tokenIndex1467 := position, tokenIndex
+			{
+				position1468 := position
+				if !_rules[ruleArg]() {
+					goto l1467
+				}
+			l1469:
+				{
+					position1470, tokenIndex1470 := position, tokenIndex
+					{
+						position1471, tokenIndex1471 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1471
+						}
+						goto l1472
+					l1471:
+						position, tokenIndex = position1471, tokenIndex1471
+					}
+				l1472:
+					if buffer[position] != rune(',') {
+						goto l1470
+					}
+					position++
+					{
+						position1473, tokenIndex1473 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1473
+						}
+						goto l1474
+					l1473:
+						position, tokenIndex = position1473, tokenIndex1473
+					}
+				l1474:
+					if !_rules[ruleArg]() {
+						goto l1470
+					}
+					goto l1469
+				l1470:
+					position, tokenIndex = position1470, tokenIndex1470
+				}
+				add(ruleArgs, position1468)
+			}
+			return true
+		l1467:
+			position, tokenIndex = position1467, tokenIndex1467
+			return false
+		},
+		/* 44 Arg <- <(QuotedArg / ([0-9] / [0-9] / ([a-z] / [A-Z]) / '%' / '+' / '-' / '*' / '_' / '@' / '.')*)> */
+		func() bool {
+			{
+				position1476 := position
+				{
+					position1477, tokenIndex1477 := position, tokenIndex
+					if !_rules[ruleQuotedArg]() {
+						goto l1478
+					}
+					goto l1477
+				l1478:
+					position, tokenIndex = position1477, tokenIndex1477
+				l1479:
+					{
+						position1480, tokenIndex1480 := position, tokenIndex
+						{
+							position1481, tokenIndex1481 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1482
+							}
+							position++
+							goto l1481
+						l1482:
+							position, tokenIndex = position1481, tokenIndex1481
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1483
+							}
+							position++
+							goto l1481
+						l1483:
+							position, tokenIndex = position1481, tokenIndex1481
+							{
+								position1485, tokenIndex1485 := position, tokenIndex
+								if c := buffer[position]; c < rune('a') || c > rune('z') {
+									goto l1486
+								}
+								position++
+								goto l1485
+							l1486:
+								position, tokenIndex = position1485, tokenIndex1485
+								if c := buffer[position]; c < rune('A') || c > rune('Z') {
+									goto l1484
+								}
+								position++
+							}
+						l1485:
+							goto l1481
+						l1484:
+							position, tokenIndex = position1481, tokenIndex1481
+							if buffer[position] != rune('%') {
+								goto l1487
+							}
+							position++
+							goto l1481
+						l1487:
+							position, tokenIndex = position1481, tokenIndex1481
+							if buffer[position] != rune('+') {
+								goto l1488
+							}
+							position++
+							goto l1481
+						l1488:
+							position, tokenIndex = position1481, tokenIndex1481
+							if buffer[position] != rune('-') {
+								goto l1489
+							}
+							position++
+							goto l1481
+						l1489:
+							position, tokenIndex = position1481, tokenIndex1481
+							if buffer[position] != rune('*') {
+								goto l1490
+							}
+							position++
+							goto l1481
+						l1490:
+							position, tokenIndex = position1481, tokenIndex1481
+							if buffer[position] != rune('_') {
+								goto l1491
+							}
+							position++
+							goto l1481
+						l1491:
+							position, tokenIndex = position1481, tokenIndex1481
+							if buffer[position] != rune('@') {
+								goto l1492
+							}
+							position++
+							goto l1481
+						l1492:
+							position, tokenIndex = position1481, tokenIndex1481
+							if buffer[position] != rune('.') {
+								goto l1480
+							}
+							position++
+						}
+					l1481:
+						goto l1479
+					l1480:
+						position, tokenIndex = position1480, tokenIndex1480
+					}
+				}
+			l1477:
+				add(ruleArg, position1476)
+			}
+			return true
+		},
+		/* 45 QuotedArg <- <('"' QuotedText '"')> */
+		func() bool {
+			position1493, tokenIndex1493 := position, tokenIndex
+			{
+				position1494 := position
+				if buffer[position] != rune('"') {
+					goto l1493
+				}
+				position++
+				if !_rules[ruleQuotedText]() {
+					goto l1493
+				}
+				if buffer[position] != rune('"') {
+					goto l1493
+				}
+				position++
+				add(ruleQuotedArg, position1494)
+			}
+			return true
+		l1493:
+			position, tokenIndex = position1493, tokenIndex1493
+			return false
+		},
+		/* 46 QuotedText <- <(EscapedChar / (!'"' .))*> */
+		func() bool {
+			{
+				position1496 := position
+			l1497:
+				{
+					position1498, tokenIndex1498 := position, tokenIndex
+					{
+						position1499, tokenIndex1499 := position, tokenIndex
+						if !_rules[ruleEscapedChar]() {
+							goto l1500
+						}
+						goto l1499
+					l1500:
+						position, tokenIndex = position1499, tokenIndex1499
+						{
+							position1501, tokenIndex1501 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l1501
+							}
+							position++
+							goto l1498
+						l1501:
+							position, tokenIndex = position1501, tokenIndex1501
+						}
+						if !matchDot() {
+							goto l1498
+						}
+					}
+				l1499:
+					goto l1497
+				l1498:
+					position, tokenIndex = position1498, tokenIndex1498
+				}
+				add(ruleQuotedText, position1496)
+			}
+			return true
+		},
+		/* 47 LabelContainingDirective <- <(LabelContainingDirectiveName WS SymbolArgs)> */
+		func() bool {
+			position1502, tokenIndex1502 := position, tokenIndex
+			{
+				position1503 := position
+				if !_rules[ruleLabelContainingDirectiveName]() {
+					goto l1502
+				}
+				if !_rules[ruleWS]() {
+					goto l1502
+				}
+				if !_rules[ruleSymbolArgs]() {
+					goto l1502
+				}
+				add(ruleLabelContainingDirective, position1503)
+			}
+			return true
+		l1502:
+			position, tokenIndex = position1502, tokenIndex1502
+			return false
+		},
+		/* 48 EquDirective <- <(EquDirectiveName WS (LocalSymbol / SymbolName) WS? ',' WS? SymbolArg &(WS? (Comment / '\n' / ';')))> */
+		func() bool {
+			position1504, tokenIndex1504 := position, tokenIndex
+			{
+				position1505 := position
+				if !_rules[ruleEquDirectiveName]() {
+					goto l1504
+				}
+				if !_rules[ruleWS]() {
+					goto l1504
+				}
+				{
+					position1506, tokenIndex1506 := position, tokenIndex
+					if !_rules[ruleLocalSymbol]() {
+						goto l1507
+					}
+					goto l1506
+				l1507:
+					position, tokenIndex = position1506, tokenIndex1506
+					if !_rules[ruleSymbolName]() {
+						goto l1504
+					}
+				}
+			l1506:
+				{
+					position1508, tokenIndex1508 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1508
+					}
+					goto l1509
+				l1508:
+					position, tokenIndex = position1508, tokenIndex1508
+				}
+			l1509:
+				if buffer[position] != rune(',') {
+					goto l1504
+				}
+				position++
+				{
+					position1510, tokenIndex1510 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1510
+					}
+					goto l1511
+				l1510:
+					position, tokenIndex = position1510, tokenIndex1510
+				}
+			l1511:
+				if !_rules[ruleSymbolArg]() {
+					goto l1504
+				}
+				position1512, tokenIndex1512 := position, tokenIndex
+				{
+					position1513, tokenIndex1513 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1513
+					}
+					goto l1514
+				l1513:
+					position, tokenIndex = position1513, tokenIndex1513
+				}
+			l1514:
+				{
+					position1515, tokenIndex1515 := position, tokenIndex
+					if !_rules[ruleComment]() {
+						goto l1516
+					}
+					goto l1515
+				l1516:
+					position, tokenIndex = position1515, tokenIndex1515
+					if buffer[position] != rune('\n') {
+						goto l1517
+					}
+					position++
+					goto l1515
+				l1517:
+					position, tokenIndex = position1515, tokenIndex1515
+					if buffer[position] != rune(';') {
+						goto l1504
+					}
+					position++
+				}
+			l1515:
+				position, tokenIndex = position1512, tokenIndex1512
+				add(ruleEquDirective, position1505)
+			}
+			return true
+		l1504:
+			position, tokenIndex = position1504, tokenIndex1504
+			return false
+		},
+		/* 49 EquDirectiveName <- <(('.' ('e' / 'E') ('q' / 'Q') ('u' / 'U') ('i' / 'I') ('v' / 'V')) / ('.' ('e' / 'E') ('q' / 'Q') ('u' / 'U')))> */
+		func() bool {
+			position1518, tokenIndex1518 := position, tokenIndex
+			{
+				position1519 := position
+				{
+					position1520, tokenIndex1520 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l1521
+					}
+					position++
+					{
+						position1522, tokenIndex1522 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1523
+						}
+						position++
+						goto l1522
+					l1523:
+						position, tokenIndex = position1522, tokenIndex1522
+						if buffer[position] != rune('E') {
+							goto l1521
+						}
+						position++
+					}
+				l1522:
+					{
+						position1524, tokenIndex1524 := position, tokenIndex
+						if buffer[position] != rune('q') {
+							goto l1525
+						}
+						position++
+						goto l1524
+					l1525:
+						position, tokenIndex = position1524, tokenIndex1524
+						if buffer[position] != rune('Q') {
+							goto l1521
+						}
+						position++
+					}
+				l1524:
+					{
+						position1526, tokenIndex1526 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1527
+						}
+						position++
+						goto l1526
+					l1527:
+						position, tokenIndex = position1526, tokenIndex1526
+						if buffer[position] != rune('U') {
+							goto l1521
+						}
+						position++
+					}
+				l1526:
+					{
+						position1528, tokenIndex1528 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1529
+						}
+						position++
+						goto l1528
+					l1529:
+						position, tokenIndex = position1528, tokenIndex1528
+						if buffer[position] != rune('I') {
+							goto l1521
+						}
+						position++
+					}
+				l1528:
+					{
+						position1530, tokenIndex1530 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l1531
+						}
+						position++
+						goto l1530
+					l1531:
+						position, tokenIndex = position1530, tokenIndex1530
+						if buffer[position] != rune('V') {
+							goto l1521
+						}
+						position++
+					}
+				l1530:
+					goto l1520
+				l1521:
+					position, tokenIndex = position1520, tokenIndex1520
+					if buffer[position] != rune('.') {
+						goto l1518
+					}
+					position++
+					{
+						position1532, tokenIndex1532 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1533
+						}
+						position++
+						goto l1532
+					l1533:
+						position, tokenIndex = position1532, tokenIndex1532
+						if buffer[position] != rune('E') {
+							goto l1518
+						}
+						position++
+					}
+				l1532:
+					{
+						position1534, tokenIndex1534 := position, tokenIndex
+						if buffer[position] != rune('q') {
+							goto l1535
+						}
+						position++
+						goto l1534
+					l1535:
+						position, tokenIndex = position1534, tokenIndex1534
+						if buffer[position] != rune('Q') {
+							goto l1518
+						}
+						position++
+					}
+				l1534:
+					{
+						position1536, tokenIndex1536 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1537
+						}
+						position++
+						goto l1536
+					l1537:
+						position, tokenIndex = position1536, tokenIndex1536
+						if buffer[position] != rune('U') {
+							goto l1518
+						}
+						position++
+					}
+				l1536:
+				}
+			l1520:
+				add(ruleEquDirectiveName, position1519)
+			}
+			return true
+		l1518:
+			position, tokenIndex = position1518, tokenIndex1518
+			return false
+		},
+		/* 50 DiagnosticDirective <- <(DiagnosticDirectiveName WS QuotedArg)> */
+		func() bool {
+			position1538, tokenIndex1538 := position, tokenIndex
+			{
+				position1539 := position
+				if !_rules[ruleDiagnosticDirectiveName]() {
+					goto l1538
+				}
+				if !_rules[ruleWS]() {
+					goto l1538
+				}
+				if !_rules[ruleQuotedArg]() {
+					goto l1538
+				}
+				add(ruleDiagnosticDirective, position1539)
+			}
+			return true
+		l1538:
+			position, tokenIndex = position1538, tokenIndex1538
+			return false
+		},
+		/* 51 DiagnosticDirectiveName <- <(('.' ('p' / 'P') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('t' / 'T')) / ('.' ('w' / 'W') ('a' / 'A') ('r' / 'R') ('n' / 'N') ('i' / 'I') ('n' / 'N') ('g' / 'G')) / ('.' ('e' / 'E') ('r' / 'R') ('r' / 'R') ('o' / 'O') ('r' / 'R')))> */
+		func() bool {
+			position1540, tokenIndex1540 := position, tokenIndex
+			{
+				position1541 := position
+				{
+					position1542, tokenIndex1542 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l1543
+					}
+					position++
+					{
+						position1544, tokenIndex1544 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1545
+						}
+						position++
+						goto l1544
+					l1545:
+						position, tokenIndex = position1544, tokenIndex1544
+						if buffer[position] != rune('P') {
+							goto l1543
+						}
+						position++
+					}
+				l1544:
+					{
+						position1546, tokenIndex1546 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1547
+						}
+						position++
+						goto l1546
+					l1547:
+						position, tokenIndex = position1546, tokenIndex1546
+						if buffer[position] != rune('R') {
+							goto l1543
+						}
+						position++
+					}
+				l1546:
+					{
+						position1548, tokenIndex1548 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1549
+						}
+						position++
+						goto l1548
+					l1549:
+						position, tokenIndex = position1548, tokenIndex1548
+						if buffer[position] != rune('I') {
+							goto l1543
+						}
+						position++
+					}
+				l1548:
+					{
+						position1550, tokenIndex1550 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1551
+						}
+						position++
+						goto l1550
+					l1551:
+						position, tokenIndex = position1550, tokenIndex1550
+						if buffer[position] != rune('N') {
+							goto l1543
+						}
+						position++
+					}
+				l1550:
+					{
+						position1552, tokenIndex1552 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1553
+						}
+						position++
+						goto l1552
+					l1553:
+						position, tokenIndex = position1552, tokenIndex1552
+						if buffer[position] != rune('T') {
+							goto l1543
+						}
+						position++
+					}
+				l1552:
+					goto l1542
+				l1543:
+					position, tokenIndex = position1542, tokenIndex1542
+					if buffer[position] != rune('.') {
+						goto l1554
+					}
+					position++
+					{
+						position1555, tokenIndex1555 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l1556
+						}
+						position++
+						goto l1555
+					l1556:
+						position, tokenIndex = position1555, tokenIndex1555
+						if buffer[position] != rune('W') {
+							goto l1554
+						}
+						position++
+					}
+				l1555:
+					{
+						position1557, tokenIndex1557 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1558
+						}
+						position++
+						goto l1557
+					l1558:
+						position, tokenIndex = position1557, tokenIndex1557
+						if buffer[position] != rune('A') {
+							goto l1554
+						}
+						position++
+					}
+				l1557:
+					{
+						position1559, tokenIndex1559 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1560
+						}
+						position++
+						goto l1559
+					l1560:
+						position, tokenIndex = position1559, tokenIndex1559
+						if buffer[position] != rune('R') {
+							goto l1554
+						}
+						position++
+					}
+				l1559:
+					{
+						position1561, tokenIndex1561 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1562
+						}
+						position++
+						goto l1561
+					l1562:
+						position, tokenIndex = position1561, tokenIndex1561
+						if buffer[position] != rune('N') {
+							goto l1554
+						}
+						position++
+					}
+				l1561:
+					{
+						position1563, tokenIndex1563 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1564
+						}
+						position++
+						goto l1563
+					l1564:
+						position, tokenIndex = position1563, tokenIndex1563
+						if buffer[position] != rune('I') {
+							goto l1554
+						}
+						position++
+					}
+				l1563:
+					{
+						position1565, tokenIndex1565 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1566
+						}
+						position++
+						goto l1565
+					l1566:
+						position, tokenIndex = position1565, tokenIndex1565
+						if buffer[position] != rune('N') {
+							goto l1554
+						}
+						position++
+					}
+				l1565:
+					{
+						position1567, tokenIndex1567 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1568
+						}
+						position++
+						goto l1567
+					l1568:
+						position, tokenIndex = position1567, tokenIndex1567
+						if buffer[position] != rune('G') {
+							goto l1554
+						}
+						position++
+					}
+				l1567:
+					goto l1542
+				l1554:
+					position, tokenIndex = position1542, tokenIndex1542
+					if buffer[position] != rune('.') {
+						goto l1540
+					}
+					position++
+					{
+						position1569, tokenIndex1569 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1570
+						}
+						position++
+						goto l1569
+					l1570:
+						position, tokenIndex = position1569, tokenIndex1569
+						if buffer[position] != rune('E') {
+							goto l1540
+						}
+						position++
+					}
+				l1569:
+					{
+						position1571, tokenIndex1571 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1572
+						}
+						position++
+						goto l1571
+					l1572:
+						position, tokenIndex = position1571, tokenIndex1571
+						if buffer[position] != rune('R') {
+							goto l1540
+						}
+						position++
+					}
+				l1571:
+					{
+						position1573, tokenIndex1573 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1574
+						}
+						position++
+						goto l1573
+					l1574:
+						position, tokenIndex = position1573, tokenIndex1573
+						if buffer[position] != rune('R') {
+							goto l1540
+						}
+						position++
+					}
+				l1573:
+					{
+						position1575, tokenIndex1575 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1576
+						}
+						position++
+						goto l1575
+					l1576:
+						position, tokenIndex = position1575, tokenIndex1575
+						if buffer[position] != rune('O') {
+							goto l1540
+						}
+						position++
+					}
+				l1575:
+					{
+						position1577, tokenIndex1577 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1578
+						}
+						position++
+						goto l1577
+					l1578:
+						position, tokenIndex = position1577, tokenIndex1577
+						if buffer[position] != rune('R') {
+							goto l1540
+						}
+						position++
+					}
+				l1577:
+				}
+			l1542:
+				add(ruleDiagnosticDirectiveName, position1541)
+			}
+			return true
+		l1540:
+			position, tokenIndex = position1540, tokenIndex1540
+			return false
+		},
+		/* 52 IdentDirective <- <('.' ('i' / 'I') ('d' / 'D') ('e' / 'E') ('n' / 'N') ('t' / 'T') WS QuotedArg)> */
+		func() bool {
+			position1579, tokenIndex1579 := position, tokenIndex
+			{
+				position1580 := position
+				if buffer[position] != rune('.') {
+					goto l1579
+				}
+				position++
+				{
+					position1581, tokenIndex1581 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1582
+					}
+					position++
+					goto l1581
+				l1582:
+					position, tokenIndex = position1581, tokenIndex1581
+					if buffer[position] != rune('I') {
+						goto l1579
+					}
+					position++
+				}
+			l1581:
+				{
+					position1583, tokenIndex1583 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l1584
+					}
+					position++
+					goto l1583
+				l1584:
+					position, tokenIndex = position1583, tokenIndex1583
+					if buffer[position] != rune('D') {
+						goto l1579
+					}
+					position++
+				}
+			l1583:
+				{
+					position1585, tokenIndex1585 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1586
+					}
+					position++
+					goto l1585
+				l1586:
+					position, tokenIndex = position1585, tokenIndex1585
+					if buffer[position] != rune('E') {
+						goto l1579
+					}
+					position++
+				}
+			l1585:
+				{
+					position1587, tokenIndex1587 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1588
+					}
+					position++
+					goto l1587
+				l1588:
+					position, tokenIndex = position1587, tokenIndex1587
+					if buffer[position] != rune('N') {
+						goto l1579
+					}
+					position++
+				}
+			l1587:
+				{
+					position1589, tokenIndex1589 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1590
+					}
+					position++
+					goto l1589
+				l1590:
+					position, tokenIndex = position1589, tokenIndex1589
+					if buffer[position] != rune('T') {
+						goto l1579
+					}
+					position++
+				}
+			l1589:
+				if !_rules[ruleWS]() {
+					goto l1579
+				}
+				if !_rules[ruleQuotedArg]() {
+					goto l1579
+				}
+				add(ruleIdentDirective, position1580)
+			}
+			return true
+		l1579:
+			position, tokenIndex = position1579, tokenIndex1579
+			return false
+		},
+		/* 53 SubsectionDirective <- <('.' ('s' / 'S') ('u' / 'U') ('b' / 'B') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') WS Offset)> */
+		func() bool {
+			position1591, tokenIndex1591 := position, tokenIndex
+			{
+				position1592 := position
+				if buffer[position] != rune('.') {
+					goto l1591
+				}
+				position++
+				{
+					position1593, tokenIndex1593 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1594
+					}
+					position++
+					goto l1593
+				l1594:
+					position, tokenIndex = position1593, tokenIndex1593
+					if buffer[position] != rune('S') {
+						goto l1591
+					}
+					position++
+				}
+			l1593:
+				{
+					position1595, tokenIndex1595 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l1596
+					}
+					position++
+					goto l1595
+				l1596:
+					position, tokenIndex = position1595, tokenIndex1595
+					if buffer[position] != rune('U') {
+						goto l1591
+					}
+					position++
+				}
+			l1595:
+				{
+					position1597, tokenIndex1597 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l1598
+					}
+					position++
+					goto l1597
+				l1598:
+					position, tokenIndex = position1597, tokenIndex1597
+					if buffer[position] != rune('B') {
+						goto l1591
+					}
+					position++
+				}
+			l1597:
+				{
+					position1599, tokenIndex1599 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1600
+					}
+					position++
+					goto l1599
+				l1600:
+					position, tokenIndex = position1599, tokenIndex1599
+					if buffer[position] != rune('S') {
+						goto l1591
+					}
+					position++
+				}
+			l1599:
+				{
+					position1601, tokenIndex1601 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1602
+					}
+					position++
+					goto l1601
+				l1602:
+					position, tokenIndex = position1601, tokenIndex1601
+					if buffer[position] != rune('E') {
+						goto l1591
+					}
+					position++
+				}
+			l1601:
+				{
+					position1603, tokenIndex1603 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1604
+					}
+					position++
+					goto l1603
+				l1604:
+					position, tokenIndex = position1603, tokenIndex1603
+					if buffer[position] != rune('C') {
+						goto l1591
+					}
+					position++
+				}
+			l1603:
+				{
+					position1605, tokenIndex1605 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1606
+					}
+					position++
+					goto l1605
+				l1606:
+					position, tokenIndex = position1605, tokenIndex1605
+					if buffer[position] != rune('T') {
+						goto l1591
+					}
+					position++
+				}
+			l1605:
+				{
+					position1607, tokenIndex1607 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1608
+					}
+					position++
+					goto l1607
+				l1608:
+					position, tokenIndex = position1607, tokenIndex1607
+					if buffer[position] != rune('I') {
+						goto l1591
+					}
+					position++
+				}
+			l1607:
+				{
+					position1609, tokenIndex1609 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1610
+					}
+					position++
+					goto l1609
+				l1610:
+					position, tokenIndex = position1609, tokenIndex1609
+					if buffer[position] != rune('O') {
+						goto l1591
+					}
+					position++
+				}
+			l1609:
+				{
+					position1611, tokenIndex1611 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1612
+					}
+					position++
+					goto l1611
+				l1612:
+					position, tokenIndex = position1611, tokenIndex1611
+					if buffer[position] != rune('N') {
+						goto l1591
+					}
+					position++
+				}
+			l1611:
+				if !_rules[ruleWS]() {
+					goto l1591
+				}
+				if !_rules[ruleOffset]() {
+					goto l1591
+				}
+				add(ruleSubsectionDirective, position1592)
+			}
+			return true
+		l1591:
+			position, tokenIndex = position1591, tokenIndex1591
+			return false
+		},
+		/* 54 LiteralPoolDirective <- <((('.' ('l' / 'L') ('t' / 'T') ('o' / 'O') ('r' / 'R') ('g' / 'G')) / ('.' ('p' / 'P') ('o' / 'O') ('o' / 'O') ('l' / 'L'))) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position1613, tokenIndex1613 := position, tokenIndex
+			{
+				position1614 := position
+				{
+					position1615, tokenIndex1615 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l1616
+					}
+					position++
+					{
+						position1617, tokenIndex1617 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1618
+						}
+						position++
+						goto l1617
+					l1618:
+						position, tokenIndex = position1617, tokenIndex1617
+						if buffer[position] != rune('L') {
+							goto l1616
+						}
+						position++
+					}
+				l1617:
+					{
+						position1619, tokenIndex1619 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1620
+						}
+						position++
+						goto l1619
+					l1620:
+						position, tokenIndex = position1619, tokenIndex1619
+						if buffer[position] != rune('T') {
+							goto l1616
+						}
+						position++
+					}
+				l1619:
+					{
+						position1621, tokenIndex1621 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1622
+						}
+						position++
+						goto l1621
+					l1622:
+						position, tokenIndex = position1621, tokenIndex1621
+						if buffer[position] != rune('O') {
+							goto l1616
+						}
+						position++
+					}
+				l1621:
+					{
+						position1623, tokenIndex1623 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1624
+						}
+						position++
+						goto l1623
+					l1624:
+						position, tokenIndex = position1623, tokenIndex1623
+						if buffer[position] != rune('R') {
+							goto l1616
+						}
+						position++
+					}
+				l1623:
+					{
+						position1625, tokenIndex1625 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1626
+						}
+						position++
+						goto l1625
+					l1626:
+						position, tokenIndex = position1625, tokenIndex1625
+						if buffer[position] != rune('G') {
+							goto l1616
+						}
+						position++
+					}
+				l1625:
+					goto l1615
+				l1616:
+					position, tokenIndex = position1615, tokenIndex1615
+					if buffer[position] != rune('.') {
+						goto l1613
+					}
+					position++
+					{
+						position1627, tokenIndex1627 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1628
+						}
+						position++
+						goto l1627
+					l1628:
+						position, tokenIndex = position1627, tokenIndex1627
+						if buffer[position] != rune('P') {
+							goto l1613
+						}
+						position++
+					}
+				l1627:
+					{
+						position1629, tokenIndex1629 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1630
+						}
+						position++
+						goto l1629
+					l1630:
+						position, tokenIndex = position1629, tokenIndex1629
+						if buffer[position] != rune('O') {
+							goto l1613
+						}
+						position++
+					}
+				l1629:
+					{
+						position1631, tokenIndex1631 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1632
+						}
+						position++
+						goto l1631
+					l1632:
+						position, tokenIndex = position1631, tokenIndex1631
+						if buffer[position] != rune('O') {
+							goto l1613
+						}
+						position++
+					}
+				l1631:
+					{
+						position1633, tokenIndex1633 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1634
+						}
+						position++
+						goto l1633
+					l1634:
+						position, tokenIndex = position1633, tokenIndex1633
+						if buffer[position] != rune('L') {
+							goto l1613
+						}
+						position++
+					}
+				l1633:
+				}
+			l1615:
+				{
+					position1635, tokenIndex1635 := position, tokenIndex
+					{
+						position1636, tokenIndex1636 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1637
+						}
+						position++
+						goto l1636
+					l1637:
+						position, tokenIndex = position1636, tokenIndex1636
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1638
+						}
+						position++
+						goto l1636
+					l1638:
+						position, tokenIndex = position1636, tokenIndex1636
+						{
+							position1640, tokenIndex1640 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1641
+							}
+							position++
+							goto l1640
+						l1641:
+							position, tokenIndex = position1640, tokenIndex1640
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1639
+							}
+							position++
+						}
+					l1640:
+						goto l1636
+					l1639:
+						position, tokenIndex = position1636, tokenIndex1636
+						if buffer[position] != rune('_') {
+							goto l1635
+						}
+						position++
+					}
+				l1636:
+					goto l1613
+				l1635:
+					position, tokenIndex = position1635, tokenIndex1635
+				}
+				add(ruleLiteralPoolDirective, position1614)
+			}
+			return true
+		l1613:
+			position, tokenIndex = position1613, tokenIndex1613
+			return false
+		},
+		/* 55 IncbinDirective <- <('.' ('i' / 'I') ('n' / 'N') ('c' / 'C') ('b' / 'B') ('i' / 'I') ('n' / 'N') WS IncbinFile (WS? ',' WS? IncbinSkip (WS? ',' WS? IncbinCount)?)?)> */
+		func() bool {
+			position1642, tokenIndex1642 := position, tokenIndex
+			{
+				position1643 := position
+				if buffer[position] != rune('.') {
+					goto l1642
+				}
+				position++
+				{
+					position1644, tokenIndex1644 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1645
+					}
+					position++
+					goto l1644
+				l1645:
+					position, tokenIndex = position1644, tokenIndex1644
+					if buffer[position] != rune('I') {
+						goto l1642
+					}
+					position++
+				}
+			l1644:
+				{
+					position1646, tokenIndex1646 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1647
+					}
+					position++
+					goto l1646
+				l1647:
+					position, tokenIndex = position1646, tokenIndex1646
+					if buffer[position] != rune('N') {
+						goto l1642
+					}
+					position++
+				}
+			l1646:
+				{
+					position1648, tokenIndex1648 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1649
+					}
+					position++
+					goto l1648
+				l1649:
+					position, tokenIndex = position1648, tokenIndex1648
+					if buffer[position] != rune('C') {
+						goto l1642
+					}
+					position++
+				}
+			l1648:
+				{
+					position1650, tokenIndex1650 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l1651
+					}
+					position++
+					goto l1650
+				l1651:
+					position, tokenIndex = position1650, tokenIndex1650
+					if buffer[position] != rune('B') {
+						goto l1642
+					}
+					position++
+				}
+			l1650:
+				{
+					position1652, tokenIndex1652 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1653
+					}
+					position++
+					goto l1652
+				l1653:
+					position, tokenIndex = position1652, tokenIndex1652
+					if buffer[position] != rune('I') {
+						goto l1642
+					}
+					position++
+				}
+			l1652:
+				{
+					position1654, tokenIndex1654 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1655
+					}
+					position++
+					goto l1654
+				l1655:
+					position, tokenIndex = position1654, tokenIndex1654
+					if buffer[position] != rune('N') {
+						goto l1642
+					}
+					position++
+				}
+			l1654:
+				if !_rules[ruleWS]() {
+					goto l1642
+				}
+				if !_rules[ruleIncbinFile]() {
+					goto l1642
+				}
+				{
+					position1656, tokenIndex1656 := position, tokenIndex
+					{
+						position1658, tokenIndex1658 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1658
+						}
+						goto l1659
+					l1658:
+						position, tokenIndex = position1658, tokenIndex1658
+					}
+				l1659:
+					if buffer[position] != rune(',') {
+						goto l1656
+					}
+					position++
+					{
+						position1660, tokenIndex1660 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1660
+						}
+						goto l1661
+					l1660:
+						position, tokenIndex = position1660, tokenIndex1660
+					}
+				l1661:
+					if !_rules[ruleIncbinSkip]() {
+						goto l1656
+					}
+					{
+						position1662, tokenIndex1662 := position, tokenIndex
+						{
+							position1664, tokenIndex1664 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l1664
+							}
+							goto l1665
+						l1664:
+							position, tokenIndex = position1664, tokenIndex1664
+						}
+					l1665:
+						if buffer[position] != rune(',') {
+							goto l1662
+						}
+						position++
+						{
+							position1666, tokenIndex1666 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l1666
+							}
+							goto l1667
+						l1666:
+							position, tokenIndex = position1666, tokenIndex1666
+						}
+					l1667:
+						if !_rules[ruleIncbinCount]() {
+							goto l1662
+						}
+						goto l1663
+					l1662:
+						position, tokenIndex = position1662, tokenIndex1662
+					}
+				l1663:
+					goto l1657
+				l1656:
+					position, tokenIndex = position1656, tokenIndex1656
+				}
+			l1657:
+				add(ruleIncbinDirective, position1643)
+			}
+			return true
+		l1642:
+			position, tokenIndex = position1642, tokenIndex1642
+			return false
+		},
+		/* 56 IncbinFile <- <QuotedArg> */
+		func() bool {
+			position1668, tokenIndex1668 := position, tokenIndex
+			{
+				position1669 := position
+				if !_rules[ruleQuotedArg]() {
+					goto l1668
+				}
+				add(ruleIncbinFile, position1669)
+			}
+			return true
+		l1668:
+			position, tokenIndex = position1668, tokenIndex1668
+			return false
+		},
+		/* 57 IncbinSkip <- <Expression> */
+		func() bool {
+			position1670, tokenIndex1670 := position, tokenIndex
+			{
+				position1671 := position
+				if !_rules[ruleExpression]() {
+					goto l1670
+				}
+				add(ruleIncbinSkip, position1671)
+			}
+			return true
+		l1670:
+			position, tokenIndex = position1670, tokenIndex1670
+			return false
+		},
+		/* 58 IncbinCount <- <Expression> */
+		func() bool {
+			position1672, tokenIndex1672 := position, tokenIndex
+			{
+				position1673 := position
+				if !_rules[ruleExpression]() {
+					goto l1672
+				}
+				add(ruleIncbinCount, position1673)
+			}
+			return true
+		l1672:
+			position, tokenIndex = position1672, tokenIndex1672
+			return false
+		},
+		/* 59 BundleDirective <- <((('.' ('b' / 'B') ('u' / 'U') ('n' / 'N') ('d' / 'D') ('l' / 'L') ('e' / 'E') '_' ('a' / 'A') ('l' / 'L') ('i' / 'I') ('g' / 'G') ('n' / 'N') '_' ('m' / 'M') ('o' / 'O') ('d' / 'D') ('e' / 'E') WS Offset) / ('.' ('b' / 'B') ('u' / 'U') ('n' / 'N') ('d' / 'D') ('l' / 'L') ('e' / 'E') '_' ('l' / 'L') ('o' / 'O') ('c' / 'C') ('k' / 'K') (WS (('a' / 'A') ('l' / 'L') ('i' / 'I') ('g' / 'G') ('n' / 'N') '_' ('t' / 'T') ('o' / 'O') '_' ('e' / 'E') ('n' / 'N') ('d' / 'D')))?) / ('.' ('b' / 'B') ('u' / 'U') ('n' / 'N') ('d' / 'D') ('l' / 'L') ('e' / 'E') '_' ('u' / 'U') ('n' / 'N') ('l' / 'L') ('o' / 'O') ('c' / 'C') ('k' / 'K'))) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position1674, tokenIndex1674 := position, tokenIndex
+			{
+				position1675 := position
+				{
+					position1676, tokenIndex1676 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l1677
+					}
+					position++
+					{
+						position1678, tokenIndex1678 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1679
+						}
+						position++
+						goto l1678
+					l1679:
+						position, tokenIndex = position1678, tokenIndex1678
+						if buffer[position] != rune('B') {
+							goto l1677
+						}
+						position++
+					}
+				l1678:
+					{
+						position1680, tokenIndex1680 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1681
+						}
+						position++
+						goto l1680
+					l1681:
+						position, tokenIndex = position1680, tokenIndex1680
+						if buffer[position] != rune('U') {
+							goto l1677
+						}
+						position++
+					}
+				l1680:
+					{
+						position1682, tokenIndex1682 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1683
+						}
+						position++
+						goto l1682
+					l1683:
+						position, tokenIndex = position1682, tokenIndex1682
+						if buffer[position] != rune('N') {
+							goto l1677
+						}
+						position++
+					}
+				l1682:
+					{
+						position1684, tokenIndex1684 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1685
+						}
+						position++
+						goto l1684
+					l1685:
+						position, tokenIndex = position1684, tokenIndex1684
+						if buffer[position] != rune('D') {
+							goto l1677
+						}
+						position++
+					}
+				l1684:
+					{
+						position1686, tokenIndex1686 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1687
+						}
+						position++
+						goto l1686
+					l1687:
+						position, tokenIndex = position1686, tokenIndex1686
+						if buffer[position] != rune('L') {
+							goto l1677
+						}
+						position++
+					}
+				l1686:
+					{
+						position1688, tokenIndex1688 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1689
+						}
+						position++
+						goto l1688
+					l1689:
+						position, tokenIndex = position1688, tokenIndex1688
+						if buffer[position] != rune('E') {
+							goto l1677
+						}
+						position++
+					}
+				l1688:
+					if buffer[position] != rune('_') {
+						goto l1677
+					}
+					position++
+					{
+						position1690, tokenIndex1690 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1691
+						}
+						position++
+						goto l1690
+					l1691:
+						position, tokenIndex = position1690, tokenIndex1690
+						if buffer[position] != rune('A') {
+							goto l1677
+						}
+						position++
+					}
+				l1690:
+					{
+						position1692, tokenIndex1692 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1693
+						}
+						position++
+						goto l1692
+					l1693:
+						position, tokenIndex = position1692, tokenIndex1692
+						if buffer[position] != rune('L') {
+							goto l1677
+						}
+						position++
+					}
+				l1692:
+					{
+						position1694, tokenIndex1694 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1695
+						}
+						position++
+						goto l1694
+					l1695:
+						position, tokenIndex = position1694, tokenIndex1694
+						if buffer[position] != rune('I') {
+							goto l1677
+						}
+						position++
+					}
+				l1694:
+					{
+						position1696, tokenIndex1696 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1697
+						}
+						position++
+						goto l1696
+					l1697:
+						position, tokenIndex = position1696, tokenIndex1696
+						if buffer[position] != rune('G') {
+							goto l1677
+						}
+						position++
+					}
+				l1696:
+					{
+						position1698, tokenIndex1698 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1699
+						}
+						position++
+						goto l1698
+					l1699:
+						position, tokenIndex = position1698, tokenIndex1698
+						if buffer[position] != rune('N') {
+							goto l1677
+						}
+						position++
+					}
+				l1698:
+					if buffer[position] != rune('_') {
+						goto l1677
+					}
+					position++
+					{
+						position1700, tokenIndex1700 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l1701
+						}
+						position++
+						goto l1700
+					l1701:
+						position, tokenIndex = position1700, tokenIndex1700
+						if buffer[position] != rune('M') {
+							goto l1677
+						}
+						position++
+					}
+				l1700:
+					{
+						position1702, tokenIndex1702 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1703
+						}
+						position++
+						goto l1702
+					l1703:
+						position, tokenIndex = position1702, tokenIndex1702
+						if buffer[position] != rune('O') {
+							goto l1677
+						}
+						position++
+					}
+				l1702:
+					{
+						position1704, tokenIndex1704 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1705
+						}
+						position++
+						goto l1704
+					l1705:
+						position, tokenIndex = position1704, tokenIndex1704
+						if buffer[position] != rune('D') {
+							goto l1677
+						}
+						position++
+					}
+				l1704:
+					{
+						position1706, tokenIndex1706 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1707
+						}
+						position++
+						goto l1706
+					l1707:
+						position, tokenIndex = position1706, tokenIndex1706
+						if buffer[position] != rune('E') {
+							goto l1677
+						}
+						position++
+					}
+				l1706:
+					if !_rules[ruleWS]() {
+						goto l1677
+					}
+					if !_rules[ruleOffset]() {
+						goto l1677
+					}
+					goto l1676
+				l1677:
+					position, tokenIndex = position1676, tokenIndex1676
+					if buffer[position] != rune('.') {
+						goto l1708
+					}
+					position++
+					{
+						position1709, tokenIndex1709 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1710
+						}
+						position++
+						goto l1709
+					l1710:
+						position, tokenIndex = position1709, tokenIndex1709
+						if buffer[position] != rune('B') {
+							goto l1708
+						}
+						position++
+					}
+				l1709:
+					{
+						position1711, tokenIndex1711 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1712
+						}
+						position++
+						goto l1711
+					l1712:
+						position, tokenIndex = position1711, tokenIndex1711
+						if buffer[position] != rune('U') {
+							goto l1708
+						}
+						position++
+					}
+				l1711:
+					{
+						position1713, tokenIndex1713 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1714
+						}
+						position++
+						goto l1713
+					l1714:
+						position, tokenIndex = position1713, tokenIndex1713
+						if buffer[position] != rune('N') {
+							goto l1708
+						}
+						position++
+					}
+				l1713:
+					{
+						position1715, tokenIndex1715 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1716
+						}
+						position++
+						goto l1715
+					l1716:
+						position, tokenIndex = position1715, tokenIndex1715
+						if buffer[position] != rune('D') {
+							goto l1708
+						}
+						position++
+					}
+				l1715:
+					{
+						position1717, tokenIndex1717 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1718
+						}
+						position++
+						goto l1717
+					l1718:
+						position, tokenIndex = position1717, tokenIndex1717
+						if buffer[position] != rune('L') {
+							goto l1708
+						}
+						position++
+					}
+				l1717:
+					{
+						position1719, tokenIndex1719 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1720
+						}
+						position++
+						goto l1719
+					l1720:
+						position, tokenIndex = position1719, tokenIndex1719
+						if buffer[position] != rune('E') {
+							goto l1708
+						}
+						position++
+					}
+				l1719:
+					if buffer[position] != rune('_') {
+						goto l1708
+					}
+					position++
+					{
+						position1721, tokenIndex1721 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1722
+						}
+						position++
+						goto l1721
+					l1722:
+						position, tokenIndex = position1721, tokenIndex1721
+						if buffer[position] != rune('L') {
+							goto l1708
+						}
+						position++
+					}
+				l1721:
+					{
+						position1723, tokenIndex1723 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1724
+						}
+						position++
+						goto l1723
+					l1724:
+						position, tokenIndex = position1723, tokenIndex1723
+						if buffer[position] != rune('O') {
+							goto l1708
+						}
+						position++
+					}
+				l1723:
+					{
+						position1725, tokenIndex1725 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1726
+						}
+						position++
+						goto l1725
+					l1726:
+						position, tokenIndex = position1725, tokenIndex1725
+						if buffer[position] != rune('C') {
+							goto l1708
+						}
+						position++
+					}
+				l1725:
+					{
+						position1727, tokenIndex1727 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l1728
+						}
+						position++
+						goto l1727
+					l1728:
+						position, tokenIndex = position1727, tokenIndex1727
+						if buffer[position] != rune('K') {
+							goto l1708
+						}
+						position++
+					}
+				l1727:
+					{
+						position1729, tokenIndex1729 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1729
+						}
+						{
+							position1731, tokenIndex1731 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1732
+							}
+							position++
+							goto l1731
+						l1732:
+							position, tokenIndex = position1731, tokenIndex1731
+							if buffer[position] != rune('A') {
+								goto l1729
+							}
+							position++
+						}
+					l1731:
+						{
+							position1733, tokenIndex1733 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1734
+							}
+							position++
+							goto l1733
+						l1734:
+							position, tokenIndex = position1733, tokenIndex1733
+							if buffer[position] != rune('L') {
+								goto l1729
+							}
+							position++
+						}
+					l1733:
+						{
+							position1735, tokenIndex1735 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1736
+							}
+							position++
+							goto l1735
+						l1736:
+							position, tokenIndex = position1735, tokenIndex1735
+							if buffer[position] != rune('I') {
+								goto l1729
+							}
+							position++
+						}
+					l1735:
+						{
+							position1737, tokenIndex1737 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1738
+							}
+							position++
+							goto l1737
+						l1738:
+							position, tokenIndex = position1737, tokenIndex1737
+							if buffer[position] != rune('G') {
+								goto l1729
+							}
+							position++
+						}
+					l1737:
+						{
+							position1739, tokenIndex1739 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1740
+							}
+							position++
+							goto l1739
+						l1740:
+							position, tokenIndex = position1739, tokenIndex1739
+							if buffer[position] != rune('N') {
+								goto l1729
+							}
+							position++
+						}
+					l1739:
+						if buffer[position] != rune('_') {
+							goto l1729
+						}
+						position++
+						{
+							position1741, tokenIndex1741 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1742
+							}
+							position++
+							goto l1741
+						l1742:
+							position, tokenIndex = position1741, tokenIndex1741
+							if buffer[position] != rune('T') {
+								goto l1729
+							}
+							position++
+						}
+					l1741:
+						{
+							position1743, tokenIndex1743 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1744
+							}
+							position++
+							goto l1743
+						l1744:
+							position, tokenIndex = position1743, tokenIndex1743
+							if buffer[position] != rune('O') {
+								goto l1729
+							}
+							position++
+						}
+					l1743:
+						if buffer[position] != rune('_') {
+							goto l1729
+						}
+						position++
+						{
+							position1745, tokenIndex1745 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1746
+							}
+							position++
+							goto l1745
+						l1746:
+							position, tokenIndex = position1745, tokenIndex1745
+							if buffer[position] != rune('E') {
+								goto l1729
+							}
+							position++
+						}
+					l1745:
+						{
+							position1747, tokenIndex1747 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1748
+							}
+							position++
+							goto l1747
+						l1748:
+							position, tokenIndex = position1747, tokenIndex1747
+							if buffer[position] != rune('N') {
+								goto l1729
+							}
+							position++
+						}
+					l1747:
+						{
+							position1749, tokenIndex1749 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1750
+							}
+							position++
+							goto l1749
+						l1750:
+							position, tokenIndex = position1749, tokenIndex1749
+							if buffer[position] != rune('D') {
+								goto l1729
+							}
+							position++
+						}
+					l1749:
+						goto l1730
+					l1729:
+						position, tokenIndex = position1729, tokenIndex1729
+					}
+				l1730:
+					goto l1676
+				l1708:
+					position, tokenIndex = position1676, tokenIndex1676
+					if buffer[position] != rune('.') {
+						goto l1674
+					}
+					position++
+					{
+						position1751, tokenIndex1751 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1752
+						}
+						position++
+						goto l1751
+					l1752:
+						position, tokenIndex = position1751, tokenIndex1751
+						if buffer[position] != rune('B') {
+							goto l1674
+						}
+						position++
+					}
+				l1751:
+					{
+						position1753, tokenIndex1753 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1754
+						}
+						position++
+						goto l1753
+					l1754:
+						position, tokenIndex = position1753, tokenIndex1753
+						if buffer[position] != rune('U') {
+							goto l1674
+						}
+						position++
+					}
+				l1753:
+					{
+						position1755, tokenIndex1755 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1756
+						}
+						position++
+						goto l1755
+					l1756:
+						position, tokenIndex = position1755, tokenIndex1755
+						if buffer[position] != rune('N') {
+							goto l1674
+						}
+						position++
+					}
+				l1755:
+					{
+						position1757, tokenIndex1757 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1758
+						}
+						position++
+						goto l1757
+					l1758:
+						position, tokenIndex = position1757, tokenIndex1757
+						if buffer[position] != rune('D') {
+							goto l1674
+						}
+						position++
+					}
+				l1757:
+					{
+						position1759, tokenIndex1759 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1760
+						}
+						position++
+						goto l1759
+					l1760:
+						position, tokenIndex = position1759, tokenIndex1759
+						if buffer[position] != rune('L') {
+							goto l1674
+						}
+						position++
+					}
+				l1759:
+					{
+						position1761, tokenIndex1761 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1762
+						}
+						position++
+						goto l1761
+					l1762:
+						position, tokenIndex = position1761, tokenIndex1761
+						if buffer[position] != rune('E') {
+							goto l1674
+						}
+						position++
+					}
+				l1761:
+					if buffer[position] != rune('_') {
+						goto l1674
+					}
+					position++
+					{
+						position1763, tokenIndex1763 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1764
+						}
+						position++
+						goto l1763
+					l1764:
+						position, tokenIndex = position1763, tokenIndex1763
+						if buffer[position] != rune('U') {
+							goto l1674
+						}
+						position++
+					}
+				l1763:
+					{
+						position1765, tokenIndex1765 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1766
+						}
+						position++
+						goto l1765
+					l1766:
+						position, tokenIndex = position1765, tokenIndex1765
+						if buffer[position] != rune('N') {
+							goto l1674
+						}
+						position++
+					}
+				l1765:
+					{
+						position1767, tokenIndex1767 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1768
+						}
+						position++
+						goto l1767
+					l1768:
+						position, tokenIndex = position1767, tokenIndex1767
+						if buffer[position] != rune('L') {
+							goto l1674
+						}
+						position++
+					}
+				l1767:
+					{
+						position1769, tokenIndex1769 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1770
+						}
+						position++
+						goto l1769
+					l1770:
+						position, tokenIndex = position1769, tokenIndex1769
+						if buffer[position] != rune('O') {
+							goto l1674
+						}
+						position++
+					}
+				l1769:
+					{
+						position1771, tokenIndex1771 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1772
+						}
+						position++
+						goto l1771
+					l1772:
+						position, tokenIndex = position1771, tokenIndex1771
+						if buffer[position] != rune('C') {
+							goto l1674
+						}
+						position++
+					}
+				l1771:
+					{
+						position1773, tokenIndex1773 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l1774
+						}
+						position++
+						goto l1773
+					l1774:
+						position, tokenIndex = position1773, tokenIndex1773
+						if buffer[position] != rune('K') {
+							goto l1674
+						}
+						position++
+					}
+				l1773:
+				}
+			l1676:
+				{
+					position1775, tokenIndex1775 := position, tokenIndex
+					{
+						position1776, tokenIndex1776 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1777
+						}
+						position++
+						goto l1776
+					l1777:
+						position, tokenIndex = position1776, tokenIndex1776
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1778
+						}
+						position++
+						goto l1776
+					l1778:
+						position, tokenIndex = position1776, tokenIndex1776
+						{
+							position1780, tokenIndex1780 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1781
+							}
+							position++
+							goto l1780
+						l1781:
+							position, tokenIndex = position1780, tokenIndex1780
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1779
+							}
+							position++
+						}
+					l1780:
+						goto l1776
+					l1779:
+						position, tokenIndex = position1776, tokenIndex1776
+						if buffer[position] != rune('_') {
+							goto l1775
+						}
+						position++
+					}
+				l1776:
+					goto l1674
+				l1775:
+					position, tokenIndex = position1775, tokenIndex1775
+				}
+				add(ruleBundleDirective, position1675)
+			}
+			return true
+		l1674:
+			position, tokenIndex = position1674, tokenIndex1674
+			return false
+		},
+		/* 60 RelocDirective <- <('.' ('r' / 'R') ('e' / 'E') ('l' / 'L') ('o' / 'O') ('c' / 'C') WS RelocOffset WS? ',' WS? RelocType (WS? ',' WS? SymbolArg)?)> */
+		func() bool {
+			position1782, tokenIndex1782 := position, tokenIndex
+			{
+				position1783 := position
+				if buffer[position] != rune('.') {
+					goto l1782
+				}
+				position++
+				{
+					position1784, tokenIndex1784 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1785
+					}
+					position++
+					goto l1784
+				l1785:
+					position, tokenIndex = position1784, tokenIndex1784
+					if buffer[position] != rune('R') {
+						goto l1782
+					}
+					position++
+				}
+			l1784:
+				{
+					position1786, tokenIndex1786 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1787
+					}
+					position++
+					goto l1786
+				l1787:
+					position, tokenIndex = position1786, tokenIndex1786
+					if buffer[position] != rune('E') {
+						goto l1782
+					}
+					position++
+				}
+			l1786:
+				{
+					position1788, tokenIndex1788 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1789
+					}
+					position++
+					goto l1788
+				l1789:
+					position, tokenIndex = position1788, tokenIndex1788
+					if buffer[position] != rune('L') {
+						goto l1782
+					}
+					position++
+				}
+			l1788:
+				{
+					position1790, tokenIndex1790 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1791
+					}
+					position++
+					goto l1790
+				l1791:
+					position, tokenIndex = position1790, tokenIndex1790
+					if buffer[position] != rune('O') {
+						goto l1782
+					}
+					position++
+				}
+			l1790:
+				{
+					position1792, tokenIndex1792 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1793
+					}
+					position++
+					goto l1792
+				l1793:
+					position, tokenIndex = position1792, tokenIndex1792
+					if buffer[position] != rune('C') {
+						goto l1782
+					}
+					position++
+				}
+			l1792:
+				if !_rules[ruleWS]() {
+					goto l1782
+				}
+				if !_rules[ruleRelocOffset]() {
+					goto l1782
+				}
+				{
+					position1794, tokenIndex1794 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1794
+					}
+					goto l1795
+				l1794:
+					position, tokenIndex = position1794, tokenIndex1794
+				}
+			l1795:
+				if buffer[position] != rune(',') {
+					goto l1782
+				}
+				position++
+				{
+					position1796, tokenIndex1796 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l1796
+					}
+					goto l1797
+				l1796:
+					position, tokenIndex = position1796, tokenIndex1796
+				}
+			l1797:
+				if !_rules[ruleRelocType]() {
+					goto l1782
+				}
+				{
+					position1798, tokenIndex1798 := position, tokenIndex
+					{
+						position1800, tokenIndex1800 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1800
+						}
+						goto l1801
+					l1800:
+						position, tokenIndex = position1800, tokenIndex1800
+					}
+				l1801:
+					if buffer[position] != rune(',') {
+						goto l1798
+					}
+					position++
+					{
+						position1802, tokenIndex1802 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1802
+						}
+						goto l1803
+					l1802:
+						position, tokenIndex = position1802, tokenIndex1802
+					}
+				l1803:
+					if !_rules[ruleSymbolArg]() {
+						goto l1798
+					}
+					goto l1799
+				l1798:
+					position, tokenIndex = position1798, tokenIndex1798
+				}
+			l1799:
+				add(ruleRelocDirective, position1783)
+			}
+			return true
+		l1782:
+			position, tokenIndex = position1782, tokenIndex1782
+			return false
+		},
+		/* 61 RelocOffset <- <((LocalSymbol / (Dot !([a-z] / [A-Z] / ([0-9] / [0-9]) / '.' / '_' / '$')) / SymbolName) (WS? Operator WS? Expression)?)> */
+		func() bool {
+			position1804, tokenIndex1804 := position, tokenIndex
+			{
+				position1805 := position
+				{
+					position1806, tokenIndex1806 := position, tokenIndex
+					if !_rules[ruleLocalSymbol]() {
+						goto l1807
+					}
+					goto l1806
+				l1807:
+					position, tokenIndex = position1806, tokenIndex1806
+					if !_rules[ruleDot]() {
+						goto l1808
+					}
+					{
+						position1809, tokenIndex1809 := position, tokenIndex
+						{
+							position1810, tokenIndex1810 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l1811
+							}
+							position++
+							goto l1810
+						l1811:
+							position, tokenIndex = position1810, tokenIndex1810
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l1812
+							}
+							position++
+							goto l1810
+						l1812:
+							position, tokenIndex = position1810, tokenIndex1810
+							{
+								position1814, tokenIndex1814 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1815
+								}
+								position++
+								goto l1814
+							l1815:
+								position, tokenIndex = position1814, tokenIndex1814
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l1813
+								}
+								position++
+							}
+						l1814:
+							goto l1810
+						l1813:
+							position, tokenIndex = position1810, tokenIndex1810
+							if buffer[position] != rune('.') {
+								goto l1816
+							}
+							position++
+							goto l1810
+						l1816:
+							position, tokenIndex = position1810, tokenIndex1810
+							if buffer[position] != rune('_') {
+								goto l1817
+							}
+							position++
+							goto l1810
+						l1817:
+							position, tokenIndex = position1810, tokenIndex1810
+							if buffer[position] != rune('$') {
+								goto l1809
+							}
+							position++
+						}
+					l1810:
+						goto l1808
+					l1809:
+						position, tokenIndex = position1809, tokenIndex1809
+					}
+					goto l1806
+				l1808:
+					position, tokenIndex = position1806, tokenIndex1806
+					if !_rules[ruleSymbolName]() {
+						goto l1804
+					}
+				}
+			l1806:
+				{
+					position1818, tokenIndex1818 := position, tokenIndex
+					{
+						position1820, tokenIndex1820 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1820
+						}
+						goto l1821
+					l1820:
+						position, tokenIndex = position1820, tokenIndex1820
+					}
+				l1821:
+					if !_rules[ruleOperator]() {
+						goto l1818
+					}
+					{
+						position1822, tokenIndex1822 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1822
+						}
+						goto l1823
+					l1822:
+						position, tokenIndex = position1822, tokenIndex1822
+					}
+				l1823:
+					if !_rules[ruleExpression]() {
+						goto l1818
+					}
+					goto l1819
+				l1818:
+					position, tokenIndex = position1818, tokenIndex1818
+				}
+			l1819:
+				add(ruleRelocOffset, position1805)
+			}
+			return true
+		l1804:
+			position, tokenIndex = position1804, tokenIndex1804
+			return false
+		},
+		/* 62 RelocType <- <([a-z] / [A-Z] / ([0-9] / [0-9]) / '_')+> */
+		func() bool {
+			position1824, tokenIndex1824 := position, tokenIndex
+			{
+				position1825 := position
+				{
+					position1828, tokenIndex1828 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l1829
+					}
+					position++
+					goto l1828
+				l1829:
+					position, tokenIndex = position1828, tokenIndex1828
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l1830
+					}
+					position++
+					goto l1828
+				l1830:
+					position, tokenIndex = position1828, tokenIndex1828
+					{
+						position1832, tokenIndex1832 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1833
+						}
+						position++
+						goto l1832
+					l1833:
+						position, tokenIndex = position1832, tokenIndex1832
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1831
+						}
+						position++
+					}
+				l1832:
+					goto l1828
+				l1831:
+					position, tokenIndex = position1828, tokenIndex1828
+					if buffer[position] != rune('_') {
+						goto l1824
+					}
+					position++
+				}
+			l1828:
+			l1826:
+				{
+					position1827, tokenIndex1827 := position, tokenIndex
+					{
+						position1834, tokenIndex1834 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1835
+						}
+						position++
+						goto l1834
+					l1835:
+						position, tokenIndex = position1834, tokenIndex1834
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1836
+						}
+						position++
+						goto l1834
+					l1836:
+						position, tokenIndex = position1834, tokenIndex1834
+						{
+							position1838, tokenIndex1838 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1839
+							}
+							position++
+							goto l1838
+						l1839:
+							position, tokenIndex = position1838, tokenIndex1838
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1837
+							}
+							position++
+						}
+					l1838:
+						goto l1834
+					l1837:
+						position, tokenIndex = position1834, tokenIndex1834
+						if buffer[position] != rune('_') {
+							goto l1827
+						}
+						position++
+					}
+				l1834:
+					goto l1826
+				l1827:
+					position, tokenIndex = position1827, tokenIndex1827
+				}
+				add(ruleRelocType, position1825)
+			}
+			return true
+		l1824:
+			position, tokenIndex = position1824, tokenIndex1824
+			return false
+		},
+		/* 63 MIPSSetDirective <- <('.' ('s' / 'S') ('e' / 'E') ('t' / 'T') WS MIPSSetOption !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_') !(WS? ','))> */
+		func() bool {
+			position1840, tokenIndex1840 := position, tokenIndex
+			{
+				position1841 := position
+				if buffer[position] != rune('.') {
+					goto l1840
+				}
+				position++
+				{
+					position1842, tokenIndex1842 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1843
+					}
+					position++
+					goto l1842
+				l1843:
+					position, tokenIndex = position1842, tokenIndex1842
+					if buffer[position] != rune('S') {
+						goto l1840
+					}
+					position++
+				}
+			l1842:
+				{
+					position1844, tokenIndex1844 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1845
+					}
+					position++
+					goto l1844
+				l1845:
+					position, tokenIndex = position1844, tokenIndex1844
+					if buffer[position] != rune('E') {
+						goto l1840
+					}
+					position++
+				}
+			l1844:
+				{
+					position1846, tokenIndex1846 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1847
+					}
+					position++
+					goto l1846
+				l1847:
+					position, tokenIndex = position1846, tokenIndex1846
+					if buffer[position] != rune('T') {
+						goto l1840
+					}
+					position++
+				}
+			l1846:
+				if !_rules[ruleWS]() {
+					goto l1840
+				}
+				if !_rules[ruleMIPSSetOption]() {
+					goto l1840
+				}
+				{
+					position1848, tokenIndex1848 := position, tokenIndex
+					{
+						position1849, tokenIndex1849 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l1850
+						}
+						position++
+						goto l1849
+					l1850:
+						position, tokenIndex = position1849, tokenIndex1849
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l1851
+						}
+						position++
+						goto l1849
+					l1851:
+						position, tokenIndex = position1849, tokenIndex1849
+						{
+							position1853, tokenIndex1853 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1854
+							}
+							position++
+							goto l1853
+						l1854:
+							position, tokenIndex = position1853, tokenIndex1853
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l1852
+							}
+							position++
+						}
+					l1853:
+						goto l1849
+					l1852:
+						position, tokenIndex = position1849, tokenIndex1849
+						if buffer[position] != rune('_') {
+							goto l1848
+						}
+						position++
+					}
+				l1849:
+					goto l1840
+				l1848:
+					position, tokenIndex = position1848, tokenIndex1848
+				}
+				{
+					position1855, tokenIndex1855 := position, tokenIndex
+					{
+						position1856, tokenIndex1856 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l1856
+						}
+						goto l1857
+					l1856:
+						position, tokenIndex = position1856, tokenIndex1856
+					}
+				l1857:
+					if buffer[position] != rune(',') {
+						goto l1855
+					}
+					position++
+					goto l1840
+				l1855:
+					position, tokenIndex = position1855, tokenIndex1855
+				}
+				add(ruleMIPSSetDirective, position1841)
+			}
+			return true
+		l1840:
+			position, tokenIndex = position1840, tokenIndex1840
+			return false
+		},
+		/* 64 MIPSSetOption <- <(((('n' / 'N') ('o' / 'O'))? ((('r' / 'R') ('e' / 'E') ('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) / (('a' / 'A') ('t' / 'T')) / (('m' / 'M') ('a' / 'A') ('c' / 'C') ('r' / 'R') ('o' / 'O')) / (('m' / 'M') ('i' / 'I') ('c' / 'C') ('r' / 'R') ('o' / 'O') ('m' / 'M') ('i' / 'I') ('p' / 'P') ('s' / 'S')) / (('m' / 'M') ('i' / 'I') ('p' / 'P') ('s' / 'S') '1' '6'))) / (('p' / 'P') ('u' / 'U') ('s' / 'S') ('h' / 'H')) / (('p' / 'P') ('o' / 'O') ('p' / 'P')))> */
+		func() bool {
+			position1858, tokenIndex1858 := position, tokenIndex
+			{
+				position1859 := position
+				{
+					position1860, tokenIndex1860 := position, tokenIndex
+					{
+						position1862, tokenIndex1862 := position, tokenIndex
+						{
+							position1864, tokenIndex1864 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1865
+							}
+							position++
+							goto l1864
+						l1865:
+							position, tokenIndex = position1864, tokenIndex1864
+							if buffer[position] != rune('N') {
+								goto l1862
+							}
+							position++
+						}
+					l1864:
+						{
+							position1866, tokenIndex1866 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1867
+							}
+							position++
+							goto l1866
+						l1867:
+							position, tokenIndex = position1866, tokenIndex1866
+							if buffer[position] != rune('O') {
+								goto l1862
+							}
+							position++
+						}
+					l1866:
+						goto l1863
+					l1862:
+						position, tokenIndex = position1862, tokenIndex1862
+					}
+				l1863:
+					{
+						position1868, tokenIndex1868 := position, tokenIndex
+						{
+							position1870, tokenIndex1870 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1871
+							}
+							position++
+							goto l1870
+						l1871:
+							position, tokenIndex = position1870, tokenIndex1870
+							if buffer[position] != rune('R') {
+								goto l1869
+							}
+							position++
+						}
+					l1870:
+						{
+							position1872, tokenIndex1872 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1873
+							}
+							position++
+							goto l1872
+						l1873:
+							position, tokenIndex = position1872, tokenIndex1872
+							if buffer[position] != rune('E') {
+								goto l1869
+							}
+							position++
+						}
+					l1872:
+						{
+							position1874, tokenIndex1874 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1875
+							}
+							position++
+							goto l1874
+						l1875:
+							position, tokenIndex = position1874, tokenIndex1874
+							if buffer[position] != rune('O') {
+								goto l1869
+							}
+							position++
+						}
+					l1874:
+						{
+							position1876, tokenIndex1876 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1877
+							}
+							position++
+							goto l1876
+						l1877:
+							position, tokenIndex = position1876, tokenIndex1876
+							if buffer[position] != rune('R') {
+								goto l1869
+							}
+							position++
+						}
+					l1876:
+						{
+							position1878, tokenIndex1878 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1879
+							}
+							position++
+							goto l1878
+						l1879:
+							position, tokenIndex = position1878, tokenIndex1878
+							if buffer[position] != rune('D') {
+								goto l1869
+							}
+							position++
+						}
+					l1878:
+						{
+							position1880, tokenIndex1880 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1881
+							}
+							position++
+							goto l1880
+						l1881:
+							position, tokenIndex = position1880, tokenIndex1880
+							if buffer[position] != rune('E') {
+								goto l1869
+							}
+							position++
+						}
+					l1880:
+						{
+							position1882, tokenIndex1882 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1883
+							}
+							position++
+							goto l1882
+						l1883:
+							position, tokenIndex = position1882, tokenIndex1882
+							if buffer[position] != rune('R') {
+								goto l1869
+							}
+							position++
+						}
+					l1882:
+						goto l1868
+					l1869:
+						position, tokenIndex = position1868, tokenIndex1868
+						{
+							position1885, tokenIndex1885 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1886
+							}
+							position++
+							goto l1885
+						l1886:
+							position, tokenIndex = position1885, tokenIndex1885
+							if buffer[position] != rune('A') {
+								goto l1884
+							}
+							position++
+						}
+					l1885:
+						{
+							position1887, tokenIndex1887 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1888
+							}
+							position++
+							goto l1887
+						l1888:
+							position, tokenIndex = position1887, tokenIndex1887
+							if buffer[position] != rune('T') {
+								goto l1884
+							}
+							position++
+						}
+					l1887:
+						goto l1868
+					l1884:
+						position, tokenIndex = position1868, tokenIndex1868
+						{
+							position1890, tokenIndex1890 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1891
+							}
+							position++
+							goto l1890
+						l1891:
+							position, tokenIndex = position1890, tokenIndex1890
+							if buffer[position] != rune('M') {
+								goto l1889
+							}
+							position++
+						}
+					l1890:
+						{
+							position1892, tokenIndex1892 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1893
+							}
+							position++
+							goto l1892
+						l1893:
+							position, tokenIndex = position1892, tokenIndex1892
+							if buffer[position] != rune('A') {
+								goto l1889
+							}
+							position++
+						}
+					l1892:
+						{
+							position1894, tokenIndex1894 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l1895
+							}
+							position++
+							goto l1894
+						l1895:
+							position, tokenIndex = position1894, tokenIndex1894
+							if buffer[position] != rune('C') {
+								goto l1889
+							}
+							position++
+						}
+					l1894:
+						{
+							position1896, tokenIndex1896 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1897
+							}
+							position++
+							goto l1896
+						l1897:
+							position, tokenIndex = position1896, tokenIndex1896
+							if buffer[position] != rune('R') {
+								goto l1889
+							}
+							position++
+						}
+					l1896:
+						{
+							position1898, tokenIndex1898 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1899
+							}
+							position++
+							goto l1898
+						l1899:
+							position, tokenIndex = position1898, tokenIndex1898
+							if buffer[position] != rune('O') {
+								goto l1889
+							}
+							position++
+						}
+					l1898:
+						goto l1868
+					l1889:
+						position, tokenIndex = position1868, tokenIndex1868
+						{
+							position1901, tokenIndex1901 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1902
+							}
+							position++
+							goto l1901
+						l1902:
+							position, tokenIndex = position1901, tokenIndex1901
+							if buffer[position] != rune('M') {
+								goto l1900
+							}
+							position++
+						}
+					l1901:
+						{
+							position1903, tokenIndex1903 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1904
+							}
+							position++
+							goto l1903
+						l1904:
+							position, tokenIndex = position1903, tokenIndex1903
+							if buffer[position] != rune('I') {
+								goto l1900
+							}
+							position++
+						}
+					l1903:
+						{
+							position1905, tokenIndex1905 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l1906
+							}
+							position++
+							goto l1905
+						l1906:
+							position, tokenIndex = position1905, tokenIndex1905
+							if buffer[position] != rune('C') {
+								goto l1900
+							}
+							position++
+						}
+					l1905:
+						{
+							position1907, tokenIndex1907 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1908
+							}
+							position++
+							goto l1907
+						l1908:
+							position, tokenIndex = position1907, tokenIndex1907
+							if buffer[position] != rune('R') {
+								goto l1900
+							}
+							position++
+						}
+					l1907:
+						{
+							position1909, tokenIndex1909 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1910
+							}
+							position++
+							goto l1909
+						l1910:
+							position, tokenIndex = position1909, tokenIndex1909
+							if buffer[position] != rune('O') {
+								goto l1900
+							}
+							position++
+						}
+					l1909:
+						{
+							position1911, tokenIndex1911 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1912
+							}
+							position++
+							goto l1911
+						l1912:
+							position, tokenIndex = position1911, tokenIndex1911
+							if buffer[position] != rune('M') {
+								goto l1900
+							}
+							position++
+						}
+					l1911:
+						{
+							position1913, tokenIndex1913 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1914
+							}
+							position++
+							goto l1913
+						l1914:
+							position, tokenIndex = position1913, tokenIndex1913
+							if buffer[position] != rune('I') {
+								goto l1900
+							}
+							position++
+						}
+					l1913:
+						{
+							position1915, tokenIndex1915 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1916
+							}
+							position++
+							goto l1915
+						l1916:
+							position, tokenIndex = position1915, tokenIndex1915
+							if buffer[position] != rune('P') {
+								goto l1900
+							}
+							position++
+						}
+					l1915:
+						{
+							position1917, tokenIndex1917 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1918
+							}
+							position++
+							goto l1917
+						l1918:
+							position, tokenIndex = position1917, tokenIndex1917
+							if buffer[position] != rune('S') {
+								goto l1900
+							}
+							position++
+						}
+					l1917:
+						goto l1868
+					l1900:
+						position, tokenIndex = position1868, tokenIndex1868
+						{
+							position1919, tokenIndex1919 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1920
+							}
+							position++
+							goto l1919
+						l1920:
+							position, tokenIndex = position1919, tokenIndex1919
+							if buffer[position] != rune('M') {
+								goto l1861
+							}
+							position++
+						}
+					l1919:
+						{
+							position1921, tokenIndex1921 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1922
+							}
+							position++
+							goto l1921
+						l1922:
+							position, tokenIndex = position1921, tokenIndex1921
+							if buffer[position] != rune('I') {
+								goto l1861
+							}
+							position++
+						}
+					l1921:
+						{
+							position1923, tokenIndex1923 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1924
+							}
+							position++
+							goto l1923
+						l1924:
+							position, tokenIndex = position1923, tokenIndex1923
+							if buffer[position] != rune('P') {
+								goto l1861
+							}
+							position++
+						}
+					l1923:
+						{
+							position1925, tokenIndex1925 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1926
+							}
+							position++
+							goto l1925
+						l1926:
+							position, tokenIndex = position1925, tokenIndex1925
+							if buffer[position] != rune('S') {
+								goto l1861
+							}
+							position++
+						}
+					l1925:
+						if buffer[position] != rune('1') {
+							goto l1861
+						}
+						position++
+						if buffer[position] != rune('6') {
+							goto l1861
+						}
+						position++
+					}
+				l1868:
+					goto l1860
+				l1861:
+					position, tokenIndex = position1860, tokenIndex1860
+					{
+						position1928, tokenIndex1928 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1929
+						}
+						position++
+						goto l1928
+					l1929:
+						position, tokenIndex = position1928, tokenIndex1928
+						if buffer[position] != rune('P') {
+							goto l1927
+						}
+						position++
+					}
+				l1928:
+					{
+						position1930, tokenIndex1930 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1931
+						}
+						position++
+						goto l1930
+					l1931:
+						position, tokenIndex = position1930, tokenIndex1930
+						if buffer[position] != rune('U') {
+							goto l1927
+						}
+						position++
+					}
+				l1930:
+					{
+						position1932, tokenIndex1932 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1933
+						}
+						position++
+						goto l1932
+					l1933:
+						position, tokenIndex = position1932, tokenIndex1932
+						if buffer[position] != rune('S') {
+							goto l1927
+						}
+						position++
+					}
+				l1932:
+					{
+						position1934, tokenIndex1934 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l1935
+						}
+						position++
+						goto l1934
+					l1935:
+						position, tokenIndex = position1934, tokenIndex1934
+						if buffer[position] != rune('H') {
+							goto l1927
+						}
+						position++
+					}
+				l1934:
+					goto l1860
+				l1927:
+					position, tokenIndex = position1860, tokenIndex1860
+					{
+						position1936, tokenIndex1936 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1937
+						}
+						position++
+						goto l1936
+					l1937:
+						position, tokenIndex = position1936, tokenIndex1936
+						if buffer[position] != rune('P') {
+							goto l1858
+						}
+						position++
+					}
+				l1936:
+					{
+						position1938, tokenIndex1938 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1939
+						}
+						position++
+						goto l1938
+					l1939:
+						position, tokenIndex = position1938, tokenIndex1938
+						if buffer[position] != rune('O') {
+							goto l1858
+						}
+						position++
+					}
+				l1938:
+					{
+						position1940, tokenIndex1940 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1941
+						}
+						position++
+						goto l1940
+					l1941:
+						position, tokenIndex = position1940, tokenIndex1940
+						if buffer[position] != rune('P') {
+							goto l1858
+						}
+						position++
+					}
+				l1940:
+				}
+			l1860:
+				add(ruleMIPSSetOption, position1859)
+			}
+			return true
+		l1858:
+			position, tokenIndex = position1858, tokenIndex1858
+			return false
+		},
+		/* 65 VariantPCSDirective <- <('.' ('v' / 'V') ('a' / 'A') ('r' / 'R') ('i' / 'I') ('a' / 'A') ('n' / 'N') ('t' / 'T') '_' ('p' / 'P') ('c' / 'C') ('s' / 'S') WS SymbolName)> */
+		func() bool {
+			position1942, tokenIndex1942 := position, tokenIndex
+			{
+				position1943 := position
+				if buffer[position] != rune('.') {
+					goto l1942
+				}
+				position++
+				{
+					position1944, tokenIndex1944 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l1945
+					}
+					position++
+					goto l1944
+				l1945:
+					position, tokenIndex = position1944, tokenIndex1944
+					if buffer[position] != rune('V') {
+						goto l1942
+					}
+					position++
+				}
+			l1944:
+				{
+					position1946, tokenIndex1946 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1947
+					}
+					position++
+					goto l1946
+				l1947:
+					position, tokenIndex = position1946, tokenIndex1946
+					if buffer[position] != rune('A') {
+						goto l1942
+					}
+					position++
+				}
+			l1946:
+				{
+					position1948, tokenIndex1948 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1949
+					}
+					position++
+					goto l1948
+				l1949:
+					position, tokenIndex = position1948, tokenIndex1948
+					if buffer[position] != rune('R') {
+						goto l1942
+					}
+					position++
+				}
+			l1948:
+				{
+					position1950, tokenIndex1950 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1951
+					}
+					position++
+					goto l1950
+				l1951:
+					position, tokenIndex = position1950, tokenIndex1950
+					if buffer[position] != rune('I') {
+						goto l1942
+					}
+					position++
+				}
+			l1950:
+				{
+					position1952, tokenIndex1952 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1953
+					}
+					position++
+					goto l1952
+				l1953:
+					position, tokenIndex = position1952, tokenIndex1952
+					if buffer[position] != rune('A') {
+						goto l1942
+					}
+					position++
+				}
+			l1952:
+				{
+					position1954, tokenIndex1954 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1955
+					}
+					position++
+					goto l1954
+				l1955:
+					position, tokenIndex = position1954, tokenIndex1954
+					if buffer[position] != rune('N') {
+						goto l1942
+					}
+					position++
+				}
+			l1954:
+				{
+					position1956, tokenIndex1956 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1957
+					}
+					position++
+					goto l1956
+				l1957:
+					position, tokenIndex = position1956, tokenIndex1956
+					if buffer[position] != rune('T') {
+						goto l1942
+					}
+					position++
+				}
+			l1956:
+				if buffer[position] != rune('_') {
+					goto l1942
+				}
+				position++
+				{
+					position1958, tokenIndex1958 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1959
+					}
+					position++
+					goto l1958
+				l1959:
+					position, tokenIndex = position1958, tokenIndex1958
+					if buffer[position] != rune('P') {
+						goto l1942
+					}
+					position++
+				}
+			l1958:
+				{
+					position1960, tokenIndex1960 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1961
+					}
+					position++
+					goto l1960
+				l1961:
+					position, tokenIndex = position1960, tokenIndex1960
+					if buffer[position] != rune('C') {
+						goto l1942
+					}
+					position++
+				}
+			l1960:
+				{
+					position1962, tokenIndex1962 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1963
+					}
+					position++
+					goto l1962
+				l1963:
+					position, tokenIndex = position1962, tokenIndex1962
+					if buffer[position] != rune('S') {
+						goto l1942
+					}
+					position++
+				}
+			l1962:
+				if !_rules[ruleWS]() {
+					goto l1942
+				}
+				if !_rules[ruleSymbolName]() {
+					goto l1942
+				}
+				add(ruleVariantPCSDirective, position1943)
+			}
+			return true
+		l1942:
+			position, tokenIndex = position1942, tokenIndex1942
+			return false
+		},
+		/* 66 MachineDirective <- <('.' ('m' / 'M') ('a' / 'A') ('c' / 'C') ('h' / 'H') ('i' / 'I') ('n' / 'N') ('e' / 'E') WS (QuotedArg / MachineStackOp / MachineName))> */
+		func() bool {
+			position1964, tokenIndex1964 := position, tokenIndex
+			{
+				position1965 := position
+				if buffer[position] != rune('.') {
+					goto l1964
+				}
+				position++
+				{
+					position1966, tokenIndex1966 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l1967
+					}
+					position++
+					goto l1966
+				l1967:
+					position, tokenIndex = position1966, tokenIndex1966
+					if buffer[position] != rune('M') {
+						goto l1964
+					}
+					position++
+				}
+			l1966:
+				{
+					position1968, tokenIndex1968 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1969
+					}
+					position++
+					goto l1968
+				l1969:
+					position, tokenIndex = position1968, tokenIndex1968
+					if buffer[position] != rune('A') {
+						goto l1964
+					}
+					position++
+				}
+			l1968:
+				{
+					position1970, tokenIndex1970 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1971
+					}
+					position++
+					goto l1970
+				l1971:
+					position, tokenIndex = position1970, tokenIndex1970
+					if buffer[position] != rune('C') {
+						goto l1964
+					}
+					position++
+				}
+			l1970:
+				{
+					position1972, tokenIndex1972 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1973
+					}
+					position++
+					goto l1972
+				l1973:
+					position, tokenIndex = position1972, tokenIndex1972
+					if buffer[position] != rune('H') {
+						goto l1964
+					}
+					position++
+				}
+			l1972:
+				{
+					position1974, tokenIndex1974 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1975
+					}
+					position++
+					goto l1974
+				l1975:
+					position, tokenIndex = position1974, tokenIndex1974
+					if buffer[position] != rune('I') {
+						goto l1964
+					}
+					position++
+				}
+			l1974:
+				{
+					position1976, tokenIndex1976 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1977
+					}
+					position++
+					goto l1976
+				l1977:
+					position, tokenIndex = position1976, tokenIndex1976
+					if buffer[position] != rune('N') {
+						goto l1964
+					}
+					position++
+				}
+			l1976:
+				{
+					position1978, tokenIndex1978 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1979
+					}
+					position++
+					goto l1978
+				l1979:
+					position, tokenIndex = position1978, tokenIndex1978
+					if buffer[position] != rune('E') {
+						goto l1964
+					}
+					position++
+				}
+			l1978:
+				if !_rules[ruleWS]() {
+					goto l1964
+				}
+				{
+					position1980, tokenIndex1980 := position, tokenIndex
+					if !_rules[ruleQuotedArg]() {
+						goto l1981
+					}
+					goto l1980
+				l1981:
+					position, tokenIndex = position1980, tokenIndex1980
+					if !_rules[ruleMachineStackOp]() {
+						goto l1982
+					}
+					goto l1980
+				l1982:
+					position, tokenIndex = position1980, tokenIndex1980
+					if !_rules[ruleMachineName]() {
+						goto l1964
+					}
+				}
+			l1980:
+				add(ruleMachineDirective, position1965)
+			}
+			return true
+		l1964:
+			position, tokenIndex = position1964, tokenIndex1964
+			return false
+		},
+		/* 67 MachineStackOp <- <(((('p' / 'P') ('u' / 'U') ('s' / 'S') ('h' / 'H')) / (('p' / 'P') ('o' / 'O') ('p' / 'P'))) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position1983, tokenIndex1983 := position, tokenIndex
+			{
+				position1984 := position
+				{
+					position1985, tokenIndex1985 := position, tokenIndex
+					{
+						position1987, tokenIndex1987 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1988
+						}
+						position++
+						goto l1987
+					l1988:
+						position, tokenIndex = position1987, tokenIndex1987
+						if buffer[position] != rune('P') {
+							goto l1986
+						}
+						position++
+					}
+				l1987:
+					{
+						position1989, tokenIndex1989 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1990
+						}
+						position++
+						goto l1989
+					l1990:
+						position, tokenIndex = position1989, tokenIndex1989
+						if buffer[position] != rune('U') {
+							goto l1986
+						}
+						position++
+					}
+				l1989:
+					{
+						position1991, tokenIndex1991 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1992
+						}
+						position++
+						goto l1991
+					l1992:
+						position, tokenIndex = position1991, tokenIndex1991
+						if buffer[position] != rune('S') {
+							goto l1986
+						}
+						position++
+					}
+				l1991:
+					{
+						position1993, tokenIndex1993 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l1994
+						}
+						position++
+						goto l1993
+					l1994:
+						position, tokenIndex = position1993, tokenIndex1993
+						if buffer[position] != rune('H') {
+							goto l1986
+						}
+						position++
+					}
+				l1993:
+					goto l1985
+				l1986:
+					position, tokenIndex = position1985, tokenIndex1985
+					{
+						position1995, tokenIndex1995 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1996
+						}
+						position++
+						goto l1995
+					l1996:
+						position, tokenIndex = position1995, tokenIndex1995
+						if buffer[position] != rune('P') {
+							goto l1983
+						}
+						position++
+					}
+				l1995:
+					{
+						position1997, tokenIndex1997 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1998
+						}
+						position++
+						goto l1997
+					l1998:
+						position, tokenIndex = position1997, tokenIndex1997
+						if buffer[position] != rune('O') {
+							goto l1983
+						}
+						position++
+					}
+				l1997:
+					{
+						position1999, tokenIndex1999 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2000
+						}
+						position++
+						goto l1999
+					l2000:
+						position, tokenIndex = position1999, tokenIndex1999
+						if buffer[position] != rune('P') {
+							goto l1983
+						}
+						position++
+					}
+				l1999:
+				}
+			l1985:
+				{
+					position2001, tokenIndex2001 := position, tokenIndex
+					{
+						position2002, tokenIndex2002 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2003
+						}
+						position++
+						goto l2002
+					l2003:
+						position, tokenIndex = position2002, tokenIndex2002
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2004
+						}
+						position++
+						goto l2002
+					l2004:
+						position, tokenIndex = position2002, tokenIndex2002
+						{
+							position2006, tokenIndex2006 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2007
+							}
+							position++
+							goto l2006
+						l2007:
+							position, tokenIndex = position2006, tokenIndex2006
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2005
+							}
+							position++
+						}
+					l2006:
+						goto l2002
+					l2005:
+						position, tokenIndex = position2002, tokenIndex2002
+						if buffer[position] != rune('_') {
+							goto l2001
+						}
+						position++
+					}
+				l2002:
+					goto l1983
+				l2001:
+					position, tokenIndex = position2001, tokenIndex2001
+				}
+				add(ruleMachineStackOp, position1984)
+			}
+			return true
+		l1983:
+			position, tokenIndex = position1983, tokenIndex1983
+			return false
+		},
+		/* 68 MachineName <- <([a-z] / [A-Z] / ([0-9] / [0-9]) / '_')+> */
+		func() bool {
+			position2008, tokenIndex2008 := position, tokenIndex
+			{
+				position2009 := position
+				{
+					position2012, tokenIndex2012 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2013
+					}
+					position++
+					goto l2012
+				l2013:
+					position, tokenIndex = position2012, tokenIndex2012
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2014
+					}
+					position++
+					goto l2012
+				l2014:
+					position, tokenIndex = position2012, tokenIndex2012
+					{
+						position2016, tokenIndex2016 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2017
+						}
+						position++
+						goto l2016
+					l2017:
+						position, tokenIndex = position2016, tokenIndex2016
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2015
+						}
+						position++
+					}
+				l2016:
+					goto l2012
+				l2015:
+					position, tokenIndex = position2012, tokenIndex2012
+					if buffer[position] != rune('_') {
+						goto l2008
+					}
+					position++
+				}
+			l2012:
+			l2010:
+				{
+					position2011, tokenIndex2011 := position, tokenIndex
+					{
+						position2018, tokenIndex2018 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2019
+						}
+						position++
+						goto l2018
+					l2019:
+						position, tokenIndex = position2018, tokenIndex2018
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2020
+						}
+						position++
+						goto l2018
+					l2020:
+						position, tokenIndex = position2018, tokenIndex2018
+						{
+							position2022, tokenIndex2022 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2023
+							}
+							position++
+							goto l2022
+						l2023:
+							position, tokenIndex = position2022, tokenIndex2022
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2021
+							}
+							position++
+						}
+					l2022:
+						goto l2018
+					l2021:
+						position, tokenIndex = position2018, tokenIndex2018
+						if buffer[position] != rune('_') {
+							goto l2011
+						}
+						position++
+					}
+				l2018:
+					goto l2010
+				l2011:
+					position, tokenIndex = position2011, tokenIndex2011
+				}
+				add(ruleMachineName, position2009)
+			}
+			return true
+		l2008:
+			position, tokenIndex = position2008, tokenIndex2008
+			return false
+		},
+		/* 69 InsnDirective <- <('.' ('i' / 'I') ('n' / 'N') ('s' / 'S') ('n' / 'N') WS InsnFormat WS InsnArg (WS? ',' WS? InsnArg)*)> */
+		func() bool {
+			position2024, tokenIndex2024 := position, tokenIndex
+			{
+				position2025 := position
+				if buffer[position] != rune('.') {
+					goto l2024
+				}
+				position++
+				{
+					position2026, tokenIndex2026 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l2027
+					}
+					position++
+					goto l2026
+				l2027:
+					position, tokenIndex = position2026, tokenIndex2026
+					if buffer[position] != rune('I') {
+						goto l2024
+					}
+					position++
+				}
+			l2026:
+				{
+					position2028, tokenIndex2028 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l2029
+					}
+					position++
+					goto l2028
+				l2029:
+					position, tokenIndex = position2028, tokenIndex2028
+					if buffer[position] != rune('N') {
+						goto l2024
+					}
+					position++
+				}
+			l2028:
+				{
+					position2030, tokenIndex2030 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l2031
+					}
+					position++
+					goto l2030
+				l2031:
+					position, tokenIndex = position2030, tokenIndex2030
+					if buffer[position] != rune('S') {
+						goto l2024
+					}
+					position++
+				}
+			l2030:
+				{
+					position2032, tokenIndex2032 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l2033
+					}
+					position++
+					goto l2032
+				l2033:
+					position, tokenIndex = position2032, tokenIndex2032
+					if buffer[position] != rune('N') {
+						goto l2024
+					}
+					position++
+				}
+			l2032:
+				if !_rules[ruleWS]() {
+					goto l2024
+				}
+				if !_rules[ruleInsnFormat]() {
+					goto l2024
+				}
+				if !_rules[ruleWS]() {
+					goto l2024
+				}
+				if !_rules[ruleInsnArg]() {
+					goto l2024
+				}
+			l2034:
+				{
+					position2035, tokenIndex2035 := position, tokenIndex
+					{
+						position2036, tokenIndex2036 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2036
+						}
+						goto l2037
+					l2036:
+						position, tokenIndex = position2036, tokenIndex2036
+					}
+				l2037:
+					if buffer[position] != rune(',') {
+						goto l2035
+					}
+					position++
+					{
+						position2038, tokenIndex2038 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2038
+						}
+						goto l2039
+					l2038:
+						position, tokenIndex = position2038, tokenIndex2038
+					}
+				l2039:
+					if !_rules[ruleInsnArg]() {
+						goto l2035
+					}
+					goto l2034
+				l2035:
+					position, tokenIndex = position2035, tokenIndex2035
+				}
+				add(ruleInsnDirective, position2025)
+			}
+			return true
+		l2024:
+			position, tokenIndex = position2024, tokenIndex2024
+			return false
+		},
+		/* 70 InsnFormat <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))* !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position2040, tokenIndex2040 := position, tokenIndex
+			{
+				position2041 := position
+				{
+					position2042, tokenIndex2042 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2043
+					}
+					position++
+					goto l2042
+				l2043:
+					position, tokenIndex = position2042, tokenIndex2042
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2040
+					}
+					position++
+				}
+			l2042:
+			l2044:
+				{
+					position2045, tokenIndex2045 := position, tokenIndex
+					{
+						position2046, tokenIndex2046 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2047
+						}
+						position++
+						goto l2046
+					l2047:
+						position, tokenIndex = position2046, tokenIndex2046
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2048
+						}
+						position++
+						goto l2046
+					l2048:
+						position, tokenIndex = position2046, tokenIndex2046
+						{
+							position2049, tokenIndex2049 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2050
+							}
+							position++
+							goto l2049
+						l2050:
+							position, tokenIndex = position2049, tokenIndex2049
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2045
+							}
+							position++
+						}
+					l2049:
+					}
+				l2046:
+					goto l2044
+				l2045:
+					position, tokenIndex = position2045, tokenIndex2045
+				}
+				{
+					position2051, tokenIndex2051 := position, tokenIndex
+					{
+						position2052, tokenIndex2052 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2053
+						}
+						position++
+						goto l2052
+					l2053:
+						position, tokenIndex = position2052, tokenIndex2052
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2054
+						}
+						position++
+						goto l2052
+					l2054:
+						position, tokenIndex = position2052, tokenIndex2052
+						{
+							position2056, tokenIndex2056 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2057
+							}
+							position++
+							goto l2056
+						l2057:
+							position, tokenIndex = position2056, tokenIndex2056
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2055
+							}
+							position++
+						}
+					l2056:
+						goto l2052
+					l2055:
+						position, tokenIndex = position2052, tokenIndex2052
+						if buffer[position] != rune('_') {
+							goto l2051
+						}
+						position++
+					}
+				l2052:
+					goto l2040
+				l2051:
+					position, tokenIndex = position2051, tokenIndex2051
+				}
+				add(ruleInsnFormat, position2041)
+			}
+			return true
+		l2040:
+			position, tokenIndex = position2040, tokenIndex2040
+			return false
+		},
+		/* 71 InsnArg <- <((Offset? '(' InsnRegister ')') / Offset / InsnRegister)> */
+		func() bool {
+			position2058, tokenIndex2058 := position, tokenIndex
+			{
+				position2059 := position
+				{
+					position2060, tokenIndex2060 := position, tokenIndex
+					{
+						position2062, tokenIndex2062 := position, tokenIndex
+						if !_rules[ruleOffset]() {
+							goto l2062
+						}
+						goto l2063
+					l2062:
+						position, tokenIndex = position2062, tokenIndex2062
+					}
+				l2063:
+					if buffer[position] != rune('(') {
+						goto l2061
+					}
+					position++
+					if !_rules[ruleInsnRegister]() {
+						goto l2061
+					}
+					if buffer[position] != rune(')') {
+						goto l2061
+					}
+					position++
+					goto l2060
+				l2061:
+					position, tokenIndex = position2060, tokenIndex2060
+					if !_rules[ruleOffset]() {
+						goto l2064
+					}
+					goto l2060
+				l2064:
+					position, tokenIndex = position2060, tokenIndex2060
+					if !_rules[ruleInsnRegister]() {
+						goto l2058
+					}
+				}
+			l2060:
+				add(ruleInsnArg, position2059)
+			}
+			return true
+		l2058:
+			position, tokenIndex = position2058, tokenIndex2058
+			return false
+		},
+		/* 72 InsnRegister <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))*)> */
+		func() bool {
+			position2065, tokenIndex2065 := position, tokenIndex
+			{
+				position2066 := position
+				{
+					position2067, tokenIndex2067 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2068
+					}
+					position++
+					goto l2067
+				l2068:
+					position, tokenIndex = position2067, tokenIndex2067
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2065
+					}
+					position++
+				}
+			l2067:
+			l2069:
+				{
+					position2070, tokenIndex2070 := position, tokenIndex
+					{
+						position2071, tokenIndex2071 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2072
+						}
+						position++
+						goto l2071
+					l2072:
+						position, tokenIndex = position2071, tokenIndex2071
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2073
+						}
+						position++
+						goto l2071
+					l2073:
+						position, tokenIndex = position2071, tokenIndex2071
+						{
+							position2074, tokenIndex2074 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2075
+							}
+							position++
+							goto l2074
+						l2075:
+							position, tokenIndex = position2074, tokenIndex2074
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2070
+							}
+							position++
+						}
+					l2074:
+					}
+				l2071:
+					goto l2069
+				l2070:
+					position, tokenIndex = position2070, tokenIndex2070
+				}
+				add(ruleInsnRegister, position2066)
+			}
+			return true
+		l2065:
+			position, tokenIndex = position2065, tokenIndex2065
+			return false
+		},
+		/* 73 LabelContainingDirectiveName <- <(('.' ('x' / 'X') ('w' / 'W') ('o' / 'O') ('r' / 'R') ('d' / 'D')) / ('.' ('w' / 'W') ('o' / 'O') ('r' / 'R') ('d' / 'D')) / ('.' ('l' / 'L') ('o' / 'O') ('n' / 'N') ('g' / 'G')) / ('.' ('s' / 'S') ('e' / 'E') ('t' / 'T')) / ('.' '8' ('b' / 'B') ('y' / 'Y') ('t' / 'T') ('e' / 'E')) / ('.' '4' ('b' / 'B') ('y' / 'Y') ('t' / 'T') ('e' / 'E')) / ('.' ('q' / 'Q') ('u' / 'U') ('a' / 'A') ('d' / 'D')) / ('.' ('t' / 'T') ('c' / 'C')) / ('.' ('l' / 'L') ('o' / 'O') ('c' / 'C') ('a' / 'A') ('l' / 'L') ('e' / 'E') ('n' / 'N') ('t' / 'T') ('r' / 'R') ('y' / 'Y')) / ('.' ('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) / ('.' ('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) / ('.' ('u' / 'U') ('l' / 'L') ('e' / 'E') ('b' / 'B') '1' '2' '8') / ('.' ('s' / 'S') ('l' / 'L') ('e' / 'E') ('b' / 'B') '1' '2' '8') / ('.' ('d' / 'D') ('c' / 'C') '.' ('a' / 'A')) / ('.' ('d' / 'D') ('c' / 'C') '.' ('b' / 'B')) / ('.' ('d' / 'D') ('c' / 'C') '.' ('w' / 'W')) / ('.' ('d' / 'D') ('c' / 'C') '.' ('l' / 'L')) / ('.' ('s' / 'S') ('h' / 'H') ('o' / 'O') ('r' / 'R') ('t' / 'T')) / ('.' ('h' / 'H') ('w' / 'W') ('o' / 'O') ('r' / 'R') ('d' / 'D')))> */
+		func() bool {
+			position2076, tokenIndex2076 := position, tokenIndex
+			{
+				position2077 := position
+				{
+					position2078, tokenIndex2078 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l2079
+					}
+					position++
+					{
+						position2080, tokenIndex2080 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l2081
+						}
+						position++
+						goto l2080
+					l2081:
+						position, tokenIndex = position2080, tokenIndex2080
+						if buffer[position] != rune('X') {
+							goto l2079
+						}
+						position++
+					}
+				l2080:
+					{
+						position2082, tokenIndex2082 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2083
+						}
+						position++
+						goto l2082
+					l2083:
+						position, tokenIndex = position2082, tokenIndex2082
+						if buffer[position] != rune('W') {
+							goto l2079
+						}
+						position++
+					}
+				l2082:
+					{
+						position2084, tokenIndex2084 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2085
+						}
+						position++
+						goto l2084
+					l2085:
+						position, tokenIndex = position2084, tokenIndex2084
+						if buffer[position] != rune('O') {
+							goto l2079
+						}
+						position++
+					}
+				l2084:
+					{
+						position2086, tokenIndex2086 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2087
+						}
+						position++
+						goto l2086
+					l2087:
+						position, tokenIndex = position2086, tokenIndex2086
+						if buffer[position] != rune('R') {
+							goto l2079
+						}
+						position++
+					}
+				l2086:
+					{
+						position2088, tokenIndex2088 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2089
+						}
+						position++
+						goto l2088
+					l2089:
+						position, tokenIndex = position2088, tokenIndex2088
+						if buffer[position] != rune('D') {
+							goto l2079
+						}
+						position++
+					}
+				l2088:
+					goto l2078
+				l2079:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2090
+					}
+					position++
+					{
+						position2091, tokenIndex2091 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2092
+						}
+						position++
+						goto l2091
+					l2092:
+						position, tokenIndex = position2091, tokenIndex2091
+						if buffer[position] != rune('W') {
+							goto l2090
+						}
+						position++
+					}
+				l2091:
+					{
+						position2093, tokenIndex2093 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2094
+						}
+						position++
+						goto l2093
+					l2094:
+						position, tokenIndex = position2093, tokenIndex2093
+						if buffer[position] != rune('O') {
+							goto l2090
+						}
+						position++
+					}
+				l2093:
+					{
+						position2095, tokenIndex2095 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2096
+						}
+						position++
+						goto l2095
+					l2096:
+						position, tokenIndex = position2095, tokenIndex2095
+						if buffer[position] != rune('R') {
+							goto l2090
+						}
+						position++
+					}
+				l2095:
+					{
+						position2097, tokenIndex2097 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2098
+						}
+						position++
+						goto l2097
+					l2098:
+						position, tokenIndex = position2097, tokenIndex2097
+						if buffer[position] != rune('D') {
+							goto l2090
+						}
+						position++
+					}
+				l2097:
+					goto l2078
+				l2090:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2099
+					}
+					position++
+					{
+						position2100, tokenIndex2100 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2101
+						}
+						position++
+						goto l2100
+					l2101:
+						position, tokenIndex = position2100, tokenIndex2100
+						if buffer[position] != rune('L') {
+							goto l2099
+						}
+						position++
+					}
+				l2100:
+					{
+						position2102, tokenIndex2102 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2103
+						}
+						position++
+						goto l2102
+					l2103:
+						position, tokenIndex = position2102, tokenIndex2102
+						if buffer[position] != rune('O') {
+							goto l2099
+						}
+						position++
+					}
+				l2102:
+					{
+						position2104, tokenIndex2104 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2105
+						}
+						position++
+						goto l2104
+					l2105:
+						position, tokenIndex = position2104, tokenIndex2104
+						if buffer[position] != rune('N') {
+							goto l2099
+						}
+						position++
+					}
+				l2104:
+					{
+						position2106, tokenIndex2106 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2107
+						}
+						position++
+						goto l2106
+					l2107:
+						position, tokenIndex = position2106, tokenIndex2106
+						if buffer[position] != rune('G') {
+							goto l2099
+						}
+						position++
+					}
+				l2106:
+					goto l2078
+				l2099:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2108
+					}
+					position++
+					{
+						position2109, tokenIndex2109 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2110
+						}
+						position++
+						goto l2109
+					l2110:
+						position, tokenIndex = position2109, tokenIndex2109
+						if buffer[position] != rune('S') {
+							goto l2108
+						}
+						position++
+					}
+				l2109:
+					{
+						position2111, tokenIndex2111 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2112
+						}
+						position++
+						goto l2111
+					l2112:
+						position, tokenIndex = position2111, tokenIndex2111
+						if buffer[position] != rune('E') {
+							goto l2108
+						}
+						position++
+					}
+				l2111:
+					{
+						position2113, tokenIndex2113 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2114
+						}
+						position++
+						goto l2113
+					l2114:
+						position, tokenIndex = position2113, tokenIndex2113
+						if buffer[position] != rune('T') {
+							goto l2108
+						}
+						position++
+					}
+				l2113:
+					goto l2078
+				l2108:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2115
+					}
+					position++
+					if buffer[position] != rune('8') {
+						goto l2115
+					}
+					position++
+					{
+						position2116, tokenIndex2116 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2117
+						}
+						position++
+						goto l2116
+					l2117:
+						position, tokenIndex = position2116, tokenIndex2116
+						if buffer[position] != rune('B') {
+							goto l2115
+						}
+						position++
+					}
+				l2116:
+					{
+						position2118, tokenIndex2118 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2119
+						}
+						position++
+						goto l2118
+					l2119:
+						position, tokenIndex = position2118, tokenIndex2118
+						if buffer[position] != rune('Y') {
+							goto l2115
+						}
+						position++
+					}
+				l2118:
+					{
+						position2120, tokenIndex2120 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2121
+						}
+						position++
+						goto l2120
+					l2121:
+						position, tokenIndex = position2120, tokenIndex2120
+						if buffer[position] != rune('T') {
+							goto l2115
+						}
+						position++
+					}
+				l2120:
+					{
+						position2122, tokenIndex2122 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2123
+						}
+						position++
+						goto l2122
+					l2123:
+						position, tokenIndex = position2122, tokenIndex2122
+						if buffer[position] != rune('E') {
+							goto l2115
+						}
+						position++
+					}
+				l2122:
+					goto l2078
+				l2115:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2124
+					}
+					position++
+					if buffer[position] != rune('4') {
+						goto l2124
+					}
+					position++
+					{
+						position2125, tokenIndex2125 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2126
+						}
+						position++
+						goto l2125
+					l2126:
+						position, tokenIndex = position2125, tokenIndex2125
+						if buffer[position] != rune('B') {
+							goto l2124
+						}
+						position++
+					}
+				l2125:
+					{
+						position2127, tokenIndex2127 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2128
+						}
+						position++
+						goto l2127
+					l2128:
+						position, tokenIndex = position2127, tokenIndex2127
+						if buffer[position] != rune('Y') {
+							goto l2124
+						}
+						position++
+					}
+				l2127:
+					{
+						position2129, tokenIndex2129 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2130
+						}
+						position++
+						goto l2129
+					l2130:
+						position, tokenIndex = position2129, tokenIndex2129
+						if buffer[position] != rune('T') {
+							goto l2124
+						}
+						position++
+					}
+				l2129:
+					{
+						position2131, tokenIndex2131 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2132
+						}
+						position++
+						goto l2131
+					l2132:
+						position, tokenIndex = position2131, tokenIndex2131
+						if buffer[position] != rune('E') {
+							goto l2124
+						}
+						position++
+					}
+				l2131:
+					goto l2078
+				l2124:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2133
+					}
+					position++
+					{
+						position2134, tokenIndex2134 := position, tokenIndex
+						if buffer[position] != rune('q') {
+							goto l2135
+						}
+						position++
+						goto l2134
+					l2135:
+						position, tokenIndex = position2134, tokenIndex2134
+						if buffer[position] != rune('Q') {
+							goto l2133
+						}
+						position++
+					}
+				l2134:
+					{
+						position2136, tokenIndex2136 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2137
+						}
+						position++
+						goto l2136
+					l2137:
+						position, tokenIndex = position2136, tokenIndex2136
+						if buffer[position] != rune('U') {
+							goto l2133
+						}
+						position++
+					}
+				l2136:
+					{
+						position2138, tokenIndex2138 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2139
+						}
+						position++
+						goto l2138
+					l2139:
+						position, tokenIndex = position2138, tokenIndex2138
+						if buffer[position] != rune('A') {
+							goto l2133
+						}
+						position++
+					}
+				l2138:
+					{
+						position2140, tokenIndex2140 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2141
+						}
+						position++
+						goto l2140
+					l2141:
+						position, tokenIndex = position2140, tokenIndex2140
+						if buffer[position] != rune('D') {
+							goto l2133
+						}
+						position++
+					}
+				l2140:
+					goto l2078
+				l2133:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2142
+					}
+					position++
+					{
+						position2143, tokenIndex2143 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2144
+						}
+						position++
+						goto l2143
+					l2144:
+						position, tokenIndex = position2143, tokenIndex2143
+						if buffer[position] != rune('T') {
+							goto l2142
+						}
+						position++
+					}
+				l2143:
+					{
+						position2145, tokenIndex2145 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2146
+						}
+						position++
+						goto l2145
+					l2146:
+						position, tokenIndex = position2145, tokenIndex2145
+						if buffer[position] != rune('C') {
+							goto l2142
+						}
+						position++
+					}
+				l2145:
+					goto l2078
+				l2142:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2147
+					}
+					position++
+					{
+						position2148, tokenIndex2148 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2149
+						}
+						position++
+						goto l2148
+					l2149:
+						position, tokenIndex = position2148, tokenIndex2148
+						if buffer[position] != rune('L') {
+							goto l2147
+						}
+						position++
+					}
+				l2148:
+					{
+						position2150, tokenIndex2150 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2151
+						}
+						position++
+						goto l2150
+					l2151:
+						position, tokenIndex = position2150, tokenIndex2150
+						if buffer[position] != rune('O') {
+							goto l2147
+						}
+						position++
+					}
+				l2150:
+					{
+						position2152, tokenIndex2152 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2153
+						}
+						position++
+						goto l2152
+					l2153:
+						position, tokenIndex = position2152, tokenIndex2152
+						if buffer[position] != rune('C') {
+							goto l2147
+						}
+						position++
+					}
+				l2152:
+					{
+						position2154, tokenIndex2154 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2155
+						}
+						position++
+						goto l2154
+					l2155:
+						position, tokenIndex = position2154, tokenIndex2154
+						if buffer[position] != rune('A') {
+							goto l2147
+						}
+						position++
+					}
+				l2154:
+					{
+						position2156, tokenIndex2156 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2157
+						}
+						position++
+						goto l2156
+					l2157:
+						position, tokenIndex = position2156, tokenIndex2156
+						if buffer[position] != rune('L') {
+							goto l2147
+						}
+						position++
+					}
+				l2156:
+					{
+						position2158, tokenIndex2158 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2159
+						}
+						position++
+						goto l2158
+					l2159:
+						position, tokenIndex = position2158, tokenIndex2158
+						if buffer[position] != rune('E') {
+							goto l2147
+						}
+						position++
+					}
+				l2158:
+					{
+						position2160, tokenIndex2160 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2161
+						}
+						position++
+						goto l2160
+					l2161:
+						position, tokenIndex = position2160, tokenIndex2160
+						if buffer[position] != rune('N') {
+							goto l2147
+						}
+						position++
+					}
+				l2160:
+					{
+						position2162, tokenIndex2162 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2163
+						}
+						position++
+						goto l2162
+					l2163:
+						position, tokenIndex = position2162, tokenIndex2162
+						if buffer[position] != rune('T') {
+							goto l2147
+						}
+						position++
+					}
+				l2162:
+					{
+						position2164, tokenIndex2164 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2165
+						}
+						position++
+						goto l2164
+					l2165:
+						position, tokenIndex = position2164, tokenIndex2164
+						if buffer[position] != rune('R') {
+							goto l2147
+						}
+						position++
+					}
+				l2164:
+					{
+						position2166, tokenIndex2166 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2167
+						}
+						position++
+						goto l2166
+					l2167:
+						position, tokenIndex = position2166, tokenIndex2166
+						if buffer[position] != rune('Y') {
+							goto l2147
+						}
+						position++
+					}
+				l2166:
+					goto l2078
+				l2147:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2168
+					}
+					position++
+					{
+						position2169, tokenIndex2169 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2170
+						}
+						position++
+						goto l2169
+					l2170:
+						position, tokenIndex = position2169, tokenIndex2169
+						if buffer[position] != rune('S') {
+							goto l2168
+						}
+						position++
+					}
+				l2169:
+					{
+						position2171, tokenIndex2171 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2172
+						}
+						position++
+						goto l2171
+					l2172:
+						position, tokenIndex = position2171, tokenIndex2171
+						if buffer[position] != rune('I') {
+							goto l2168
+						}
+						position++
+					}
+				l2171:
+					{
+						position2173, tokenIndex2173 := position, tokenIndex
+						if buffer[position] != rune('z') {
+							goto l2174
+						}
+						position++
+						goto l2173
+					l2174:
+						position, tokenIndex = position2173, tokenIndex2173
+						if buffer[position] != rune('Z') {
+							goto l2168
+						}
+						position++
+					}
+				l2173:
+					{
+						position2175, tokenIndex2175 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2176
+						}
+						position++
+						goto l2175
+					l2176:
+						position, tokenIndex = position2175, tokenIndex2175
+						if buffer[position] != rune('E') {
+							goto l2168
+						}
+						position++
+					}
+				l2175:
+					goto l2078
+				l2168:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2177
+					}
+					position++
+					{
+						position2178, tokenIndex2178 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2179
+						}
+						position++
+						goto l2178
+					l2179:
+						position, tokenIndex = position2178, tokenIndex2178
+						if buffer[position] != rune('T') {
+							goto l2177
+						}
+						position++
+					}
+				l2178:
+					{
+						position2180, tokenIndex2180 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2181
+						}
+						position++
+						goto l2180
+					l2181:
+						position, tokenIndex = position2180, tokenIndex2180
+						if buffer[position] != rune('Y') {
+							goto l2177
+						}
+						position++
+					}
+				l2180:
+					{
+						position2182, tokenIndex2182 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2183
+						}
+						position++
+						goto l2182
+					l2183:
+						position, tokenIndex = position2182, tokenIndex2182
+						if buffer[position] != rune('P') {
+							goto l2177
+						}
+						position++
+					}
+				l2182:
+					{
+						position2184, tokenIndex2184 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2185
+						}
+						position++
+						goto l2184
+					l2185:
+						position, tokenIndex = position2184, tokenIndex2184
+						if buffer[position] != rune('E') {
+							goto l2177
+						}
+						position++
+					}
+				l2184:
+					goto l2078
+				l2177:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2186
+					}
+					position++
+					{
+						position2187, tokenIndex2187 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2188
+						}
+						position++
+						goto l2187
+					l2188:
+						position, tokenIndex = position2187, tokenIndex2187
+						if buffer[position] != rune('U') {
+							goto l2186
+						}
+						position++
+					}
+				l2187:
+					{
+						position2189, tokenIndex2189 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2190
+						}
+						position++
+						goto l2189
+					l2190:
+						position, tokenIndex = position2189, tokenIndex2189
+						if buffer[position] != rune('L') {
+							goto l2186
+						}
+						position++
+					}
+				l2189:
+					{
+						position2191, tokenIndex2191 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2192
+						}
+						position++
+						goto l2191
+					l2192:
+						position, tokenIndex = position2191, tokenIndex2191
+						if buffer[position] != rune('E') {
+							goto l2186
+						}
+						position++
+					}
+				l2191:
+					{
+						position2193, tokenIndex2193 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2194
+						}
+						position++
+						goto l2193
+					l2194:
+						position, tokenIndex = position2193, tokenIndex2193
+						if buffer[position] != rune('B') {
+							goto l2186
+						}
+						position++
+					}
+				l2193:
+					if buffer[position] != rune('1') {
+						goto l2186
+					}
+					position++
+					if buffer[position] != rune('2') {
+						goto l2186
+					}
+					position++
+					if buffer[position] != rune('8') {
+						goto l2186
+					}
+					position++
+					goto l2078
+				l2186:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2195
+					}
+					position++
+					{
+						position2196, tokenIndex2196 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2197
+						}
+						position++
+						goto l2196
+					l2197:
+						position, tokenIndex = position2196, tokenIndex2196
+						if buffer[position] != rune('S') {
+							goto l2195
+						}
+						position++
+					}
+				l2196:
+					{
+						position2198, tokenIndex2198 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2199
+						}
+						position++
+						goto l2198
+					l2199:
+						position, tokenIndex = position2198, tokenIndex2198
+						if buffer[position] != rune('L') {
+							goto l2195
+						}
+						position++
+					}
+				l2198:
+					{
+						position2200, tokenIndex2200 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2201
+						}
+						position++
+						goto l2200
+					l2201:
+						position, tokenIndex = position2200, tokenIndex2200
+						if buffer[position] != rune('E') {
+							goto l2195
+						}
+						position++
+					}
+				l2200:
+					{
+						position2202, tokenIndex2202 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2203
+						}
+						position++
+						goto l2202
+					l2203:
+						position, tokenIndex = position2202, tokenIndex2202
+						if buffer[position] != rune('B') {
+							goto l2195
+						}
+						position++
+					}
+				l2202:
+					if buffer[position] != rune('1') {
+						goto l2195
+					}
+					position++
+					if buffer[position] != rune('2') {
+						goto l2195
+					}
+					position++
+					if buffer[position] != rune('8') {
+						goto l2195
+					}
+					position++
+					goto l2078
+				l2195:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2204
+					}
+					position++
+					{
+						position2205, tokenIndex2205 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2206
+						}
+						position++
+						goto l2205
+					l2206:
+						position, tokenIndex = position2205, tokenIndex2205
+						if buffer[position] != rune('D') {
+							goto l2204
+						}
+						position++
+					}
+				l2205:
+					{
+						position2207, tokenIndex2207 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2208
+						}
+						position++
+						goto l2207
+					l2208:
+						position, tokenIndex = position2207, tokenIndex2207
+						if buffer[position] != rune('C') {
+							goto l2204
+						}
+						position++
+					}
+				l2207:
+					if buffer[position] != rune('.') {
+						goto l2204
+					}
+					position++
+					{
+						position2209, tokenIndex2209 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2210
+						}
+						position++
+						goto l2209
+					l2210:
+						position, tokenIndex = position2209, tokenIndex2209
+						if buffer[position] != rune('A') {
+							goto l2204
+						}
+						position++
+					}
+				l2209:
+					goto l2078
+				l2204:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2211
+					}
+					position++
+					{
+						position2212, tokenIndex2212 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2213
+						}
+						position++
+						goto l2212
+					l2213:
+						position, tokenIndex = position2212, tokenIndex2212
+						if buffer[position] != rune('D') {
+							goto l2211
+						}
+						position++
+					}
+				l2212:
+					{
+						position2214, tokenIndex2214 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2215
+						}
+						position++
+						goto l2214
+					l2215:
+						position, tokenIndex = position2214, tokenIndex2214
+						if buffer[position] != rune('C') {
+							goto l2211
+						}
+						position++
+					}
+				l2214:
+					if buffer[position] != rune('.') {
+						goto l2211
+					}
+					position++
+					{
+						position2216, tokenIndex2216 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2217
+						}
+						position++
+						goto l2216
+					l2217:
+						position, tokenIndex = position2216, tokenIndex2216
+						if buffer[position] != rune('B') {
+							goto l2211
+						}
+						position++
+					}
+				l2216:
+					goto l2078
+				l2211:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2218
+					}
+					position++
+					{
+						position2219, tokenIndex2219 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2220
+						}
+						position++
+						goto l2219
+					l2220:
+						position, tokenIndex = position2219, tokenIndex2219
+						if buffer[position] != rune('D') {
+							goto l2218
+						}
+						position++
+					}
+				l2219:
+					{
+						position2221, tokenIndex2221 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2222
+						}
+						position++
+						goto l2221
+					l2222:
+						position, tokenIndex = position2221, tokenIndex2221
+						if buffer[position] != rune('C') {
+							goto l2218
+						}
+						position++
+					}
+				l2221:
+					if buffer[position] != rune('.') {
+						goto l2218
+					}
+					position++
+					{
+						position2223, tokenIndex2223 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2224
+						}
+						position++
+						goto l2223
+					l2224:
+						position, tokenIndex = position2223, tokenIndex2223
+						if buffer[position] != rune('W') {
+							goto l2218
+						}
+						position++
+					}
+				l2223:
+					goto l2078
+				l2218:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2225
+					}
+					position++
+					{
+						position2226, tokenIndex2226 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2227
+						}
+						position++
+						goto l2226
+					l2227:
+						position, tokenIndex = position2226, tokenIndex2226
+						if buffer[position] != rune('D') {
+							goto l2225
+						}
+						position++
+					}
+				l2226:
+					{
+						position2228, tokenIndex2228 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2229
+						}
+						position++
+						goto l2228
+					l2229:
+						position, tokenIndex = position2228, tokenIndex2228
+						if buffer[position] != rune('C') {
+							goto l2225
+						}
+						position++
+					}
+				l2228:
+					if buffer[position] != rune('.') {
+						goto l2225
+					}
+					position++
+					{
+						position2230, tokenIndex2230 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2231
+						}
+						position++
+						goto l2230
+					l2231:
+						position, tokenIndex = position2230, tokenIndex2230
+						if buffer[position] != rune('L') {
+							goto l2225
+						}
+						position++
+					}
+				l2230:
+					goto l2078
+				l2225:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2232
+					}
+					position++
+					{
+						position2233, tokenIndex2233 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2234
+						}
+						position++
+						goto l2233
+					l2234:
+						position, tokenIndex = position2233, tokenIndex2233
+						if buffer[position] != rune('S') {
+							goto l2232
+						}
+						position++
+					}
+				l2233:
+					{
+						position2235, tokenIndex2235 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l2236
+						}
+						position++
+						goto l2235
+					l2236:
+						position, tokenIndex = position2235, tokenIndex2235
+						if buffer[position] != rune('H') {
+							goto l2232
+						}
+						position++
+					}
+				l2235:
+					{
+						position2237, tokenIndex2237 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2238
+						}
+						position++
+						goto l2237
+					l2238:
+						position, tokenIndex = position2237, tokenIndex2237
+						if buffer[position] != rune('O') {
+							goto l2232
+						}
+						position++
+					}
+				l2237:
+					{
+						position2239, tokenIndex2239 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2240
+						}
+						position++
+						goto l2239
+					l2240:
+						position, tokenIndex = position2239, tokenIndex2239
+						if buffer[position] != rune('R') {
+							goto l2232
+						}
+						position++
+					}
+				l2239:
+					{
+						position2241, tokenIndex2241 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2242
+						}
+						position++
+						goto l2241
+					l2242:
+						position, tokenIndex = position2241, tokenIndex2241
+						if buffer[position] != rune('T') {
+							goto l2232
+						}
+						position++
+					}
+				l2241:
+					goto l2078
+				l2232:
+					position, tokenIndex = position2078, tokenIndex2078
+					if buffer[position] != rune('.') {
+						goto l2076
+					}
+					position++
+					{
+						position2243, tokenIndex2243 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l2244
+						}
+						position++
+						goto l2243
+					l2244:
+						position, tokenIndex = position2243, tokenIndex2243
+						if buffer[position] != rune('H') {
+							goto l2076
+						}
+						position++
+					}
+				l2243:
+					{
+						position2245, tokenIndex2245 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2246
+						}
+						position++
+						goto l2245
+					l2246:
+						position, tokenIndex = position2245, tokenIndex2245
+						if buffer[position] != rune('W') {
+							goto l2076
+						}
+						position++
+					}
+				l2245:
+					{
+						position2247, tokenIndex2247 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2248
+						}
+						position++
+						goto l2247
+					l2248:
+						position, tokenIndex = position2247, tokenIndex2247
+						if buffer[position] != rune('O') {
+							goto l2076
+						}
+						position++
+					}
+				l2247:
+					{
+						position2249, tokenIndex2249 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2250
+						}
+						position++
+						goto l2249
+					l2250:
+						position, tokenIndex = position2249, tokenIndex2249
+						if buffer[position] != rune('R') {
+							goto l2076
+						}
+						position++
+					}
+				l2249:
+					{
+						position2251, tokenIndex2251 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2252
+						}
+						position++
+						goto l2251
+					l2252:
+						position, tokenIndex = position2251, tokenIndex2251
+						if buffer[position] != rune('D') {
+							goto l2076
+						}
+						position++
+					}
+				l2251:
+				}
+			l2078:
+				add(ruleLabelContainingDirectiveName, position2077)
+			}
+			return true
+		l2076:
+			position, tokenIndex = position2076, tokenIndex2076
+			return false
+		},
+		/* 74 SymbolArgs <- <(SymbolArg (WS? ',' WS? SymbolArg)*)> */
+		func() bool {
+			position2253, tokenIndex2253 := position, tokenIndex
+			{
+				position2254 := position
+				if !_rules[ruleSymbolArg]() {
+					goto l2253
+				}
+			l2255:
+				{
+					position2256, tokenIndex2256 := position, tokenIndex
+					{
+						position2257, tokenIndex2257 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2257
+						}
+						goto l2258
+					l2257:
+						position, tokenIndex = position2257, tokenIndex2257
+					}
+				l2258:
+					if buffer[position] != rune(',') {
+						goto l2256
+					}
+					position++
+					{
+						position2259, tokenIndex2259 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2259
+						}
+						goto l2260
+					l2259:
+						position, tokenIndex = position2259, tokenIndex2259
+					}
+				l2260:
+					if !_rules[ruleSymbolArg]() {
+						goto l2256
+					}
+					goto l2255
+				l2256:
+					position, tokenIndex = position2256, tokenIndex2256
+				}
+				add(ruleSymbolArgs, position2254)
+			}
+			return true
+		l2253:
+			position, tokenIndex = position2253, tokenIndex2253
+			return false
+		},
+		/* 75 SymbolArg <- <((&(Offset SymbolArgEnd) Offset) / (&(Expression SymbolArgEnd) Expression) / SymbolType / ((Offset / LocalSymbol / SymbolName / Dot) (WS? Operator WS? (Offset / LocalSymbol / SymbolName))+) / (LocalSymbol TCMarker?) / (SymbolName Offset) / (SymbolName TCMarker?))> */
+		func() bool {
+			position2261, tokenIndex2261 := position, tokenIndex
+			{
+				position2262 := position
+				{
+					position2263, tokenIndex2263 := position, tokenIndex
+					position2265, tokenIndex2265 := position, tokenIndex
+					if !_rules[ruleOffset]() {
+						goto l2264
+					}
+					if !_rules[ruleSymbolArgEnd]() {
+						goto l2264
+					}
+					position, tokenIndex = position2265, tokenIndex2265
+					if !_rules[ruleOffset]() {
+						goto l2264
+					}
+					goto l2263
+				l2264:
+					position, tokenIndex = position2263, tokenIndex2263
+					position2267, tokenIndex2267 := position, tokenIndex
+					if !_rules[ruleExpression]() {
+						goto l2266
+					}
+					if !_rules[ruleSymbolArgEnd]() {
+						goto l2266
+					}
+					position, tokenIndex = position2267, tokenIndex2267
+					if !_rules[ruleExpression]() {
+						goto l2266
+					}
+					goto l2263
+				l2266:
+					position, tokenIndex = position2263, tokenIndex2263
+					if !_rules[ruleSymbolType]() {
+						goto l2268
+					}
+					goto l2263
+				l2268:
+					position, tokenIndex = position2263, tokenIndex2263
+					{
+						position2270, tokenIndex2270 := position, tokenIndex
+						if !_rules[ruleOffset]() {
+							goto l2271
+						}
+						goto l2270
+					l2271:
+						position, tokenIndex = position2270, tokenIndex2270
+						if !_rules[ruleLocalSymbol]() {
+							goto l2272
+						}
+						goto l2270
+					l2272:
+						position, tokenIndex = position2270, tokenIndex2270
+						if !_rules[ruleSymbolName]() {
+							goto l2273
+						}
+						goto l2270
+					l2273:
+						position, tokenIndex = position2270, tokenIndex2270
+						if !_rules[ruleDot]() {
+							goto l2269
+						}
+					}
+				l2270:
+					{
+						position2276, tokenIndex2276 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2276
+						}
+						goto l2277
+					l2276:
+						position, tokenIndex = position2276, tokenIndex2276
+					}
+				l2277:
+					if !_rules[ruleOperator]() {
+						goto l2269
+					}
+					{
+						position2278, tokenIndex2278 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2278
+						}
+						goto l2279
+					l2278:
+						position, tokenIndex = position2278, tokenIndex2278
+					}
+				l2279:
+					{
+						position2280, tokenIndex2280 := position, tokenIndex
+						if !_rules[ruleOffset]() {
+							goto l2281
+						}
+						goto l2280
+					l2281:
+						position, tokenIndex = position2280, tokenIndex2280
+						if !_rules[ruleLocalSymbol]() {
+							goto l2282
+						}
+						goto l2280
+					l2282:
+						position, tokenIndex = position2280, tokenIndex2280
+						if !_rules[ruleSymbolName]() {
+							goto l2269
+						}
+					}
+				l2280:
+				l2274:
+					{
+						position2275, tokenIndex2275 := position, tokenIndex
+						{
+							position2283, tokenIndex2283 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l2283
+							}
+							goto l2284
+						l2283:
+							position, tokenIndex = position2283, tokenIndex2283
+						}
+					l2284:
+						if !_rules[ruleOperator]() {
+							goto l2275
+						}
+						{
+							position2285, tokenIndex2285 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l2285
+							}
+							goto l2286
+						l2285:
+							position, tokenIndex = position2285, tokenIndex2285
+						}
+					l2286:
+						{
+							position2287, tokenIndex2287 := position, tokenIndex
+							if !_rules[ruleOffset]() {
+								goto l2288
+							}
+							goto l2287
+						l2288:
+							position, tokenIndex = position2287, tokenIndex2287
+							if !_rules[ruleLocalSymbol]() {
+								goto l2289
+							}
+							goto l2287
+						l2289:
+							position, tokenIndex = position2287, tokenIndex2287
+							if !_rules[ruleSymbolName]() {
+								goto l2275
+							}
+						}
+					l2287:
+						goto l2274
+					l2275:
+						position, tokenIndex = position2275, tokenIndex2275
+					}
+					goto l2263
+				l2269:
+					position, tokenIndex = position2263, tokenIndex2263
+					if !_rules[ruleLocalSymbol]() {
+						goto l2290
+					}
+					{
+						position2291, tokenIndex2291 := position, tokenIndex
+						if !_rules[ruleTCMarker]() {
+							goto l2291
+						}
+						goto l2292
+					l2291:
+						position, tokenIndex = position2291, tokenIndex2291
+					}
+				l2292:
+					goto l2263
+				l2290:
+					position, tokenIndex = position2263, tokenIndex2263
+					if !_rules[ruleSymbolName]() {
+						goto l2293
+					}
+					if !_rules[ruleOffset]() {
+						goto l2293
+					}
+					goto l2263
+				l2293:
+					position, tokenIndex = position2263, tokenIndex2263
+					if !_rules[ruleSymbolName]() {
+						goto l2261
+					}
+					{
+						position2294, tokenIndex2294 := position, tokenIndex
+						if !_rules[ruleTCMarker]() {
+							goto l2294
+						}
+						goto l2295
+					l2294:
+						position, tokenIndex = position2294, tokenIndex2294
+					}
+				l2295:
+				}
+			l2263:
+				add(ruleSymbolArg, position2262)
+			}
+			return true
+		l2261:
+			position, tokenIndex = position2261, tokenIndex2261
+			return false
+		},
+		/* 76 SymbolArgEnd <- <(WS? (',' / Comment / '\n' / ';'))> */
+		func() bool {
+			position2296, tokenIndex2296 := position, tokenIndex
+			{
+				position2297 := position
+				{
+					position2298, tokenIndex2298 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l2298
+					}
+					goto l2299
+				l2298:
+					position, tokenIndex = position2298, tokenIndex2298
+				}
+			l2299:
+				{
+					position2300, tokenIndex2300 := position, tokenIndex
+					if buffer[position] != rune(',') {
+						goto l2301
+					}
+					position++
+					goto l2300
+				l2301:
+					position, tokenIndex = position2300, tokenIndex2300
+					if !_rules[ruleComment]() {
+						goto l2302
+					}
+					goto l2300
+				l2302:
+					position, tokenIndex = position2300, tokenIndex2300
+					if buffer[position] != rune('\n') {
+						goto l2303
+					}
+					position++
+					goto l2300
+				l2303:
+					position, tokenIndex = position2300, tokenIndex2300
+					if buffer[position] != rune(';') {
+						goto l2296
+					}
+					position++
+				}
+			l2300:
+				add(ruleSymbolArgEnd, position2297)
+			}
+			return true
+		l2296:
+			position, tokenIndex = position2296, tokenIndex2296
+			return false
+		},
+		/* 77 SymbolType <- <(('@' / '%') (('f' 'u' 'n' 'c' 't' 'i' 'o' 'n') / ('o' 'b' 'j' 'e' 'c' 't') / ('g' 'n' 'u' '_' 'i' 'n' 'd' 'i' 'r' 'e' 'c' 't' '_' 'f' 'u' 'n' 'c' 't' 'i' 'o' 'n') / ('g' 'n' 'u' '_' 'u' 'n' 'i' 'q' 'u' 'e' '_' 'o' 'b' 'j' 'e' 'c' 't') / ('t' 'l' 's' '_' 'o' 'b' 'j' 'e' 'c' 't') / ('c' 'o' 'm' 'm' 'o' 'n') / ('n' 'o' 't' 'y' 'p' 'e')))> */
+		func() bool {
+			position2304, tokenIndex2304 := position, tokenIndex
+			{
+				position2305 := position
+				{
+					position2306, tokenIndex2306 := position, tokenIndex
+					if buffer[position] != rune('@') {
+						goto l2307
+					}
+					position++
+					goto l2306
+				l2307:
+					position, tokenIndex = position2306, tokenIndex2306
+					if buffer[position] != rune('%') {
+						goto l2304
+					}
+					position++
+				}
+			l2306:
+				{
+					position2308, tokenIndex2308 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l2309
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l2309
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2309
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l2309
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l2309
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l2309
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l2309
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2309
+					}
+					position++
+					goto l2308
+				l2309:
+					position, tokenIndex = position2308, tokenIndex2308
+					if buffer[position] != rune('o') {
+						goto l2310
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l2310
+					}
+					position++
+					if buffer[position] != rune('j') {
+						goto l2310
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l2310
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l2310
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l2310
+					}
+					position++
+					goto l2308
+				l2310:
+					position, tokenIndex = position2308, tokenIndex2308
+					if buffer[position] != rune('g') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l2311
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2311
+					}
+					position++
+					goto l2308
+				l2311:
+					position, tokenIndex = position2308, tokenIndex2308
+					if buffer[position] != rune('g') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('q') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('j') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l2312
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l2312
+					}
+					position++
+					goto l2308
+				l2312:
+					position, tokenIndex = position2308, tokenIndex2308
+					if buffer[position] != rune('t') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('j') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l2313
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l2313
+					}
+					position++
+					goto l2308
+				l2313:
+					position, tokenIndex = position2308, tokenIndex2308
+					if buffer[position] != rune('c') {
+						goto l2314
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l2314
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l2314
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l2314
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l2314
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l2314
+					}
+					position++
+					goto l2308
+				l2314:
+					position, tokenIndex = position2308, tokenIndex2308
+					if buffer[position] != rune('n') {
+						goto l2304
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l2304
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l2304
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l2304
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l2304
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l2304
+					}
+					position++
+				}
+			l2308:
+				add(ruleSymbolType, position2305)
+			}
+			return true
+		l2304:
+			position, tokenIndex = position2304, tokenIndex2304
+			return false
+		},
+		/* 78 Dot <- <'.'> */
+		func() bool {
+			position2315, tokenIndex2315 := position, tokenIndex
+			{
+				position2316 := position
+				if buffer[position] != rune('.') {
+					goto l2315
+				}
+				position++
+				add(ruleDot, position2316)
+			}
+			return true
+		l2315:
+			position, tokenIndex = position2315, tokenIndex2315
+			return false
+		},
+		/* 79 TCMarker <- <('[' 'T' 'C' ']')> */
+		func() bool {
+			position2317, tokenIndex2317 := position, tokenIndex
+			{
+				position2318 := position
+				if buffer[position] != rune('[') {
+					goto l2317
+				}
+				position++
+				if buffer[position] != rune('T') {
+					goto l2317
+				}
+				position++
+				if buffer[position] != rune('C') {
+					goto l2317
+				}
+				position++
+				if buffer[position] != rune(']') {
+					goto l2317
+				}
+				position++
+				add(ruleTCMarker, position2318)
+			}
+			return true
+		l2317:
+			position, tokenIndex = position2317, tokenIndex2317
+			return false
+		},
+		/* 80 EscapedChar <- <('\\' .)> */
+		func() bool {
+			position2319, tokenIndex2319 := position, tokenIndex
+			{
+				position2320 := position
+				if buffer[position] != rune('\\') {
+					goto l2319
+				}
+				position++
+				if !matchDot() {
+					goto l2319
+				}
+				add(ruleEscapedChar, position2320)
+			}
+			return true
+		l2319:
+			position, tokenIndex = position2319, tokenIndex2319
+			return false
+		},
+		/* 81 WS <- <(' ' / '\t')+> */
+		func() bool {
+			position2321, tokenIndex2321 := position, tokenIndex
+			{
+				position2322 := position
+				{
+					position2325, tokenIndex2325 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l2326
+					}
+					position++
+					goto l2325
+				l2326:
+					position, tokenIndex = position2325, tokenIndex2325
+					if buffer[position] != rune('\t') {
+						goto l2321
+					}
+					position++
+				}
+			l2325:
+			l2323:
+				{
+					position2324, tokenIndex2324 := position, tokenIndex
+					{
+						position2327, tokenIndex2327 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l2328
+						}
+						position++
+						goto l2327
+					l2328:
+						position, tokenIndex = position2327, tokenIndex2327
+						if buffer[position] != rune('\t') {
+							goto l2324
+						}
+						position++
+					}
+				l2327:
+					goto l2323
+				l2324:
+					position, tokenIndex = position2324, tokenIndex2324
+				}
+				add(ruleWS, position2322)
+			}
+			return true
+		l2321:
+			position, tokenIndex = position2321, tokenIndex2321
+			return false
+		},
+		/* 82 Comment <- <((('/' '/') / '#') (!'\n' .)*)> */
+		func() bool {
+			position2329, tokenIndex2329 := position, tokenIndex
+			{
+				position2330 := position
+				{
+					position2331, tokenIndex2331 := position, tokenIndex
+					if buffer[position] != rune('/') {
+						goto l2332
+					}
+					position++
+					if buffer[position] != rune('/') {
+						goto l2332
+					}
+					position++
+					goto l2331
+				l2332:
+					position, tokenIndex = position2331, tokenIndex2331
+					if buffer[position] != rune('#') {
+						goto l2329
+					}
+					position++
+				}
+			l2331:
+			l2333:
+				{
+					position2334, tokenIndex2334 := position, tokenIndex
+					{
+						position2335, tokenIndex2335 := position, tokenIndex
+						if buffer[position] != rune('\n') {
+							goto l2335
+						}
+						position++
+						goto l2334
+					l2335:
+						position, tokenIndex = position2335, tokenIndex2335
+					}
+					if !matchDot() {
+						goto l2334
+					}
+					goto l2333
+				l2334:
+					position, tokenIndex = position2334, tokenIndex2334
+				}
+				add(ruleComment, position2330)
+			}
+			return true
+		l2329:
+			position, tokenIndex = position2329, tokenIndex2329
+			return false
+		},
+		/* 83 InlineAsmMarker <- <((('#' 'A' 'P' 'P') / ('#' 'N' 'O' '_' 'A' 'P' 'P')) &(WS? '\n'))> */
+		func() bool {
+			position2336, tokenIndex2336 := position, tokenIndex
+			{
+				position2337 := position
+				{
+					position2338, tokenIndex2338 := position, tokenIndex
+					if buffer[position] != rune('#') {
+						goto l2339
+					}
+					position++
+					if buffer[position] != rune('A') {
+						goto l2339
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l2339
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l2339
+					}
+					position++
+					goto l2338
+				l2339:
+					position, tokenIndex = position2338, tokenIndex2338
+					if buffer[position] != rune('#') {
+						goto l2336
+					}
+					position++
+					if buffer[position] != rune('N') {
+						goto l2336
+					}
+					position++
+					if buffer[position] != rune('O') {
+						goto l2336
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2336
+					}
+					position++
+					if buffer[position] != rune('A') {
+						goto l2336
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l2336
+					}
+					position++
+					if buffer[position] != rune('P') {
+						goto l2336
+					}
+					position++
+				}
+			l2338:
+				position2340, tokenIndex2340 := position, tokenIndex
+				{
+					position2341, tokenIndex2341 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l2341
+					}
+					goto l2342
+				l2341:
+					position, tokenIndex = position2341, tokenIndex2341
+				}
+			l2342:
+				if buffer[position] != rune('\n') {
+					goto l2336
+				}
+				position++
+				position, tokenIndex = position2340, tokenIndex2340
+				add(ruleInlineAsmMarker, position2337)
+			}
+			return true
+		l2336:
+			position, tokenIndex = position2336, tokenIndex2336
+			return false
+		},
+		/* 84 Label <- <((LocalSymbol / LocalLabel / SymbolName) ':')> */
+		func() bool {
+			position2343, tokenIndex2343 := position, tokenIndex
+			{
+				position2344 := position
+				{
+					position2345, tokenIndex2345 := position, tokenIndex
+					if !_rules[ruleLocalSymbol]() {
+						goto l2346
+					}
+					goto l2345
+				l2346:
+					position, tokenIndex = position2345, tokenIndex2345
+					if !_rules[ruleLocalLabel]() {
+						goto l2347
+					}
+					goto l2345
+				l2347:
+					position, tokenIndex = position2345, tokenIndex2345
+					if !_rules[ruleSymbolName]() {
+						goto l2343
+					}
+				}
+			l2345:
+				if buffer[position] != rune(':') {
+					goto l2343
+				}
+				position++
+				add(ruleLabel, position2344)
+			}
+			return true
+		l2343:
+			position, tokenIndex = position2343, tokenIndex2343
+			return false
+		},
+		/* 85 SymbolName <- <(([a-z] / [A-Z] / '.' / '_') ([a-z] / [A-Z] / '.' / ([0-9] / [0-9]) / '$' / '_')*)> */
+		func() bool {
+			position2348, tokenIndex2348 := position, tokenIndex
+			{
+				position2349 := position
+				{
+					position2350, tokenIndex2350 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2351
+					}
+					position++
+					goto l2350
+				l2351:
+					position, tokenIndex = position2350, tokenIndex2350
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2352
+					}
+					position++
+					goto l2350
+				l2352:
+					position, tokenIndex = position2350, tokenIndex2350
+					if buffer[position] != rune('.') {
+						goto l2353
+					}
+					position++
+					goto l2350
+				l2353:
+					position, tokenIndex = position2350, tokenIndex2350
+					if buffer[position] != rune('_') {
+						goto l2348
+					}
+					position++
+				}
+			l2350:
+			l2354:
+				{
+					position2355, tokenIndex2355 := position, tokenIndex
+					{
+						position2356, tokenIndex2356 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2357
+						}
+						position++
+						goto l2356
+					l2357:
+						position, tokenIndex = position2356, tokenIndex2356
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2358
+						}
+						position++
+						goto l2356
+					l2358:
+						position, tokenIndex = position2356, tokenIndex2356
+						if buffer[position] != rune('.') {
+							goto l2359
+						}
+						position++
+						goto l2356
+					l2359:
+						position, tokenIndex = position2356, tokenIndex2356
+						{
+							position2361, tokenIndex2361 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2362
+							}
+							position++
+							goto l2361
+						l2362:
+							position, tokenIndex = position2361, tokenIndex2361
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2360
+							}
+							position++
+						}
+					l2361:
+						goto l2356
+					l2360:
+						position, tokenIndex = position2356, tokenIndex2356
+						if buffer[position] != rune('$') {
+							goto l2363
+						}
+						position++
+						goto l2356
+					l2363:
+						position, tokenIndex = position2356, tokenIndex2356
+						if buffer[position] != rune('_') {
+							goto l2355
+						}
+						position++
+					}
+				l2356:
+					goto l2354
+				l2355:
+					position, tokenIndex = position2355, tokenIndex2355
+				}
+				add(ruleSymbolName, position2349)
+			}
+			return true
+		l2348:
+			position, tokenIndex = position2348, tokenIndex2348
+			return false
+		},
+		/* 86 LocalSymbol <- <('.' 'L' ([a-z] / [A-Z] / ([a-z] / [A-Z]) / '.' / ([0-9] / [0-9]) / '$' / '_')+)> */
+		func() bool {
+			position2364, tokenIndex2364 := position, tokenIndex
+			{
+				position2365 := position
+				if buffer[position] != rune('.') {
+					goto l2364
+				}
+				position++
+				if buffer[position] != rune('L') {
+					goto l2364
+				}
+				position++
+				{
+					position2368, tokenIndex2368 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2369
+					}
+					position++
+					goto l2368
+				l2369:
+					position, tokenIndex = position2368, tokenIndex2368
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2370
+					}
+					position++
+					goto l2368
+				l2370:
+					position, tokenIndex = position2368, tokenIndex2368
+					{
+						position2372, tokenIndex2372 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2373
+						}
+						position++
+						goto l2372
+					l2373:
+						position, tokenIndex = position2372, tokenIndex2372
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2371
+						}
+						position++
+					}
+				l2372:
+					goto l2368
+				l2371:
+					position, tokenIndex = position2368, tokenIndex2368
+					if buffer[position] != rune('.') {
+						goto l2374
+					}
+					position++
+					goto l2368
+				l2374:
+					position, tokenIndex = position2368, tokenIndex2368
+					{
+						position2376, tokenIndex2376 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2377
+						}
+						position++
+						goto l2376
+					l2377:
+						position, tokenIndex = position2376, tokenIndex2376
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2375
+						}
+						position++
+					}
+				l2376:
+					goto l2368
+				l2375:
+					position, tokenIndex = position2368, tokenIndex2368
+					if buffer[position] != rune('$') {
+						goto l2378
+					}
+					position++
+					goto l2368
+				l2378:
+					position, tokenIndex = position2368, tokenIndex2368
+					if buffer[position] != rune('_') {
+						goto l2364
+					}
+					position++
+				}
+			l2368:
+			l2366:
+				{
+					position2367, tokenIndex2367 := position, tokenIndex
+					{
+						position2379, tokenIndex2379 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2380
+						}
+						position++
+						goto l2379
+					l2380:
+						position, tokenIndex = position2379, tokenIndex2379
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2381
+						}
+						position++
+						goto l2379
+					l2381:
+						position, tokenIndex = position2379, tokenIndex2379
+						{
+							position2383, tokenIndex2383 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l2384
+							}
+							position++
+							goto l2383
+						l2384:
+							position, tokenIndex = position2383, tokenIndex2383
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l2382
+							}
+							position++
+						}
+					l2383:
+						goto l2379
+					l2382:
+						position, tokenIndex = position2379, tokenIndex2379
+						if buffer[position] != rune('.') {
+							goto l2385
+						}
+						position++
+						goto l2379
+					l2385:
+						position, tokenIndex = position2379, tokenIndex2379
+						{
+							position2387, tokenIndex2387 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2388
+							}
+							position++
+							goto l2387
+						l2388:
+							position, tokenIndex = position2387, tokenIndex2387
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2386
+							}
+							position++
+						}
+					l2387:
+						goto l2379
+					l2386:
+						position, tokenIndex = position2379, tokenIndex2379
+						if buffer[position] != rune('$') {
+							goto l2389
+						}
+						position++
+						goto l2379
+					l2389:
+						position, tokenIndex = position2379, tokenIndex2379
+						if buffer[position] != rune('_') {
+							goto l2367
+						}
+						position++
+					}
+				l2379:
+					goto l2366
+				l2367:
+					position, tokenIndex = position2367, tokenIndex2367
+				}
+				add(ruleLocalSymbol, position2365)
+			}
+			return true
+		l2364:
+			position, tokenIndex = position2364, tokenIndex2364
+			return false
+		},
+		/* 87 LocalLabel <- <([0-9] ([0-9] / '$')*)> */
+		func() bool {
+			position2390, tokenIndex2390 := position, tokenIndex
+			{
+				position2391 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l2390
+				}
+				position++
+			l2392:
+				{
+					position2393, tokenIndex2393 := position, tokenIndex
+					{
+						position2394, tokenIndex2394 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2395
+						}
+						position++
+						goto l2394
+					l2395:
+						position, tokenIndex = position2394, tokenIndex2394
+						if buffer[position] != rune('$') {
+							goto l2393
+						}
+						position++
+					}
+				l2394:
+					goto l2392
+				l2393:
+					position, tokenIndex = position2393, tokenIndex2393
+				}
+				add(ruleLocalLabel, position2391)
+			}
+			return true
+		l2390:
+			position, tokenIndex = position2390, tokenIndex2390
+			return false
+		},
+		/* 88 LocalLabelRef <- <([0-9] ([0-9] / '$')* ('b' / 'f'))> */
+		func() bool {
+			position2396, tokenIndex2396 := position, tokenIndex
+			{
+				position2397 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l2396
+				}
+				position++
+			l2398:
+				{
+					position2399, tokenIndex2399 := position, tokenIndex
+					{
+						position2400, tokenIndex2400 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2401
+						}
+						position++
+						goto l2400
+					l2401:
+						position, tokenIndex = position2400, tokenIndex2400
+						if buffer[position] != rune('$') {
+							goto l2399
+						}
+						position++
+					}
+				l2400:
+					goto l2398
+				l2399:
+					position, tokenIndex = position2399, tokenIndex2399
+				}
+				{
+					position2402, tokenIndex2402 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l2403
+					}
+					position++
+					goto l2402
+				l2403:
+					position, tokenIndex = position2402, tokenIndex2402
+					if buffer[position] != rune('f') {
+						goto l2396
+					}
+					position++
+				}
+			l2402:
+				add(ruleLocalLabelRef, position2397)
+			}
+			return true
+		l2396:
+			position, tokenIndex = position2396, tokenIndex2396
+			return false
+		},
+		/* 89 Instruction <- <((EncodingHint WS?)* (InstructionPrefix WS)* InstructionName (WS InstructionArg (WS? ',' WS? InstructionArg)*)?)> */
+		func() bool {
+			position2404, tokenIndex2404 := position, tokenIndex
+			{
+				position2405 := position
+			l2406:
+				{
+					position2407, tokenIndex2407 := position, tokenIndex
+					if !_rules[ruleEncodingHint]() {
+						goto l2407
+					}
+					{
+						position2408, tokenIndex2408 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2408
+						}
+						goto l2409
+					l2408:
+						position, tokenIndex = position2408, tokenIndex2408
+					}
+				l2409:
+					goto l2406
+				l2407:
+					position, tokenIndex = position2407, tokenIndex2407
+				}
+			l2410:
+				{
+					position2411, tokenIndex2411 := position, tokenIndex
+					if !_rules[ruleInstructionPrefix]() {
+						goto l2411
+					}
+					if !_rules[ruleWS]() {
+						goto l2411
+					}
+					goto l2410
+				l2411:
+					position, tokenIndex = position2411, tokenIndex2411
+				}
+				if !_rules[ruleInstructionName]() {
+					goto l2404
+				}
+				{
+					position2412, tokenIndex2412 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l2412
+					}
+					if !_rules[ruleInstructionArg]() {
+						goto l2412
+					}
+				l2414:
+					{
+						position2415, tokenIndex2415 := position, tokenIndex
+						{
+							position2416, tokenIndex2416 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l2416
+							}
+							goto l2417
+						l2416:
+							position, tokenIndex = position2416, tokenIndex2416
+						}
+					l2417:
+						if buffer[position] != rune(',') {
+							goto l2415
+						}
+						position++
+						{
+							position2418, tokenIndex2418 := position, tokenIndex
+							if !_rules[ruleWS]() {
+								goto l2418
+							}
+							goto l2419
+						l2418:
+							position, tokenIndex = position2418, tokenIndex2418
+						}
+					l2419:
+						if !_rules[ruleInstructionArg]() {
+							goto l2415
+						}
+						goto l2414
+					l2415:
+						position, tokenIndex = position2415, tokenIndex2415
+					}
+					goto l2413
+				l2412:
+					position, tokenIndex = position2412, tokenIndex2412
+				}
+			l2413:
+				add(ruleInstruction, position2405)
+			}
+			return true
+		l2404:
+			position, tokenIndex = position2404, tokenIndex2404
+			return false
+		},
+		/* 90 EncodingHint <- <('{' ([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))* '}')> */
+		func() bool {
+			position2420, tokenIndex2420 := position, tokenIndex
+			{
+				position2421 := position
+				if buffer[position] != rune('{') {
+					goto l2420
+				}
+				position++
+				{
+					position2422, tokenIndex2422 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2423
+					}
+					position++
+					goto l2422
+				l2423:
+					position, tokenIndex = position2422, tokenIndex2422
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2420
+					}
+					position++
+				}
+			l2422:
+			l2424:
+				{
+					position2425, tokenIndex2425 := position, tokenIndex
+					{
+						position2426, tokenIndex2426 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2427
+						}
+						position++
+						goto l2426
+					l2427:
+						position, tokenIndex = position2426, tokenIndex2426
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2428
+						}
+						position++
+						goto l2426
+					l2428:
+						position, tokenIndex = position2426, tokenIndex2426
+						{
+							position2429, tokenIndex2429 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2430
+							}
+							position++
+							goto l2429
+						l2430:
+							position, tokenIndex = position2429, tokenIndex2429
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2425
+							}
+							position++
+						}
+					l2429:
+					}
+				l2426:
+					goto l2424
+				l2425:
+					position, tokenIndex = position2425, tokenIndex2425
+				}
+				if buffer[position] != rune('}') {
+					goto l2420
+				}
+				position++
+				add(ruleEncodingHint, position2421)
+			}
+			return true
+		l2420:
+			position, tokenIndex = position2420, tokenIndex2420
+			return false
+		},
+		/* 91 InstructionPrefix <- <(((('x' / 'X') ('a' / 'A') ('c' / 'C') ('q' / 'Q') ('u' / 'U') ('i' / 'I') ('r' / 'R') ('e' / 'E')) / (('x' / 'X') ('r' / 'R') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('a' / 'A') ('s' / 'S') ('e' / 'E')) / (('l' / 'L') ('o' / 'O') ('c' / 'C') ('k' / 'K')) / (('r' / 'R') ('e' / 'E') ('p' / 'P') ('n' / 'N') ('e' / 'E')) / (('r' / 'R') ('e' / 'E') ('p' / 'P') ('n' / 'N') ('z' / 'Z')) / (('r' / 'R') ('e' / 'E') ('p' / 'P') ('e' / 'E')) / (('r' / 'R') ('e' / 'E') ('p' / 'P') ('z' / 'Z')) / (('r' / 'R') ('e' / 'E') ('p' / 'P')) / (('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') '1' '6') / (('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') '3' '2') / (('a' / 'A') ('d' / 'D') ('d' / 'D') ('r' / 'R') '3' '2')) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_') &(WS InstructionName))> */
+		func() bool {
+			position2431, tokenIndex2431 := position, tokenIndex
+			{
+				position2432 := position
+				{
+					position2433, tokenIndex2433 := position, tokenIndex
+					{
+						position2435, tokenIndex2435 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l2436
+						}
+						position++
+						goto l2435
+					l2436:
+						position, tokenIndex = position2435, tokenIndex2435
+						if buffer[position] != rune('X') {
+							goto l2434
+						}
+						position++
+					}
+				l2435:
+					{
+						position2437, tokenIndex2437 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2438
+						}
+						position++
+						goto l2437
+					l2438:
+						position, tokenIndex = position2437, tokenIndex2437
+						if buffer[position] != rune('A') {
+							goto l2434
+						}
+						position++
+					}
+				l2437:
+					{
+						position2439, tokenIndex2439 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2440
+						}
+						position++
+						goto l2439
+					l2440:
+						position, tokenIndex = position2439, tokenIndex2439
+						if buffer[position] != rune('C') {
+							goto l2434
+						}
+						position++
+					}
+				l2439:
+					{
+						position2441, tokenIndex2441 := position, tokenIndex
+						if buffer[position] != rune('q') {
+							goto l2442
+						}
+						position++
+						goto l2441
+					l2442:
+						position, tokenIndex = position2441, tokenIndex2441
+						if buffer[position] != rune('Q') {
+							goto l2434
+						}
+						position++
+					}
+				l2441:
+					{
+						position2443, tokenIndex2443 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2444
+						}
+						position++
+						goto l2443
+					l2444:
+						position, tokenIndex = position2443, tokenIndex2443
+						if buffer[position] != rune('U') {
+							goto l2434
+						}
+						position++
+					}
+				l2443:
+					{
+						position2445, tokenIndex2445 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2446
+						}
+						position++
+						goto l2445
+					l2446:
+						position, tokenIndex = position2445, tokenIndex2445
+						if buffer[position] != rune('I') {
+							goto l2434
+						}
+						position++
+					}
+				l2445:
+					{
+						position2447, tokenIndex2447 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2448
+						}
+						position++
+						goto l2447
+					l2448:
+						position, tokenIndex = position2447, tokenIndex2447
+						if buffer[position] != rune('R') {
+							goto l2434
+						}
+						position++
+					}
+				l2447:
+					{
+						position2449, tokenIndex2449 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2450
+						}
+						position++
+						goto l2449
+					l2450:
+						position, tokenIndex = position2449, tokenIndex2449
+						if buffer[position] != rune('E') {
+							goto l2434
+						}
+						position++
+					}
+				l2449:
+					goto l2433
+				l2434:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2452, tokenIndex2452 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l2453
+						}
+						position++
+						goto l2452
+					l2453:
+						position, tokenIndex = position2452, tokenIndex2452
+						if buffer[position] != rune('X') {
+							goto l2451
+						}
+						position++
+					}
+				l2452:
+					{
+						position2454, tokenIndex2454 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2455
+						}
+						position++
+						goto l2454
+					l2455:
+						position, tokenIndex = position2454, tokenIndex2454
+						if buffer[position] != rune('R') {
+							goto l2451
+						}
+						position++
+					}
+				l2454:
+					{
+						position2456, tokenIndex2456 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2457
+						}
+						position++
+						goto l2456
+					l2457:
+						position, tokenIndex = position2456, tokenIndex2456
+						if buffer[position] != rune('E') {
+							goto l2451
+						}
+						position++
+					}
+				l2456:
+					{
+						position2458, tokenIndex2458 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2459
+						}
+						position++
+						goto l2458
+					l2459:
+						position, tokenIndex = position2458, tokenIndex2458
+						if buffer[position] != rune('L') {
+							goto l2451
+						}
+						position++
+					}
+				l2458:
+					{
+						position2460, tokenIndex2460 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2461
+						}
+						position++
+						goto l2460
+					l2461:
+						position, tokenIndex = position2460, tokenIndex2460
+						if buffer[position] != rune('E') {
+							goto l2451
+						}
+						position++
+					}
+				l2460:
+					{
+						position2462, tokenIndex2462 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2463
+						}
+						position++
+						goto l2462
+					l2463:
+						position, tokenIndex = position2462, tokenIndex2462
+						if buffer[position] != rune('A') {
+							goto l2451
+						}
+						position++
+					}
+				l2462:
+					{
+						position2464, tokenIndex2464 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2465
+						}
+						position++
+						goto l2464
+					l2465:
+						position, tokenIndex = position2464, tokenIndex2464
+						if buffer[position] != rune('S') {
+							goto l2451
+						}
+						position++
+					}
+				l2464:
+					{
+						position2466, tokenIndex2466 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2467
+						}
+						position++
+						goto l2466
+					l2467:
+						position, tokenIndex = position2466, tokenIndex2466
+						if buffer[position] != rune('E') {
+							goto l2451
+						}
+						position++
+					}
+				l2466:
+					goto l2433
+				l2451:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2469, tokenIndex2469 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2470
+						}
+						position++
+						goto l2469
+					l2470:
+						position, tokenIndex = position2469, tokenIndex2469
+						if buffer[position] != rune('L') {
+							goto l2468
+						}
+						position++
+					}
+				l2469:
+					{
+						position2471, tokenIndex2471 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2472
+						}
+						position++
+						goto l2471
+					l2472:
+						position, tokenIndex = position2471, tokenIndex2471
+						if buffer[position] != rune('O') {
+							goto l2468
+						}
+						position++
+					}
+				l2471:
+					{
+						position2473, tokenIndex2473 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2474
+						}
+						position++
+						goto l2473
+					l2474:
+						position, tokenIndex = position2473, tokenIndex2473
+						if buffer[position] != rune('C') {
+							goto l2468
+						}
+						position++
+					}
+				l2473:
+					{
+						position2475, tokenIndex2475 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2476
+						}
+						position++
+						goto l2475
+					l2476:
+						position, tokenIndex = position2475, tokenIndex2475
+						if buffer[position] != rune('K') {
+							goto l2468
+						}
+						position++
+					}
+				l2475:
+					goto l2433
+				l2468:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2478, tokenIndex2478 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2479
+						}
+						position++
+						goto l2478
+					l2479:
+						position, tokenIndex = position2478, tokenIndex2478
+						if buffer[position] != rune('R') {
+							goto l2477
+						}
+						position++
+					}
+				l2478:
+					{
+						position2480, tokenIndex2480 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2481
+						}
+						position++
+						goto l2480
+					l2481:
+						position, tokenIndex = position2480, tokenIndex2480
+						if buffer[position] != rune('E') {
+							goto l2477
+						}
+						position++
+					}
+				l2480:
+					{
+						position2482, tokenIndex2482 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2483
+						}
+						position++
+						goto l2482
+					l2483:
+						position, tokenIndex = position2482, tokenIndex2482
+						if buffer[position] != rune('P') {
+							goto l2477
+						}
+						position++
+					}
+				l2482:
+					{
+						position2484, tokenIndex2484 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2485
+						}
+						position++
+						goto l2484
+					l2485:
+						position, tokenIndex = position2484, tokenIndex2484
+						if buffer[position] != rune('N') {
+							goto l2477
+						}
+						position++
+					}
+				l2484:
+					{
+						position2486, tokenIndex2486 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2487
+						}
+						position++
+						goto l2486
+					l2487:
+						position, tokenIndex = position2486, tokenIndex2486
+						if buffer[position] != rune('E') {
+							goto l2477
+						}
+						position++
+					}
+				l2486:
+					goto l2433
+				l2477:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2489, tokenIndex2489 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2490
+						}
+						position++
+						goto l2489
+					l2490:
+						position, tokenIndex = position2489, tokenIndex2489
+						if buffer[position] != rune('R') {
+							goto l2488
+						}
+						position++
+					}
+				l2489:
+					{
+						position2491, tokenIndex2491 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2492
+						}
+						position++
+						goto l2491
+					l2492:
+						position, tokenIndex = position2491, tokenIndex2491
+						if buffer[position] != rune('E') {
+							goto l2488
+						}
+						position++
+					}
+				l2491:
+					{
+						position2493, tokenIndex2493 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2494
+						}
+						position++
+						goto l2493
+					l2494:
+						position, tokenIndex = position2493, tokenIndex2493
+						if buffer[position] != rune('P') {
+							goto l2488
+						}
+						position++
+					}
+				l2493:
+					{
+						position2495, tokenIndex2495 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2496
+						}
+						position++
+						goto l2495
+					l2496:
+						position, tokenIndex = position2495, tokenIndex2495
+						if buffer[position] != rune('N') {
+							goto l2488
+						}
+						position++
+					}
+				l2495:
+					{
+						position2497, tokenIndex2497 := position, tokenIndex
+						if buffer[position] != rune('z') {
+							goto l2498
+						}
+						position++
+						goto l2497
+					l2498:
+						position, tokenIndex = position2497, tokenIndex2497
+						if buffer[position] != rune('Z') {
+							goto l2488
+						}
+						position++
+					}
+				l2497:
+					goto l2433
+				l2488:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2500, tokenIndex2500 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2501
+						}
+						position++
+						goto l2500
+					l2501:
+						position, tokenIndex = position2500, tokenIndex2500
+						if buffer[position] != rune('R') {
+							goto l2499
+						}
+						position++
+					}
+				l2500:
+					{
+						position2502, tokenIndex2502 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2503
+						}
+						position++
+						goto l2502
+					l2503:
+						position, tokenIndex = position2502, tokenIndex2502
+						if buffer[position] != rune('E') {
+							goto l2499
+						}
+						position++
+					}
+				l2502:
+					{
+						position2504, tokenIndex2504 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2505
+						}
+						position++
+						goto l2504
+					l2505:
+						position, tokenIndex = position2504, tokenIndex2504
+						if buffer[position] != rune('P') {
+							goto l2499
+						}
+						position++
+					}
+				l2504:
+					{
+						position2506, tokenIndex2506 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2507
+						}
+						position++
+						goto l2506
+					l2507:
+						position, tokenIndex = position2506, tokenIndex2506
+						if buffer[position] != rune('E') {
+							goto l2499
+						}
+						position++
+					}
+				l2506:
+					goto l2433
+				l2499:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2509, tokenIndex2509 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2510
+						}
+						position++
+						goto l2509
+					l2510:
+						position, tokenIndex = position2509, tokenIndex2509
+						if buffer[position] != rune('R') {
+							goto l2508
+						}
+						position++
+					}
+				l2509:
+					{
+						position2511, tokenIndex2511 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2512
+						}
+						position++
+						goto l2511
+					l2512:
+						position, tokenIndex = position2511, tokenIndex2511
+						if buffer[position] != rune('E') {
+							goto l2508
+						}
+						position++
+					}
+				l2511:
+					{
+						position2513, tokenIndex2513 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2514
+						}
+						position++
+						goto l2513
+					l2514:
+						position, tokenIndex = position2513, tokenIndex2513
+						if buffer[position] != rune('P') {
+							goto l2508
+						}
+						position++
+					}
+				l2513:
+					{
+						position2515, tokenIndex2515 := position, tokenIndex
+						if buffer[position] != rune('z') {
+							goto l2516
+						}
+						position++
+						goto l2515
+					l2516:
+						position, tokenIndex = position2515, tokenIndex2515
+						if buffer[position] != rune('Z') {
+							goto l2508
+						}
+						position++
+					}
+				l2515:
+					goto l2433
+				l2508:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2518, tokenIndex2518 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2519
+						}
+						position++
+						goto l2518
+					l2519:
+						position, tokenIndex = position2518, tokenIndex2518
+						if buffer[position] != rune('R') {
+							goto l2517
+						}
+						position++
+					}
+				l2518:
+					{
+						position2520, tokenIndex2520 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2521
+						}
+						position++
+						goto l2520
+					l2521:
+						position, tokenIndex = position2520, tokenIndex2520
+						if buffer[position] != rune('E') {
+							goto l2517
+						}
+						position++
+					}
+				l2520:
+					{
+						position2522, tokenIndex2522 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2523
+						}
+						position++
+						goto l2522
+					l2523:
+						position, tokenIndex = position2522, tokenIndex2522
+						if buffer[position] != rune('P') {
+							goto l2517
+						}
+						position++
+					}
+				l2522:
+					goto l2433
+				l2517:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2525, tokenIndex2525 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2526
+						}
+						position++
+						goto l2525
+					l2526:
+						position, tokenIndex = position2525, tokenIndex2525
+						if buffer[position] != rune('D') {
+							goto l2524
+						}
+						position++
+					}
+				l2525:
+					{
+						position2527, tokenIndex2527 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2528
+						}
+						position++
+						goto l2527
+					l2528:
+						position, tokenIndex = position2527, tokenIndex2527
+						if buffer[position] != rune('A') {
+							goto l2524
+						}
+						position++
+					}
+				l2527:
+					{
+						position2529, tokenIndex2529 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2530
+						}
+						position++
+						goto l2529
+					l2530:
+						position, tokenIndex = position2529, tokenIndex2529
+						if buffer[position] != rune('T') {
+							goto l2524
+						}
+						position++
+					}
+				l2529:
+					{
+						position2531, tokenIndex2531 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2532
+						}
+						position++
+						goto l2531
+					l2532:
+						position, tokenIndex = position2531, tokenIndex2531
+						if buffer[position] != rune('A') {
+							goto l2524
+						}
+						position++
+					}
+				l2531:
+					if buffer[position] != rune('1') {
+						goto l2524
+					}
+					position++
+					if buffer[position] != rune('6') {
+						goto l2524
+					}
+					position++
+					goto l2433
+				l2524:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2534, tokenIndex2534 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2535
+						}
+						position++
+						goto l2534
+					l2535:
+						position, tokenIndex = position2534, tokenIndex2534
+						if buffer[position] != rune('D') {
+							goto l2533
+						}
+						position++
+					}
+				l2534:
+					{
+						position2536, tokenIndex2536 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2537
+						}
+						position++
+						goto l2536
+					l2537:
+						position, tokenIndex = position2536, tokenIndex2536
+						if buffer[position] != rune('A') {
+							goto l2533
+						}
+						position++
+					}
+				l2536:
+					{
+						position2538, tokenIndex2538 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2539
+						}
+						position++
+						goto l2538
+					l2539:
+						position, tokenIndex = position2538, tokenIndex2538
+						if buffer[position] != rune('T') {
+							goto l2533
+						}
+						position++
+					}
+				l2538:
+					{
+						position2540, tokenIndex2540 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2541
+						}
+						position++
+						goto l2540
+					l2541:
+						position, tokenIndex = position2540, tokenIndex2540
+						if buffer[position] != rune('A') {
+							goto l2533
+						}
+						position++
+					}
+				l2540:
+					if buffer[position] != rune('3') {
+						goto l2533
+					}
+					position++
+					if buffer[position] != rune('2') {
+						goto l2533
+					}
+					position++
+					goto l2433
+				l2533:
+					position, tokenIndex = position2433, tokenIndex2433
+					{
+						position2542, tokenIndex2542 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2543
+						}
+						position++
+						goto l2542
+					l2543:
+						position, tokenIndex = position2542, tokenIndex2542
+						if buffer[position] != rune('A') {
+							goto l2431
+						}
+						position++
+					}
+				l2542:
+					{
+						position2544, tokenIndex2544 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2545
+						}
+						position++
+						goto l2544
+					l2545:
+						position, tokenIndex = position2544, tokenIndex2544
+						if buffer[position] != rune('D') {
+							goto l2431
+						}
+						position++
+					}
+				l2544:
+					{
+						position2546, tokenIndex2546 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2547
+						}
+						position++
+						goto l2546
+					l2547:
+						position, tokenIndex = position2546, tokenIndex2546
+						if buffer[position] != rune('D') {
+							goto l2431
+						}
+						position++
+					}
+				l2546:
+					{
+						position2548, tokenIndex2548 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2549
+						}
+						position++
+						goto l2548
+					l2549:
+						position, tokenIndex = position2548, tokenIndex2548
+						if buffer[position] != rune('R') {
+							goto l2431
+						}
+						position++
+					}
+				l2548:
+					if buffer[position] != rune('3') {
+						goto l2431
+					}
+					position++
+					if buffer[position] != rune('2') {
+						goto l2431
+					}
+					position++
+				}
+			l2433:
+				{
+					position2550, tokenIndex2550 := position, tokenIndex
+					{
+						position2551, tokenIndex2551 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2552
+						}
+						position++
+						goto l2551
+					l2552:
+						position, tokenIndex = position2551, tokenIndex2551
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2553
+						}
+						position++
+						goto l2551
+					l2553:
+						position, tokenIndex = position2551, tokenIndex2551
+						{
+							position2555, tokenIndex2555 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2556
+							}
+							position++
+							goto l2555
+						l2556:
+							position, tokenIndex = position2555, tokenIndex2555
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2554
+							}
+							position++
+						}
+					l2555:
+						goto l2551
+					l2554:
+						position, tokenIndex = position2551, tokenIndex2551
+						if buffer[position] != rune('_') {
+							goto l2550
+						}
+						position++
+					}
+				l2551:
+					goto l2431
+				l2550:
+					position, tokenIndex = position2550, tokenIndex2550
+				}
+				position2557, tokenIndex2557 := position, tokenIndex
+				if !_rules[ruleWS]() {
+					goto l2431
+				}
+				if !_rules[ruleInstructionName]() {
+					goto l2431
+				}
+				position, tokenIndex = position2557, tokenIndex2557
+				add(ruleInstructionPrefix, position2432)
+			}
+			return true
+		l2431:
+			position, tokenIndex = position2431, tokenIndex2431
+			return false
+		},
+		/* 92 InstructionName <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / '.' / ([0-9] / [0-9]))* ('.' / '+' / '-')?)> */
+		func() bool {
+			position2558, tokenIndex2558 := position, tokenIndex
+			{
+				position2559 := position
+				{
+					position2560, tokenIndex2560 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l2561
+					}
+					position++
+					goto l2560
+				l2561:
+					position, tokenIndex = position2560, tokenIndex2560
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l2558
+					}
+					position++
+				}
+			l2560:
+			l2562:
+				{
+					position2563, tokenIndex2563 := position, tokenIndex
+					{
+						position2564, tokenIndex2564 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2565
+						}
+						position++
+						goto l2564
+					l2565:
+						position, tokenIndex = position2564, tokenIndex2564
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2566
+						}
+						position++
+						goto l2564
+					l2566:
+						position, tokenIndex = position2564, tokenIndex2564
+						if buffer[position] != rune('.') {
+							goto l2567
+						}
+						position++
+						goto l2564
+					l2567:
+						position, tokenIndex = position2564, tokenIndex2564
+						{
+							position2568, tokenIndex2568 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2569
+							}
+							position++
+							goto l2568
+						l2569:
+							position, tokenIndex = position2568, tokenIndex2568
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2563
+							}
+							position++
+						}
+					l2568:
+					}
+				l2564:
+					goto l2562
+				l2563:
+					position, tokenIndex = position2563, tokenIndex2563
+				}
+				{
+					position2570, tokenIndex2570 := position, tokenIndex
+					{
+						position2572, tokenIndex2572 := position, tokenIndex
+						if buffer[position] != rune('.') {
+							goto l2573
+						}
+						position++
+						goto l2572
+					l2573:
+						position, tokenIndex = position2572, tokenIndex2572
+						if buffer[position] != rune('+') {
+							goto l2574
+						}
+						position++
+						goto l2572
+					l2574:
+						position, tokenIndex = position2572, tokenIndex2572
+						if buffer[position] != rune('-') {
+							goto l2570
+						}
+						position++
+					}
+				l2572:
+					goto l2571
+				l2570:
+					position, tokenIndex = position2570, tokenIndex2570
+				}
+			l2571:
+				add(ruleInstructionName, position2559)
+			}
+			return true
+		l2558:
+			position, tokenIndex = position2558, tokenIndex2558
+			return false
+		},
+		/* 93 InstructionArg <- <(IndirectionIndicator? (ARMConstantTweak / ARMPrefetchOp / ARMSystemRegister / ARMLiteralPoolOperand / PPCConditionRegister / RegisterOrConstant / LocalLabelRef / TOCRefHigh / TOCRefLow / GOTLocation / GOTSymbolOffset / MemoryRef) AVX512Token*)> */
+		func() bool {
+			position2575, tokenIndex2575 := position, tokenIndex
+			{
+				position2576 := position
+				{
+					position2577, tokenIndex2577 := position, tokenIndex
+					if !_rules[ruleIndirectionIndicator]() {
+						goto l2577
+					}
+					goto l2578
+				l2577:
+					position, tokenIndex = position2577, tokenIndex2577
+				}
+			l2578:
+				{
+					position2579, tokenIndex2579 := position, tokenIndex
+					if !_rules[ruleARMConstantTweak]() {
+						goto l2580
+					}
+					goto l2579
+				l2580:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleARMPrefetchOp]() {
+						goto l2581
+					}
+					goto l2579
+				l2581:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleARMSystemRegister]() {
+						goto l2582
+					}
+					goto l2579
+				l2582:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleARMLiteralPoolOperand]() {
+						goto l2583
+					}
+					goto l2579
+				l2583:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[rulePPCConditionRegister]() {
+						goto l2584
+					}
+					goto l2579
+				l2584:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleRegisterOrConstant]() {
+						goto l2585
+					}
+					goto l2579
+				l2585:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleLocalLabelRef]() {
+						goto l2586
+					}
+					goto l2579
+				l2586:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleTOCRefHigh]() {
+						goto l2587
+					}
+					goto l2579
+				l2587:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleTOCRefLow]() {
+						goto l2588
+					}
+					goto l2579
+				l2588:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleGOTLocation]() {
+						goto l2589
+					}
+					goto l2579
+				l2589:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleGOTSymbolOffset]() {
+						goto l2590
+					}
+					goto l2579
+				l2590:
+					position, tokenIndex = position2579, tokenIndex2579
+					if !_rules[ruleMemoryRef]() {
+						goto l2575
+					}
+				}
+			l2579:
+			l2591:
+				{
+					position2592, tokenIndex2592 := position, tokenIndex
+					if !_rules[ruleAVX512Token]() {
+						goto l2592
+					}
+					goto l2591
+				l2592:
+					position, tokenIndex = position2592, tokenIndex2592
+				}
+				add(ruleInstructionArg, position2576)
+			}
+			return true
+		l2575:
+			position, tokenIndex = position2575, tokenIndex2575
+			return false
+		},
+		/* 94 GOTLocation <- <('$' '_' 'G' 'L' 'O' 'B' 'A' 'L' '_' 'O' 'F' 'F' 'S' 'E' 'T' '_' 'T' 'A' 'B' 'L' 'E' '_' ('-' LocalSymbol)?)> */
+		func() bool {
+			position2593, tokenIndex2593 := position, tokenIndex
+			{
+				position2594 := position
+				if buffer[position] != rune('$') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('_') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('G') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('L') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('O') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('B') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('A') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('L') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('_') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('O') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('F') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('F') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('S') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('E') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('T') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('_') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('T') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('A') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('B') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('L') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('E') {
+					goto l2593
+				}
+				position++
+				if buffer[position] != rune('_') {
+					goto l2593
+				}
+				position++
+				{
+					position2595, tokenIndex2595 := position, tokenIndex
+					if buffer[position] != rune('-') {
+						goto l2595
+					}
+					position++
+					if !_rules[ruleLocalSymbol]() {
+						goto l2595
+					}
+					goto l2596
+				l2595:
+					position, tokenIndex = position2595, tokenIndex2595
+				}
+			l2596:
+				add(ruleGOTLocation, position2594)
+			}
+			return true
+		l2593:
+			position, tokenIndex = position2593, tokenIndex2593
+			return false
+		},
+		/* 95 GOTSymbolOffset <- <(('$' SymbolName ('@' 'G' 'O' 'T') ('O' 'F' 'F')?) / (':' ('g' / 'G') ('o' / 'O') ('t' / 'T') ':' SymbolName))> */
+		func() bool {
+			position2597, tokenIndex2597 := position, tokenIndex
+			{
+				position2598 := position
+				{
+					position2599, tokenIndex2599 := position, tokenIndex
+					if buffer[position] != rune('$') {
+						goto l2600
+					}
+					position++
+					if !_rules[ruleSymbolName]() {
+						goto l2600
+					}
+					if buffer[position] != rune('@') {
+						goto l2600
+					}
+					position++
+					if buffer[position] != rune('G') {
+						goto l2600
+					}
+					position++
+					if buffer[position] != rune('O') {
+						goto l2600
+					}
+					position++
+					if buffer[position] != rune('T') {
+						goto l2600
+					}
+					position++
+					{
+						position2601, tokenIndex2601 := position, tokenIndex
+						if buffer[position] != rune('O') {
+							goto l2601
+						}
+						position++
+						if buffer[position] != rune('F') {
+							goto l2601
+						}
+						position++
+						if buffer[position] != rune('F') {
+							goto l2601
+						}
+						position++
+						goto l2602
+					l2601:
+						position, tokenIndex = position2601, tokenIndex2601
+					}
+				l2602:
+					goto l2599
+				l2600:
+					position, tokenIndex = position2599, tokenIndex2599
+					if buffer[position] != rune(':') {
+						goto l2597
+					}
+					position++
+					{
+						position2603, tokenIndex2603 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2604
+						}
+						position++
+						goto l2603
+					l2604:
+						position, tokenIndex = position2603, tokenIndex2603
+						if buffer[position] != rune('G') {
+							goto l2597
+						}
+						position++
+					}
+				l2603:
+					{
+						position2605, tokenIndex2605 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2606
+						}
+						position++
+						goto l2605
+					l2606:
+						position, tokenIndex = position2605, tokenIndex2605
+						if buffer[position] != rune('O') {
+							goto l2597
+						}
+						position++
+					}
+				l2605:
+					{
+						position2607, tokenIndex2607 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2608
+						}
+						position++
+						goto l2607
+					l2608:
+						position, tokenIndex = position2607, tokenIndex2607
+						if buffer[position] != rune('T') {
+							goto l2597
+						}
+						position++
+					}
+				l2607:
+					if buffer[position] != rune(':') {
+						goto l2597
+					}
+					position++
+					if !_rules[ruleSymbolName]() {
+						goto l2597
+					}
+				}
+			l2599:
+				add(ruleGOTSymbolOffset, position2598)
+			}
+			return true
+		l2597:
+			position, tokenIndex = position2597, tokenIndex2597
+			return false
+		},
+		/* 96 AVX512Token <- <(WS? '{' '%'? ([0-9] / [a-z])* '}')> */
+		func() bool {
+			position2609, tokenIndex2609 := position, tokenIndex
+			{
+				position2610 := position
+				{
+					position2611, tokenIndex2611 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l2611
+					}
+					goto l2612
+				l2611:
+					position, tokenIndex = position2611, tokenIndex2611
+				}
+			l2612:
+				if buffer[position] != rune('{') {
+					goto l2609
+				}
+				position++
+				{
+					position2613, tokenIndex2613 := position, tokenIndex
+					if buffer[position] != rune('%') {
+						goto l2613
+					}
+					position++
+					goto l2614
+				l2613:
+					position, tokenIndex = position2613, tokenIndex2613
+				}
+			l2614:
+			l2615:
+				{
+					position2616, tokenIndex2616 := position, tokenIndex
+					{
+						position2617, tokenIndex2617 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2618
+						}
+						position++
+						goto l2617
+					l2618:
+						position, tokenIndex = position2617, tokenIndex2617
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2616
+						}
+						position++
+					}
+				l2617:
+					goto l2615
+				l2616:
+					position, tokenIndex = position2616, tokenIndex2616
+				}
+				if buffer[position] != rune('}') {
+					goto l2609
+				}
+				position++
+				add(ruleAVX512Token, position2610)
+			}
+			return true
+		l2609:
+			position, tokenIndex = position2609, tokenIndex2609
+			return false
+		},
+		/* 97 TOCRefHigh <- <('.' 'T' 'O' 'C' '.' '-' (('0' 'b') / ('.' 'L' ([a-z] / [A-Z] / '_' / [0-9])+)) ('@' ('h' / 'H') ('a' / 'A')))> */
+		func() bool {
+			position2619, tokenIndex2619 := position, tokenIndex
+			{
+				position2620 := position
+				if buffer[position] != rune('.') {
+					goto l2619
+				}
+				position++
+				if buffer[position] != rune('T') {
+					goto l2619
+				}
+				position++
+				if buffer[position] != rune('O') {
+					goto l2619
+				}
+				position++
+				if buffer[position] != rune('C') {
+					goto l2619
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l2619
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l2619
+				}
+				position++
+				{
+					position2621, tokenIndex2621 := position, tokenIndex
+					if buffer[position] != rune('0') {
+						goto l2622
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l2622
+					}
+					position++
+					goto l2621
+				l2622:
+					position, tokenIndex = position2621, tokenIndex2621
+					if buffer[position] != rune('.') {
+						goto l2619
+					}
+					position++
+					if buffer[position] != rune('L') {
+						goto l2619
+					}
+					position++
+					{
+						position2625, tokenIndex2625 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2626
+						}
+						position++
+						goto l2625
+					l2626:
+						position, tokenIndex = position2625, tokenIndex2625
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2627
+						}
+						position++
+						goto l2625
+					l2627:
+						position, tokenIndex = position2625, tokenIndex2625
+						if buffer[position] != rune('_') {
+							goto l2628
+						}
+						position++
+						goto l2625
+					l2628:
+						position, tokenIndex = position2625, tokenIndex2625
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2619
+						}
+						position++
+					}
+				l2625:
+				l2623:
+					{
+						position2624, tokenIndex2624 := position, tokenIndex
+						{
+							position2629, tokenIndex2629 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l2630
+							}
+							position++
+							goto l2629
+						l2630:
+							position, tokenIndex = position2629, tokenIndex2629
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l2631
+							}
+							position++
+							goto l2629
+						l2631:
+							position, tokenIndex = position2629, tokenIndex2629
+							if buffer[position] != rune('_') {
+								goto l2632
+							}
+							position++
+							goto l2629
+						l2632:
+							position, tokenIndex = position2629, tokenIndex2629
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2624
+							}
+							position++
+						}
+					l2629:
+						goto l2623
+					l2624:
+						position, tokenIndex = position2624, tokenIndex2624
+					}
+				}
+			l2621:
+				if buffer[position] != rune('@') {
+					goto l2619
+				}
+				position++
+				{
+					position2633, tokenIndex2633 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l2634
+					}
+					position++
+					goto l2633
+				l2634:
+					position, tokenIndex = position2633, tokenIndex2633
+					if buffer[position] != rune('H') {
+						goto l2619
+					}
+					position++
+				}
+			l2633:
+				{
+					position2635, tokenIndex2635 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l2636
+					}
+					position++
+					goto l2635
+				l2636:
+					position, tokenIndex = position2635, tokenIndex2635
+					if buffer[position] != rune('A') {
+						goto l2619
+					}
+					position++
+				}
+			l2635:
+				add(ruleTOCRefHigh, position2620)
+			}
+			return true
+		l2619:
+			position, tokenIndex = position2619, tokenIndex2619
+			return false
+		},
+		/* 98 TOCRefLow <- <('.' 'T' 'O' 'C' '.' '-' (('0' 'b') / ('.' 'L' ([a-z] / [A-Z] / '_' / [0-9])+)) ('@' ('l' / 'L')))> */
+		func() bool {
+			position2637, tokenIndex2637 := position, tokenIndex
+			{
+				position2638 := position
+				if buffer[position] != rune('.') {
+					goto l2637
+				}
+				position++
+				if buffer[position] != rune('T') {
+					goto l2637
+				}
+				position++
+				if buffer[position] != rune('O') {
+					goto l2637
+				}
+				position++
+				if buffer[position] != rune('C') {
+					goto l2637
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l2637
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l2637
+				}
+				position++
+				{
+					position2639, tokenIndex2639 := position, tokenIndex
+					if buffer[position] != rune('0') {
+						goto l2640
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l2640
+					}
+					position++
+					goto l2639
+				l2640:
+					position, tokenIndex = position2639, tokenIndex2639
 					if buffer[position] != rune('.') {
-						goto l150
+						goto l2637
+					}
+					position++
+					if buffer[position] != rune('L') {
+						goto l2637
+					}
+					position++
+					{
+						position2643, tokenIndex2643 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2644
+						}
+						position++
+						goto l2643
+					l2644:
+						position, tokenIndex = position2643, tokenIndex2643
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2645
+						}
+						position++
+						goto l2643
+					l2645:
+						position, tokenIndex = position2643, tokenIndex2643
+						if buffer[position] != rune('_') {
+							goto l2646
+						}
+						position++
+						goto l2643
+					l2646:
+						position, tokenIndex = position2643, tokenIndex2643
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2637
+						}
+						position++
+					}
+				l2643:
+				l2641:
+					{
+						position2642, tokenIndex2642 := position, tokenIndex
+						{
+							position2647, tokenIndex2647 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l2648
+							}
+							position++
+							goto l2647
+						l2648:
+							position, tokenIndex = position2647, tokenIndex2647
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l2649
+							}
+							position++
+							goto l2647
+						l2649:
+							position, tokenIndex = position2647, tokenIndex2647
+							if buffer[position] != rune('_') {
+								goto l2650
+							}
+							position++
+							goto l2647
+						l2650:
+							position, tokenIndex = position2647, tokenIndex2647
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2642
+							}
+							position++
+						}
+					l2647:
+						goto l2641
+					l2642:
+						position, tokenIndex = position2642, tokenIndex2642
+					}
+				}
+			l2639:
+				if buffer[position] != rune('@') {
+					goto l2637
+				}
+				position++
+				{
+					position2651, tokenIndex2651 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l2652
+					}
+					position++
+					goto l2651
+				l2652:
+					position, tokenIndex = position2651, tokenIndex2651
+					if buffer[position] != rune('L') {
+						goto l2637
+					}
+					position++
+				}
+			l2651:
+				add(ruleTOCRefLow, position2638)
+			}
+			return true
+		l2637:
+			position, tokenIndex = position2637, tokenIndex2637
+			return false
+		},
+		/* 99 IndirectionIndicator <- <'*'> */
+		func() bool {
+			position2653, tokenIndex2653 := position, tokenIndex
+			{
+				position2654 := position
+				if buffer[position] != rune('*') {
+					goto l2653
+				}
+				position++
+				add(ruleIndirectionIndicator, position2654)
+			}
+			return true
+		l2653:
+			position, tokenIndex = position2653, tokenIndex2653
+			return false
+		},
+		/* 100 RegisterOrConstant <- <((('%' ([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))*) / ('$' Expression) / ('$'? ((Offset Offset) / Offset)) / ('#' Offset ('*' [0-9]+ ('-' [0-9] [0-9]*)?)?) / ('#' '~'? '(' [0-9] WS? ('<' '<') WS? [0-9] ')') / ARMRegister) !('f' / 'b' / ':' / '(' / '+' / '-'))> */
+		func() bool {
+			position2655, tokenIndex2655 := position, tokenIndex
+			{
+				position2656 := position
+				{
+					position2657, tokenIndex2657 := position, tokenIndex
+					if buffer[position] != rune('%') {
+						goto l2658
+					}
+					position++
+					{
+						position2659, tokenIndex2659 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2660
+						}
+						position++
+						goto l2659
+					l2660:
+						position, tokenIndex = position2659, tokenIndex2659
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2658
+						}
+						position++
+					}
+				l2659:
+				l2661:
+					{
+						position2662, tokenIndex2662 := position, tokenIndex
+						{
+							position2663, tokenIndex2663 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l2664
+							}
+							position++
+							goto l2663
+						l2664:
+							position, tokenIndex = position2663, tokenIndex2663
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l2665
+							}
+							position++
+							goto l2663
+						l2665:
+							position, tokenIndex = position2663, tokenIndex2663
+							{
+								position2666, tokenIndex2666 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l2667
+								}
+								position++
+								goto l2666
+							l2667:
+								position, tokenIndex = position2666, tokenIndex2666
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l2662
+								}
+								position++
+							}
+						l2666:
+						}
+					l2663:
+						goto l2661
+					l2662:
+						position, tokenIndex = position2662, tokenIndex2662
+					}
+					goto l2657
+				l2658:
+					position, tokenIndex = position2657, tokenIndex2657
+					if buffer[position] != rune('$') {
+						goto l2668
+					}
+					position++
+					if !_rules[ruleExpression]() {
+						goto l2668
+					}
+					goto l2657
+				l2668:
+					position, tokenIndex = position2657, tokenIndex2657
+					{
+						position2670, tokenIndex2670 := position, tokenIndex
+						if buffer[position] != rune('$') {
+							goto l2670
+						}
+						position++
+						goto l2671
+					l2670:
+						position, tokenIndex = position2670, tokenIndex2670
+					}
+				l2671:
+					{
+						position2672, tokenIndex2672 := position, tokenIndex
+						if !_rules[ruleOffset]() {
+							goto l2673
+						}
+						if !_rules[ruleOffset]() {
+							goto l2673
+						}
+						goto l2672
+					l2673:
+						position, tokenIndex = position2672, tokenIndex2672
+						if !_rules[ruleOffset]() {
+							goto l2669
+						}
+					}
+				l2672:
+					goto l2657
+				l2669:
+					position, tokenIndex = position2657, tokenIndex2657
+					if buffer[position] != rune('#') {
+						goto l2674
+					}
+					position++
+					if !_rules[ruleOffset]() {
+						goto l2674
+					}
+					{
+						position2675, tokenIndex2675 := position, tokenIndex
+						if buffer[position] != rune('*') {
+							goto l2675
+						}
+						position++
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2675
+						}
+						position++
+					l2677:
+						{
+							position2678, tokenIndex2678 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2678
+							}
+							position++
+							goto l2677
+						l2678:
+							position, tokenIndex = position2678, tokenIndex2678
+						}
+						{
+							position2679, tokenIndex2679 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l2679
+							}
+							position++
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2679
+							}
+							position++
+						l2681:
+							{
+								position2682, tokenIndex2682 := position, tokenIndex
+								if c := buffer[position]; c < rune('0') || c > rune('9') {
+									goto l2682
+								}
+								position++
+								goto l2681
+							l2682:
+								position, tokenIndex = position2682, tokenIndex2682
+							}
+							goto l2680
+						l2679:
+							position, tokenIndex = position2679, tokenIndex2679
+						}
+					l2680:
+						goto l2676
+					l2675:
+						position, tokenIndex = position2675, tokenIndex2675
+					}
+				l2676:
+					goto l2657
+				l2674:
+					position, tokenIndex = position2657, tokenIndex2657
+					if buffer[position] != rune('#') {
+						goto l2683
+					}
+					position++
+					{
+						position2684, tokenIndex2684 := position, tokenIndex
+						if buffer[position] != rune('~') {
+							goto l2684
+						}
+						position++
+						goto l2685
+					l2684:
+						position, tokenIndex = position2684, tokenIndex2684
+					}
+				l2685:
+					if buffer[position] != rune('(') {
+						goto l2683
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2683
+					}
+					position++
+					{
+						position2686, tokenIndex2686 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2686
+						}
+						goto l2687
+					l2686:
+						position, tokenIndex = position2686, tokenIndex2686
+					}
+				l2687:
+					if buffer[position] != rune('<') {
+						goto l2683
+					}
+					position++
+					if buffer[position] != rune('<') {
+						goto l2683
+					}
+					position++
+					{
+						position2688, tokenIndex2688 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2688
+						}
+						goto l2689
+					l2688:
+						position, tokenIndex = position2688, tokenIndex2688
+					}
+				l2689:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2683
+					}
+					position++
+					if buffer[position] != rune(')') {
+						goto l2683
+					}
+					position++
+					goto l2657
+				l2683:
+					position, tokenIndex = position2657, tokenIndex2657
+					if !_rules[ruleARMRegister]() {
+						goto l2655
+					}
+				}
+			l2657:
+				{
+					position2690, tokenIndex2690 := position, tokenIndex
+					{
+						position2691, tokenIndex2691 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2692
+						}
+						position++
+						goto l2691
+					l2692:
+						position, tokenIndex = position2691, tokenIndex2691
+						if buffer[position] != rune('b') {
+							goto l2693
+						}
+						position++
+						goto l2691
+					l2693:
+						position, tokenIndex = position2691, tokenIndex2691
+						if buffer[position] != rune(':') {
+							goto l2694
+						}
+						position++
+						goto l2691
+					l2694:
+						position, tokenIndex = position2691, tokenIndex2691
+						if buffer[position] != rune('(') {
+							goto l2695
+						}
+						position++
+						goto l2691
+					l2695:
+						position, tokenIndex = position2691, tokenIndex2691
+						if buffer[position] != rune('+') {
+							goto l2696
+						}
+						position++
+						goto l2691
+					l2696:
+						position, tokenIndex = position2691, tokenIndex2691
+						if buffer[position] != rune('-') {
+							goto l2690
+						}
+						position++
+					}
+				l2691:
+					goto l2655
+				l2690:
+					position, tokenIndex = position2690, tokenIndex2690
+				}
+				add(ruleRegisterOrConstant, position2656)
+			}
+			return true
+		l2655:
+			position, tokenIndex = position2655, tokenIndex2655
+			return false
+		},
+		/* 101 ARMConstantTweak <- <(((('l' / 'L') ('s' / 'S') ('l' / 'L')) / (('s' / 'S') ('x' / 'X') ('t' / 'T') ('w' / 'W')) / (('u' / 'U') ('x' / 'X') ('t' / 'T') ('w' / 'W')) / (('u' / 'U') ('x' / 'X') ('t' / 'T') ('b' / 'B')) / (('l' / 'L') ('s' / 'S') ('r' / 'R')) / (('r' / 'R') ('o' / 'O') ('r' / 'R')) / (('a' / 'A') ('s' / 'S') ('r' / 'R'))) (WS '#' Offset)?)> */
+		func() bool {
+			position2697, tokenIndex2697 := position, tokenIndex
+			{
+				position2698 := position
+				{
+					position2699, tokenIndex2699 := position, tokenIndex
+					{
+						position2701, tokenIndex2701 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2702
+						}
+						position++
+						goto l2701
+					l2702:
+						position, tokenIndex = position2701, tokenIndex2701
+						if buffer[position] != rune('L') {
+							goto l2700
+						}
+						position++
+					}
+				l2701:
+					{
+						position2703, tokenIndex2703 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2704
+						}
+						position++
+						goto l2703
+					l2704:
+						position, tokenIndex = position2703, tokenIndex2703
+						if buffer[position] != rune('S') {
+							goto l2700
+						}
+						position++
+					}
+				l2703:
+					{
+						position2705, tokenIndex2705 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2706
+						}
+						position++
+						goto l2705
+					l2706:
+						position, tokenIndex = position2705, tokenIndex2705
+						if buffer[position] != rune('L') {
+							goto l2700
+						}
+						position++
+					}
+				l2705:
+					goto l2699
+				l2700:
+					position, tokenIndex = position2699, tokenIndex2699
+					{
+						position2708, tokenIndex2708 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2709
+						}
+						position++
+						goto l2708
+					l2709:
+						position, tokenIndex = position2708, tokenIndex2708
+						if buffer[position] != rune('S') {
+							goto l2707
+						}
+						position++
+					}
+				l2708:
+					{
+						position2710, tokenIndex2710 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l2711
+						}
+						position++
+						goto l2710
+					l2711:
+						position, tokenIndex = position2710, tokenIndex2710
+						if buffer[position] != rune('X') {
+							goto l2707
+						}
+						position++
+					}
+				l2710:
+					{
+						position2712, tokenIndex2712 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2713
+						}
+						position++
+						goto l2712
+					l2713:
+						position, tokenIndex = position2712, tokenIndex2712
+						if buffer[position] != rune('T') {
+							goto l2707
+						}
+						position++
+					}
+				l2712:
+					{
+						position2714, tokenIndex2714 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2715
+						}
+						position++
+						goto l2714
+					l2715:
+						position, tokenIndex = position2714, tokenIndex2714
+						if buffer[position] != rune('W') {
+							goto l2707
+						}
+						position++
+					}
+				l2714:
+					goto l2699
+				l2707:
+					position, tokenIndex = position2699, tokenIndex2699
+					{
+						position2717, tokenIndex2717 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2718
+						}
+						position++
+						goto l2717
+					l2718:
+						position, tokenIndex = position2717, tokenIndex2717
+						if buffer[position] != rune('U') {
+							goto l2716
+						}
+						position++
+					}
+				l2717:
+					{
+						position2719, tokenIndex2719 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l2720
+						}
+						position++
+						goto l2719
+					l2720:
+						position, tokenIndex = position2719, tokenIndex2719
+						if buffer[position] != rune('X') {
+							goto l2716
+						}
+						position++
+					}
+				l2719:
+					{
+						position2721, tokenIndex2721 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2722
+						}
+						position++
+						goto l2721
+					l2722:
+						position, tokenIndex = position2721, tokenIndex2721
+						if buffer[position] != rune('T') {
+							goto l2716
+						}
+						position++
+					}
+				l2721:
+					{
+						position2723, tokenIndex2723 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2724
+						}
+						position++
+						goto l2723
+					l2724:
+						position, tokenIndex = position2723, tokenIndex2723
+						if buffer[position] != rune('W') {
+							goto l2716
+						}
+						position++
+					}
+				l2723:
+					goto l2699
+				l2716:
+					position, tokenIndex = position2699, tokenIndex2699
+					{
+						position2726, tokenIndex2726 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2727
+						}
+						position++
+						goto l2726
+					l2727:
+						position, tokenIndex = position2726, tokenIndex2726
+						if buffer[position] != rune('U') {
+							goto l2725
+						}
+						position++
+					}
+				l2726:
+					{
+						position2728, tokenIndex2728 := position, tokenIndex
+						if buffer[position] != rune('x') {
+							goto l2729
+						}
+						position++
+						goto l2728
+					l2729:
+						position, tokenIndex = position2728, tokenIndex2728
+						if buffer[position] != rune('X') {
+							goto l2725
+						}
+						position++
+					}
+				l2728:
+					{
+						position2730, tokenIndex2730 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2731
+						}
+						position++
+						goto l2730
+					l2731:
+						position, tokenIndex = position2730, tokenIndex2730
+						if buffer[position] != rune('T') {
+							goto l2725
+						}
+						position++
+					}
+				l2730:
+					{
+						position2732, tokenIndex2732 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2733
+						}
+						position++
+						goto l2732
+					l2733:
+						position, tokenIndex = position2732, tokenIndex2732
+						if buffer[position] != rune('B') {
+							goto l2725
+						}
+						position++
+					}
+				l2732:
+					goto l2699
+				l2725:
+					position, tokenIndex = position2699, tokenIndex2699
+					{
+						position2735, tokenIndex2735 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2736
+						}
+						position++
+						goto l2735
+					l2736:
+						position, tokenIndex = position2735, tokenIndex2735
+						if buffer[position] != rune('L') {
+							goto l2734
+						}
+						position++
+					}
+				l2735:
+					{
+						position2737, tokenIndex2737 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2738
+						}
+						position++
+						goto l2737
+					l2738:
+						position, tokenIndex = position2737, tokenIndex2737
+						if buffer[position] != rune('S') {
+							goto l2734
+						}
+						position++
+					}
+				l2737:
+					{
+						position2739, tokenIndex2739 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2740
+						}
+						position++
+						goto l2739
+					l2740:
+						position, tokenIndex = position2739, tokenIndex2739
+						if buffer[position] != rune('R') {
+							goto l2734
+						}
+						position++
+					}
+				l2739:
+					goto l2699
+				l2734:
+					position, tokenIndex = position2699, tokenIndex2699
+					{
+						position2742, tokenIndex2742 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2743
+						}
+						position++
+						goto l2742
+					l2743:
+						position, tokenIndex = position2742, tokenIndex2742
+						if buffer[position] != rune('R') {
+							goto l2741
+						}
+						position++
+					}
+				l2742:
+					{
+						position2744, tokenIndex2744 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2745
+						}
+						position++
+						goto l2744
+					l2745:
+						position, tokenIndex = position2744, tokenIndex2744
+						if buffer[position] != rune('O') {
+							goto l2741
+						}
+						position++
+					}
+				l2744:
+					{
+						position2746, tokenIndex2746 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2747
+						}
+						position++
+						goto l2746
+					l2747:
+						position, tokenIndex = position2746, tokenIndex2746
+						if buffer[position] != rune('R') {
+							goto l2741
+						}
+						position++
+					}
+				l2746:
+					goto l2699
+				l2741:
+					position, tokenIndex = position2699, tokenIndex2699
+					{
+						position2748, tokenIndex2748 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2749
+						}
+						position++
+						goto l2748
+					l2749:
+						position, tokenIndex = position2748, tokenIndex2748
+						if buffer[position] != rune('A') {
+							goto l2697
+						}
+						position++
+					}
+				l2748:
+					{
+						position2750, tokenIndex2750 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2751
+						}
+						position++
+						goto l2750
+					l2751:
+						position, tokenIndex = position2750, tokenIndex2750
+						if buffer[position] != rune('S') {
+							goto l2697
+						}
+						position++
+					}
+				l2750:
+					{
+						position2752, tokenIndex2752 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2753
+						}
+						position++
+						goto l2752
+					l2753:
+						position, tokenIndex = position2752, tokenIndex2752
+						if buffer[position] != rune('R') {
+							goto l2697
+						}
+						position++
+					}
+				l2752:
+				}
+			l2699:
+				{
+					position2754, tokenIndex2754 := position, tokenIndex
+					if !_rules[ruleWS]() {
+						goto l2754
+					}
+					if buffer[position] != rune('#') {
+						goto l2754
+					}
+					position++
+					if !_rules[ruleOffset]() {
+						goto l2754
+					}
+					goto l2755
+				l2754:
+					position, tokenIndex = position2754, tokenIndex2754
+				}
+			l2755:
+				add(ruleARMConstantTweak, position2698)
+			}
+			return true
+		l2697:
+			position, tokenIndex = position2697, tokenIndex2697
+			return false
+		},
+		/* 102 PPCConditionRegister <- <((('4' WS? '*' WS?) / &{p.PPC64LE}) (('c' / 'C') ('r' / 'R')) [0-7] (WS? '+' WS? ((('l' / 'L') ('t' / 'T')) / (('g' / 'G') ('t' / 'T')) / (('e' / 'E') ('q' / 'Q')) / (('s' / 'S') ('o' / 'O')) / (('u' / 'U') ('n' / 'N'))))? !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position2756, tokenIndex2756 := position, tokenIndex
+			{
+				position2757 := position
+				{
+					position2758, tokenIndex2758 := position, tokenIndex
+					if buffer[position] != rune('4') {
+						goto l2759
+					}
+					position++
+					{
+						position2760, tokenIndex2760 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2760
+						}
+						goto l2761
+					l2760:
+						position, tokenIndex = position2760, tokenIndex2760
+					}
+				l2761:
+					if buffer[position] != rune('*') {
+						goto l2759
+					}
+					position++
+					{
+						position2762, tokenIndex2762 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2762
+						}
+						goto l2763
+					l2762:
+						position, tokenIndex = position2762, tokenIndex2762
+					}
+				l2763:
+					goto l2758
+				l2759:
+					position, tokenIndex = position2758, tokenIndex2758
+					if !(p.PPC64LE) {
+						goto l2756
+					}
+				}
+			l2758:
+				{
+					position2764, tokenIndex2764 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l2765
+					}
+					position++
+					goto l2764
+				l2765:
+					position, tokenIndex = position2764, tokenIndex2764
+					if buffer[position] != rune('C') {
+						goto l2756
+					}
+					position++
+				}
+			l2764:
+				{
+					position2766, tokenIndex2766 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l2767
+					}
+					position++
+					goto l2766
+				l2767:
+					position, tokenIndex = position2766, tokenIndex2766
+					if buffer[position] != rune('R') {
+						goto l2756
+					}
+					position++
+				}
+			l2766:
+				if c := buffer[position]; c < rune('0') || c > rune('7') {
+					goto l2756
+				}
+				position++
+				{
+					position2768, tokenIndex2768 := position, tokenIndex
+					{
+						position2770, tokenIndex2770 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2770
+						}
+						goto l2771
+					l2770:
+						position, tokenIndex = position2770, tokenIndex2770
+					}
+				l2771:
+					if buffer[position] != rune('+') {
+						goto l2768
+					}
+					position++
+					{
+						position2772, tokenIndex2772 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l2772
+						}
+						goto l2773
+					l2772:
+						position, tokenIndex = position2772, tokenIndex2772
+					}
+				l2773:
+					{
+						position2774, tokenIndex2774 := position, tokenIndex
+						{
+							position2776, tokenIndex2776 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l2777
+							}
+							position++
+							goto l2776
+						l2777:
+							position, tokenIndex = position2776, tokenIndex2776
+							if buffer[position] != rune('L') {
+								goto l2775
+							}
+							position++
+						}
+					l2776:
+						{
+							position2778, tokenIndex2778 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l2779
+							}
+							position++
+							goto l2778
+						l2779:
+							position, tokenIndex = position2778, tokenIndex2778
+							if buffer[position] != rune('T') {
+								goto l2775
+							}
+							position++
+						}
+					l2778:
+						goto l2774
+					l2775:
+						position, tokenIndex = position2774, tokenIndex2774
+						{
+							position2781, tokenIndex2781 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l2782
+							}
+							position++
+							goto l2781
+						l2782:
+							position, tokenIndex = position2781, tokenIndex2781
+							if buffer[position] != rune('G') {
+								goto l2780
+							}
+							position++
+						}
+					l2781:
+						{
+							position2783, tokenIndex2783 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l2784
+							}
+							position++
+							goto l2783
+						l2784:
+							position, tokenIndex = position2783, tokenIndex2783
+							if buffer[position] != rune('T') {
+								goto l2780
+							}
+							position++
+						}
+					l2783:
+						goto l2774
+					l2780:
+						position, tokenIndex = position2774, tokenIndex2774
+						{
+							position2786, tokenIndex2786 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l2787
+							}
+							position++
+							goto l2786
+						l2787:
+							position, tokenIndex = position2786, tokenIndex2786
+							if buffer[position] != rune('E') {
+								goto l2785
+							}
+							position++
+						}
+					l2786:
+						{
+							position2788, tokenIndex2788 := position, tokenIndex
+							if buffer[position] != rune('q') {
+								goto l2789
+							}
+							position++
+							goto l2788
+						l2789:
+							position, tokenIndex = position2788, tokenIndex2788
+							if buffer[position] != rune('Q') {
+								goto l2785
+							}
+							position++
+						}
+					l2788:
+						goto l2774
+					l2785:
+						position, tokenIndex = position2774, tokenIndex2774
+						{
+							position2791, tokenIndex2791 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l2792
+							}
+							position++
+							goto l2791
+						l2792:
+							position, tokenIndex = position2791, tokenIndex2791
+							if buffer[position] != rune('S') {
+								goto l2790
+							}
+							position++
+						}
+					l2791:
+						{
+							position2793, tokenIndex2793 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l2794
+							}
+							position++
+							goto l2793
+						l2794:
+							position, tokenIndex = position2793, tokenIndex2793
+							if buffer[position] != rune('O') {
+								goto l2790
+							}
+							position++
+						}
+					l2793:
+						goto l2774
+					l2790:
+						position, tokenIndex = position2774, tokenIndex2774
+						{
+							position2795, tokenIndex2795 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l2796
+							}
+							position++
+							goto l2795
+						l2796:
+							position, tokenIndex = position2795, tokenIndex2795
+							if buffer[position] != rune('U') {
+								goto l2768
+							}
+							position++
+						}
+					l2795:
+						{
+							position2797, tokenIndex2797 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l2798
+							}
+							position++
+							goto l2797
+						l2798:
+							position, tokenIndex = position2797, tokenIndex2797
+							if buffer[position] != rune('N') {
+								goto l2768
+							}
+							position++
+						}
+					l2797:
+					}
+				l2774:
+					goto l2769
+				l2768:
+					position, tokenIndex = position2768, tokenIndex2768
+				}
+			l2769:
+				{
+					position2799, tokenIndex2799 := position, tokenIndex
+					{
+						position2800, tokenIndex2800 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2801
+						}
+						position++
+						goto l2800
+					l2801:
+						position, tokenIndex = position2800, tokenIndex2800
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2802
+						}
+						position++
+						goto l2800
+					l2802:
+						position, tokenIndex = position2800, tokenIndex2800
+						{
+							position2804, tokenIndex2804 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2805
+							}
+							position++
+							goto l2804
+						l2805:
+							position, tokenIndex = position2804, tokenIndex2804
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2803
+							}
+							position++
+						}
+					l2804:
+						goto l2800
+					l2803:
+						position, tokenIndex = position2800, tokenIndex2800
+						if buffer[position] != rune('_') {
+							goto l2799
+						}
+						position++
+					}
+				l2800:
+					goto l2756
+				l2799:
+					position, tokenIndex = position2799, tokenIndex2799
+				}
+				add(rulePPCConditionRegister, position2757)
+			}
+			return true
+		l2756:
+			position, tokenIndex = position2756, tokenIndex2756
+			return false
+		},
+		/* 103 ARMPrefetchOp <- <(((('p' / 'P') ('l' / 'L') ('d' / 'D')) / (('p' / 'P') ('l' / 'L') ('i' / 'I')) / (('p' / 'P') ('s' / 'S') ('t' / 'T'))) ((('l' / 'L') '1') / (('l' / 'L') '2') / (('l' / 'L') '3')) ((('k' / 'K') ('e' / 'E') ('e' / 'E') ('p' / 'P')) / (('s' / 'S') ('t' / 'T') ('r' / 'R') ('m' / 'M'))) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_'))> */
+		func() bool {
+			position2806, tokenIndex2806 := position, tokenIndex
+			{
+				position2807 := position
+				{
+					position2808, tokenIndex2808 := position, tokenIndex
+					{
+						position2810, tokenIndex2810 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2811
+						}
+						position++
+						goto l2810
+					l2811:
+						position, tokenIndex = position2810, tokenIndex2810
+						if buffer[position] != rune('P') {
+							goto l2809
+						}
+						position++
+					}
+				l2810:
+					{
+						position2812, tokenIndex2812 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2813
+						}
+						position++
+						goto l2812
+					l2813:
+						position, tokenIndex = position2812, tokenIndex2812
+						if buffer[position] != rune('L') {
+							goto l2809
+						}
+						position++
+					}
+				l2812:
+					{
+						position2814, tokenIndex2814 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2815
+						}
+						position++
+						goto l2814
+					l2815:
+						position, tokenIndex = position2814, tokenIndex2814
+						if buffer[position] != rune('D') {
+							goto l2809
+						}
+						position++
+					}
+				l2814:
+					goto l2808
+				l2809:
+					position, tokenIndex = position2808, tokenIndex2808
+					{
+						position2817, tokenIndex2817 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2818
+						}
+						position++
+						goto l2817
+					l2818:
+						position, tokenIndex = position2817, tokenIndex2817
+						if buffer[position] != rune('P') {
+							goto l2816
+						}
+						position++
+					}
+				l2817:
+					{
+						position2819, tokenIndex2819 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2820
+						}
+						position++
+						goto l2819
+					l2820:
+						position, tokenIndex = position2819, tokenIndex2819
+						if buffer[position] != rune('L') {
+							goto l2816
+						}
+						position++
+					}
+				l2819:
+					{
+						position2821, tokenIndex2821 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2822
+						}
+						position++
+						goto l2821
+					l2822:
+						position, tokenIndex = position2821, tokenIndex2821
+						if buffer[position] != rune('I') {
+							goto l2816
+						}
+						position++
+					}
+				l2821:
+					goto l2808
+				l2816:
+					position, tokenIndex = position2808, tokenIndex2808
+					{
+						position2823, tokenIndex2823 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2824
+						}
+						position++
+						goto l2823
+					l2824:
+						position, tokenIndex = position2823, tokenIndex2823
+						if buffer[position] != rune('P') {
+							goto l2806
+						}
+						position++
+					}
+				l2823:
+					{
+						position2825, tokenIndex2825 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2826
+						}
+						position++
+						goto l2825
+					l2826:
+						position, tokenIndex = position2825, tokenIndex2825
+						if buffer[position] != rune('S') {
+							goto l2806
+						}
+						position++
+					}
+				l2825:
+					{
+						position2827, tokenIndex2827 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2828
+						}
+						position++
+						goto l2827
+					l2828:
+						position, tokenIndex = position2827, tokenIndex2827
+						if buffer[position] != rune('T') {
+							goto l2806
+						}
+						position++
+					}
+				l2827:
+				}
+			l2808:
+				{
+					position2829, tokenIndex2829 := position, tokenIndex
+					{
+						position2831, tokenIndex2831 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2832
+						}
+						position++
+						goto l2831
+					l2832:
+						position, tokenIndex = position2831, tokenIndex2831
+						if buffer[position] != rune('L') {
+							goto l2830
+						}
+						position++
+					}
+				l2831:
+					if buffer[position] != rune('1') {
+						goto l2830
+					}
+					position++
+					goto l2829
+				l2830:
+					position, tokenIndex = position2829, tokenIndex2829
+					{
+						position2834, tokenIndex2834 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2835
+						}
+						position++
+						goto l2834
+					l2835:
+						position, tokenIndex = position2834, tokenIndex2834
+						if buffer[position] != rune('L') {
+							goto l2833
+						}
+						position++
+					}
+				l2834:
+					if buffer[position] != rune('2') {
+						goto l2833
+					}
+					position++
+					goto l2829
+				l2833:
+					position, tokenIndex = position2829, tokenIndex2829
+					{
+						position2836, tokenIndex2836 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2837
+						}
+						position++
+						goto l2836
+					l2837:
+						position, tokenIndex = position2836, tokenIndex2836
+						if buffer[position] != rune('L') {
+							goto l2806
+						}
+						position++
+					}
+				l2836:
+					if buffer[position] != rune('3') {
+						goto l2806
+					}
+					position++
+				}
+			l2829:
+				{
+					position2838, tokenIndex2838 := position, tokenIndex
+					{
+						position2840, tokenIndex2840 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2841
+						}
+						position++
+						goto l2840
+					l2841:
+						position, tokenIndex = position2840, tokenIndex2840
+						if buffer[position] != rune('K') {
+							goto l2839
+						}
+						position++
+					}
+				l2840:
+					{
+						position2842, tokenIndex2842 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2843
+						}
+						position++
+						goto l2842
+					l2843:
+						position, tokenIndex = position2842, tokenIndex2842
+						if buffer[position] != rune('E') {
+							goto l2839
+						}
+						position++
+					}
+				l2842:
+					{
+						position2844, tokenIndex2844 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2845
+						}
+						position++
+						goto l2844
+					l2845:
+						position, tokenIndex = position2844, tokenIndex2844
+						if buffer[position] != rune('E') {
+							goto l2839
+						}
+						position++
 					}
-					position++
+				l2844:
 					{
-						position151, tokenIndex151 := position, tokenIndex
-						if buffer[position] != rune('x') {
-							goto l152
+						position2846, tokenIndex2846 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2847
 						}
 						position++
-						goto l151
-					l152:
-						position, tokenIndex = position151, tokenIndex151
-						if buffer[position] != rune('X') {
-							goto l150
+						goto l2846
+					l2847:
+						position, tokenIndex = position2846, tokenIndex2846
+						if buffer[position] != rune('P') {
+							goto l2839
 						}
 						position++
 					}
-				l151:
+				l2846:
+					goto l2838
+				l2839:
+					position, tokenIndex = position2838, tokenIndex2838
 					{
-						position153, tokenIndex153 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l154
+						position2848, tokenIndex2848 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2849
 						}
 						position++
-						goto l153
-					l154:
-						position, tokenIndex = position153, tokenIndex153
-						if buffer[position] != rune('W') {
-							goto l150
+						goto l2848
+					l2849:
+						position, tokenIndex = position2848, tokenIndex2848
+						if buffer[position] != rune('S') {
+							goto l2806
 						}
 						position++
 					}
-				l153:
+				l2848:
 					{
-						position155, tokenIndex155 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l156
+						position2850, tokenIndex2850 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2851
 						}
 						position++
-						goto l155
-					l156:
-						position, tokenIndex = position155, tokenIndex155
-						if buffer[position] != rune('O') {
-							goto l150
+						goto l2850
+					l2851:
+						position, tokenIndex = position2850, tokenIndex2850
+						if buffer[position] != rune('T') {
+							goto l2806
 						}
 						position++
 					}
-				l155:
+				l2850:
 					{
-						position157, tokenIndex157 := position, tokenIndex
+						position2852, tokenIndex2852 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l158
+							goto l2853
 						}
 						position++
-						goto l157
-					l158:
-						position, tokenIndex = position157, tokenIndex157
+						goto l2852
+					l2853:
+						position, tokenIndex = position2852, tokenIndex2852
 						if buffer[position] != rune('R') {
-							goto l150
+							goto l2806
 						}
 						position++
 					}
-				l157:
+				l2852:
 					{
-						position159, tokenIndex159 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l160
+						position2854, tokenIndex2854 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2855
 						}
 						position++
-						goto l159
-					l160:
-						position, tokenIndex = position159, tokenIndex159
-						if buffer[position] != rune('D') {
-							goto l150
+						goto l2854
+					l2855:
+						position, tokenIndex = position2854, tokenIndex2854
+						if buffer[position] != rune('M') {
+							goto l2806
 						}
 						position++
 					}
-				l159:
-					goto l149
-				l150:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l161
+				l2854:
+				}
+			l2838:
+				{
+					position2856, tokenIndex2856 := position, tokenIndex
+					{
+						position2857, tokenIndex2857 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l2858
+						}
+						position++
+						goto l2857
+					l2858:
+						position, tokenIndex = position2857, tokenIndex2857
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l2859
+						}
+						position++
+						goto l2857
+					l2859:
+						position, tokenIndex = position2857, tokenIndex2857
+						{
+							position2861, tokenIndex2861 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2862
+							}
+							position++
+							goto l2861
+						l2862:
+							position, tokenIndex = position2861, tokenIndex2861
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l2860
+							}
+							position++
+						}
+					l2861:
+						goto l2857
+					l2860:
+						position, tokenIndex = position2857, tokenIndex2857
+						if buffer[position] != rune('_') {
+							goto l2856
+						}
+						position++
 					}
-					position++
+				l2857:
+					goto l2806
+				l2856:
+					position, tokenIndex = position2856, tokenIndex2856
+				}
+				add(ruleARMPrefetchOp, position2807)
+			}
+			return true
+		l2806:
+			position, tokenIndex = position2806, tokenIndex2806
+			return false
+		},
+		/* 104 ARMSystemRegister <- <(((('t' / 'T') ('p' / 'P') ('i' / 'I') ('d' / 'D') ('r' / 'R') '_' ('e' / 'E') ('l' / 'L') '0') / (('t' / 'T') ('p' / 'P') ('i' / 'I') ('d' / 'D') ('r' / 'R') ('r' / 'R') ('o' / 'O') '_' ('e' / 'E') ('l' / 'L') '0') / (('t' / 'T') ('p' / 'P') ('i' / 'I') ('d' / 'D') ('r' / 'R') '_' ('e' / 'E') ('l' / 'L') '1') / (('c' / 'C') ('n' / 'N') ('t' / 'T') ('v' / 'V') ('c' / 'C') ('t' / 'T') '_' ('e' / 'E') ('l' / 'L') '0') / (('c' / 'C') ('n' / 'N') ('t' / 'T') ('f' / 'F') ('r' / 'R') ('q' / 'Q') '_' ('e' / 'E') ('l' / 'L') '0') / (('c' / 'C') ('t' / 'T') ('r' / 'R') '_' ('e' / 'E') ('l' / 'L') '0') / (('d' / 'D') ('c' / 'C') ('z' / 'Z') ('i' / 'I') ('d' / 'D') '_' ('e' / 'E') ('l' / 'L') '0') / (('m' / 'M') ('i' / 'I') ('d' / 'D') ('r' / 'R') '_' ('e' / 'E') ('l' / 'L') '1') / (('m' / 'M') ('p' / 'P') ('i' / 'I') ('d' / 'D') ('r' / 'R') '_' ('e' / 'E') ('l' / 'L') '1') / (('i' / 'I') ('d' / 'D') '_' ('a' / 'A') ('a' / 'A') '6' '4' ('i' / 'I') ('s' / 'S') ('a' / 'A') ('r' / 'R') '0' '_' ('e' / 'E') ('l' / 'L') '1') / (('i' / 'I') ('d' / 'D') '_' ('a' / 'A') ('a' / 'A') '6' '4' ('i' / 'I') ('s' / 'S') ('a' / 'A') ('r' / 'R') '1' '_' ('e' / 'E') ('l' / 'L') '1') / (('i' / 'I') ('d' / 'D') '_' ('a' / 'A') ('a' / 'A') '6' '4' ('p' / 'P') ('f' / 'F') ('r' / 'R') '0' '_' ('e' / 'E') ('l' / 'L') '1') / (('i' / 'I') ('d' / 'D') '_' ('a' / 'A') ('a' / 'A') '6' '4' ('m' / 'M') ('m' / 'M') ('f' / 'F') ('r' / 'R') '0' '_' ('e' / 'E') ('l' / 'L') '1') / (('n' / 'N') ('z' / 'Z') ('c' / 'C') ('v' / 'V')) / (('d' / 'D') ('a' / 'A') ('i' / 'I') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T')) / (('d' / 'D') ('a' / 'A') ('i' / 'I') ('f' / 'F') ('c' / 'C') ('l' / 'L') ('r' / 'R')) / (('d' / 'D') ('a' / 'A') ('i' / 'I') ('f' / 'F')) / (('f' / 'F') ('p' / 'P') ('c' / 'C') ('r' / 'R')) / (('f' / 'F') ('p' / 'P') ('s' / 'S') ('r' / 'R')) / (('s' / 'S') ('p' / 'P') ('s' / 'S') ('e' / 'E') ('l' / 'L')) / (('c' / 'C') ('u' / 'U') ('r' / 'R') ('r' / 'R') ('e' / 'E') ('n' / 'N') ('t' / 'T') ('e' / 'E') ('l' / 'L')) / ('s' [0-3] '_' [0-7] ('_' ('c' / 'C')) [0-9] [0-9]? ('_' ('c' / 'C')) [0-9] [0-9]? '_' [0-7])) !([a-z] / [A-Z] / ([0-9] / [0-9]) / '_' / '(' / '+' / '-' / '@'))> */
+		func() bool {
+			position2863, tokenIndex2863 := position, tokenIndex
+			{
+				position2864 := position
+				{
+					position2865, tokenIndex2865 := position, tokenIndex
 					{
-						position162, tokenIndex162 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l163
+						position2867, tokenIndex2867 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2868
 						}
 						position++
-						goto l162
-					l163:
-						position, tokenIndex = position162, tokenIndex162
-						if buffer[position] != rune('W') {
-							goto l161
+						goto l2867
+					l2868:
+						position, tokenIndex = position2867, tokenIndex2867
+						if buffer[position] != rune('T') {
+							goto l2866
 						}
 						position++
 					}
-				l162:
+				l2867:
 					{
-						position164, tokenIndex164 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l165
+						position2869, tokenIndex2869 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2870
 						}
 						position++
-						goto l164
-					l165:
-						position, tokenIndex = position164, tokenIndex164
-						if buffer[position] != rune('O') {
-							goto l161
+						goto l2869
+					l2870:
+						position, tokenIndex = position2869, tokenIndex2869
+						if buffer[position] != rune('P') {
+							goto l2866
 						}
 						position++
 					}
-				l164:
+				l2869:
 					{
-						position166, tokenIndex166 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l167
+						position2871, tokenIndex2871 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2872
 						}
 						position++
-						goto l166
-					l167:
-						position, tokenIndex = position166, tokenIndex166
-						if buffer[position] != rune('R') {
-							goto l161
+						goto l2871
+					l2872:
+						position, tokenIndex = position2871, tokenIndex2871
+						if buffer[position] != rune('I') {
+							goto l2866
 						}
 						position++
 					}
-				l166:
+				l2871:
 					{
-						position168, tokenIndex168 := position, tokenIndex
+						position2873, tokenIndex2873 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l169
+							goto l2874
 						}
 						position++
-						goto l168
-					l169:
-						position, tokenIndex = position168, tokenIndex168
+						goto l2873
+					l2874:
+						position, tokenIndex = position2873, tokenIndex2873
 						if buffer[position] != rune('D') {
-							goto l161
+							goto l2866
 						}
 						position++
 					}
-				l168:
-					goto l149
-				l161:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l170
+				l2873:
+					{
+						position2875, tokenIndex2875 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2876
+						}
+						position++
+						goto l2875
+					l2876:
+						position, tokenIndex = position2875, tokenIndex2875
+						if buffer[position] != rune('R') {
+							goto l2866
+						}
+						position++
+					}
+				l2875:
+					if buffer[position] != rune('_') {
+						goto l2866
 					}
 					position++
 					{
-						position171, tokenIndex171 := position, tokenIndex
+						position2877, tokenIndex2877 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2878
+						}
+						position++
+						goto l2877
+					l2878:
+						position, tokenIndex = position2877, tokenIndex2877
+						if buffer[position] != rune('E') {
+							goto l2866
+						}
+						position++
+					}
+				l2877:
+					{
+						position2879, tokenIndex2879 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l172
+							goto l2880
 						}
 						position++
-						goto l171
-					l172:
-						position, tokenIndex = position171, tokenIndex171
+						goto l2879
+					l2880:
+						position, tokenIndex = position2879, tokenIndex2879
 						if buffer[position] != rune('L') {
-							goto l170
+							goto l2866
 						}
 						position++
 					}
-				l171:
+				l2879:
+					if buffer[position] != rune('0') {
+						goto l2866
+					}
+					position++
+					goto l2865
+				l2866:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position173, tokenIndex173 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l174
+						position2882, tokenIndex2882 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2883
 						}
 						position++
-						goto l173
-					l174:
-						position, tokenIndex = position173, tokenIndex173
-						if buffer[position] != rune('O') {
-							goto l170
+						goto l2882
+					l2883:
+						position, tokenIndex = position2882, tokenIndex2882
+						if buffer[position] != rune('T') {
+							goto l2881
 						}
 						position++
 					}
-				l173:
+				l2882:
 					{
-						position175, tokenIndex175 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l176
+						position2884, tokenIndex2884 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2885
 						}
 						position++
-						goto l175
-					l176:
-						position, tokenIndex = position175, tokenIndex175
-						if buffer[position] != rune('N') {
-							goto l170
+						goto l2884
+					l2885:
+						position, tokenIndex = position2884, tokenIndex2884
+						if buffer[position] != rune('P') {
+							goto l2881
 						}
 						position++
 					}
-				l175:
+				l2884:
 					{
-						position177, tokenIndex177 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l178
+						position2886, tokenIndex2886 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2887
 						}
 						position++
-						goto l177
-					l178:
-						position, tokenIndex = position177, tokenIndex177
-						if buffer[position] != rune('G') {
-							goto l170
+						goto l2886
+					l2887:
+						position, tokenIndex = position2886, tokenIndex2886
+						if buffer[position] != rune('I') {
+							goto l2881
 						}
 						position++
 					}
-				l177:
-					goto l149
-				l170:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l179
-					}
-					position++
+				l2886:
 					{
-						position180, tokenIndex180 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l181
+						position2888, tokenIndex2888 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2889
 						}
 						position++
-						goto l180
-					l181:
-						position, tokenIndex = position180, tokenIndex180
-						if buffer[position] != rune('S') {
-							goto l179
+						goto l2888
+					l2889:
+						position, tokenIndex = position2888, tokenIndex2888
+						if buffer[position] != rune('D') {
+							goto l2881
 						}
 						position++
 					}
-				l180:
+				l2888:
 					{
-						position182, tokenIndex182 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l183
+						position2890, tokenIndex2890 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2891
 						}
 						position++
-						goto l182
-					l183:
-						position, tokenIndex = position182, tokenIndex182
-						if buffer[position] != rune('E') {
-							goto l179
+						goto l2890
+					l2891:
+						position, tokenIndex = position2890, tokenIndex2890
+						if buffer[position] != rune('R') {
+							goto l2881
 						}
 						position++
 					}
-				l182:
+				l2890:
 					{
-						position184, tokenIndex184 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l185
+						position2892, tokenIndex2892 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2893
 						}
 						position++
-						goto l184
-					l185:
-						position, tokenIndex = position184, tokenIndex184
-						if buffer[position] != rune('T') {
-							goto l179
+						goto l2892
+					l2893:
+						position, tokenIndex = position2892, tokenIndex2892
+						if buffer[position] != rune('R') {
+							goto l2881
 						}
 						position++
 					}
-				l184:
-					goto l149
-				l179:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l186
+				l2892:
+					{
+						position2894, tokenIndex2894 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2895
+						}
+						position++
+						goto l2894
+					l2895:
+						position, tokenIndex = position2894, tokenIndex2894
+						if buffer[position] != rune('O') {
+							goto l2881
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('8') {
-						goto l186
+				l2894:
+					if buffer[position] != rune('_') {
+						goto l2881
 					}
 					position++
 					{
-						position187, tokenIndex187 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l188
+						position2896, tokenIndex2896 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2897
 						}
 						position++
-						goto l187
-					l188:
-						position, tokenIndex = position187, tokenIndex187
-						if buffer[position] != rune('B') {
-							goto l186
+						goto l2896
+					l2897:
+						position, tokenIndex = position2896, tokenIndex2896
+						if buffer[position] != rune('E') {
+							goto l2881
 						}
 						position++
 					}
-				l187:
+				l2896:
 					{
-						position189, tokenIndex189 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l190
+						position2898, tokenIndex2898 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2899
 						}
 						position++
-						goto l189
-					l190:
-						position, tokenIndex = position189, tokenIndex189
-						if buffer[position] != rune('Y') {
-							goto l186
+						goto l2898
+					l2899:
+						position, tokenIndex = position2898, tokenIndex2898
+						if buffer[position] != rune('L') {
+							goto l2881
 						}
 						position++
 					}
-				l189:
+				l2898:
+					if buffer[position] != rune('0') {
+						goto l2881
+					}
+					position++
+					goto l2865
+				l2881:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position191, tokenIndex191 := position, tokenIndex
+						position2901, tokenIndex2901 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l192
+							goto l2902
 						}
 						position++
-						goto l191
-					l192:
-						position, tokenIndex = position191, tokenIndex191
+						goto l2901
+					l2902:
+						position, tokenIndex = position2901, tokenIndex2901
 						if buffer[position] != rune('T') {
-							goto l186
+							goto l2900
 						}
 						position++
 					}
-				l191:
+				l2901:
 					{
-						position193, tokenIndex193 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l194
+						position2903, tokenIndex2903 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2904
 						}
 						position++
-						goto l193
-					l194:
-						position, tokenIndex = position193, tokenIndex193
-						if buffer[position] != rune('E') {
-							goto l186
+						goto l2903
+					l2904:
+						position, tokenIndex = position2903, tokenIndex2903
+						if buffer[position] != rune('P') {
+							goto l2900
 						}
 						position++
 					}
-				l193:
-					goto l149
-				l186:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l195
-					}
-					position++
-					if buffer[position] != rune('4') {
-						goto l195
-					}
-					position++
+				l2903:
 					{
-						position196, tokenIndex196 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l197
+						position2905, tokenIndex2905 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2906
 						}
 						position++
-						goto l196
-					l197:
-						position, tokenIndex = position196, tokenIndex196
-						if buffer[position] != rune('B') {
-							goto l195
+						goto l2905
+					l2906:
+						position, tokenIndex = position2905, tokenIndex2905
+						if buffer[position] != rune('I') {
+							goto l2900
 						}
 						position++
 					}
-				l196:
+				l2905:
 					{
-						position198, tokenIndex198 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l199
+						position2907, tokenIndex2907 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2908
 						}
 						position++
-						goto l198
-					l199:
-						position, tokenIndex = position198, tokenIndex198
-						if buffer[position] != rune('Y') {
-							goto l195
+						goto l2907
+					l2908:
+						position, tokenIndex = position2907, tokenIndex2907
+						if buffer[position] != rune('D') {
+							goto l2900
 						}
 						position++
 					}
-				l198:
+				l2907:
 					{
-						position200, tokenIndex200 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l201
+						position2909, tokenIndex2909 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2910
 						}
 						position++
-						goto l200
-					l201:
-						position, tokenIndex = position200, tokenIndex200
-						if buffer[position] != rune('T') {
-							goto l195
+						goto l2909
+					l2910:
+						position, tokenIndex = position2909, tokenIndex2909
+						if buffer[position] != rune('R') {
+							goto l2900
 						}
 						position++
 					}
-				l200:
+				l2909:
+					if buffer[position] != rune('_') {
+						goto l2900
+					}
+					position++
 					{
-						position202, tokenIndex202 := position, tokenIndex
+						position2911, tokenIndex2911 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l203
+							goto l2912
 						}
 						position++
-						goto l202
-					l203:
-						position, tokenIndex = position202, tokenIndex202
+						goto l2911
+					l2912:
+						position, tokenIndex = position2911, tokenIndex2911
 						if buffer[position] != rune('E') {
-							goto l195
+							goto l2900
 						}
 						position++
 					}
-				l202:
-					goto l149
-				l195:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l204
+				l2911:
+					{
+						position2913, tokenIndex2913 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2914
+						}
+						position++
+						goto l2913
+					l2914:
+						position, tokenIndex = position2913, tokenIndex2913
+						if buffer[position] != rune('L') {
+							goto l2900
+						}
+						position++
+					}
+				l2913:
+					if buffer[position] != rune('1') {
+						goto l2900
 					}
 					position++
+					goto l2865
+				l2900:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position205, tokenIndex205 := position, tokenIndex
-						if buffer[position] != rune('q') {
-							goto l206
+						position2916, tokenIndex2916 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2917
 						}
 						position++
-						goto l205
-					l206:
-						position, tokenIndex = position205, tokenIndex205
-						if buffer[position] != rune('Q') {
-							goto l204
+						goto l2916
+					l2917:
+						position, tokenIndex = position2916, tokenIndex2916
+						if buffer[position] != rune('C') {
+							goto l2915
 						}
 						position++
 					}
-				l205:
+				l2916:
 					{
-						position207, tokenIndex207 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l208
+						position2918, tokenIndex2918 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2919
 						}
 						position++
-						goto l207
-					l208:
-						position, tokenIndex = position207, tokenIndex207
-						if buffer[position] != rune('U') {
-							goto l204
+						goto l2918
+					l2919:
+						position, tokenIndex = position2918, tokenIndex2918
+						if buffer[position] != rune('N') {
+							goto l2915
 						}
 						position++
 					}
-				l207:
+				l2918:
 					{
-						position209, tokenIndex209 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l210
+						position2920, tokenIndex2920 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2921
 						}
 						position++
-						goto l209
-					l210:
-						position, tokenIndex = position209, tokenIndex209
-						if buffer[position] != rune('A') {
-							goto l204
+						goto l2920
+					l2921:
+						position, tokenIndex = position2920, tokenIndex2920
+						if buffer[position] != rune('T') {
+							goto l2915
 						}
 						position++
 					}
-				l209:
+				l2920:
 					{
-						position211, tokenIndex211 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l212
+						position2922, tokenIndex2922 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l2923
 						}
 						position++
-						goto l211
-					l212:
-						position, tokenIndex = position211, tokenIndex211
-						if buffer[position] != rune('D') {
-							goto l204
+						goto l2922
+					l2923:
+						position, tokenIndex = position2922, tokenIndex2922
+						if buffer[position] != rune('V') {
+							goto l2915
 						}
 						position++
 					}
-				l211:
-					goto l149
-				l204:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l213
+				l2922:
+					{
+						position2924, tokenIndex2924 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2925
+						}
+						position++
+						goto l2924
+					l2925:
+						position, tokenIndex = position2924, tokenIndex2924
+						if buffer[position] != rune('C') {
+							goto l2915
+						}
+						position++
 					}
-					position++
+				l2924:
 					{
-						position214, tokenIndex214 := position, tokenIndex
+						position2926, tokenIndex2926 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l215
+							goto l2927
 						}
 						position++
-						goto l214
-					l215:
-						position, tokenIndex = position214, tokenIndex214
+						goto l2926
+					l2927:
+						position, tokenIndex = position2926, tokenIndex2926
 						if buffer[position] != rune('T') {
-							goto l213
+							goto l2915
 						}
 						position++
 					}
-				l214:
+				l2926:
+					if buffer[position] != rune('_') {
+						goto l2915
+					}
+					position++
 					{
-						position216, tokenIndex216 := position, tokenIndex
+						position2928, tokenIndex2928 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2929
+						}
+						position++
+						goto l2928
+					l2929:
+						position, tokenIndex = position2928, tokenIndex2928
+						if buffer[position] != rune('E') {
+							goto l2915
+						}
+						position++
+					}
+				l2928:
+					{
+						position2930, tokenIndex2930 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2931
+						}
+						position++
+						goto l2930
+					l2931:
+						position, tokenIndex = position2930, tokenIndex2930
+						if buffer[position] != rune('L') {
+							goto l2915
+						}
+						position++
+					}
+				l2930:
+					if buffer[position] != rune('0') {
+						goto l2915
+					}
+					position++
+					goto l2865
+				l2915:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position2933, tokenIndex2933 := position, tokenIndex
 						if buffer[position] != rune('c') {
-							goto l217
+							goto l2934
 						}
 						position++
-						goto l216
-					l217:
-						position, tokenIndex = position216, tokenIndex216
+						goto l2933
+					l2934:
+						position, tokenIndex = position2933, tokenIndex2933
 						if buffer[position] != rune('C') {
-							goto l213
+							goto l2932
 						}
 						position++
 					}
-				l216:
-					goto l149
-				l213:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l218
-					}
-					position++
+				l2933:
 					{
-						position219, tokenIndex219 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l220
+						position2935, tokenIndex2935 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2936
 						}
 						position++
-						goto l219
-					l220:
-						position, tokenIndex = position219, tokenIndex219
-						if buffer[position] != rune('L') {
-							goto l218
+						goto l2935
+					l2936:
+						position, tokenIndex = position2935, tokenIndex2935
+						if buffer[position] != rune('N') {
+							goto l2932
 						}
 						position++
 					}
-				l219:
+				l2935:
 					{
-						position221, tokenIndex221 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l222
+						position2937, tokenIndex2937 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2938
 						}
 						position++
-						goto l221
-					l222:
-						position, tokenIndex = position221, tokenIndex221
-						if buffer[position] != rune('O') {
-							goto l218
+						goto l2937
+					l2938:
+						position, tokenIndex = position2937, tokenIndex2937
+						if buffer[position] != rune('T') {
+							goto l2932
 						}
 						position++
 					}
-				l221:
+				l2937:
 					{
-						position223, tokenIndex223 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l224
+						position2939, tokenIndex2939 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2940
 						}
 						position++
-						goto l223
-					l224:
-						position, tokenIndex = position223, tokenIndex223
-						if buffer[position] != rune('C') {
-							goto l218
+						goto l2939
+					l2940:
+						position, tokenIndex = position2939, tokenIndex2939
+						if buffer[position] != rune('F') {
+							goto l2932
 						}
 						position++
 					}
-				l223:
+				l2939:
 					{
-						position225, tokenIndex225 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l226
+						position2941, tokenIndex2941 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2942
 						}
 						position++
-						goto l225
-					l226:
-						position, tokenIndex = position225, tokenIndex225
-						if buffer[position] != rune('A') {
-							goto l218
+						goto l2941
+					l2942:
+						position, tokenIndex = position2941, tokenIndex2941
+						if buffer[position] != rune('R') {
+							goto l2932
 						}
 						position++
 					}
-				l225:
+				l2941:
 					{
-						position227, tokenIndex227 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l228
+						position2943, tokenIndex2943 := position, tokenIndex
+						if buffer[position] != rune('q') {
+							goto l2944
 						}
 						position++
-						goto l227
-					l228:
-						position, tokenIndex = position227, tokenIndex227
-						if buffer[position] != rune('L') {
-							goto l218
+						goto l2943
+					l2944:
+						position, tokenIndex = position2943, tokenIndex2943
+						if buffer[position] != rune('Q') {
+							goto l2932
 						}
 						position++
 					}
-				l227:
+				l2943:
+					if buffer[position] != rune('_') {
+						goto l2932
+					}
+					position++
 					{
-						position229, tokenIndex229 := position, tokenIndex
+						position2945, tokenIndex2945 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l230
+							goto l2946
 						}
 						position++
-						goto l229
-					l230:
-						position, tokenIndex = position229, tokenIndex229
+						goto l2945
+					l2946:
+						position, tokenIndex = position2945, tokenIndex2945
 						if buffer[position] != rune('E') {
-							goto l218
+							goto l2932
 						}
 						position++
 					}
-				l229:
+				l2945:
 					{
-						position231, tokenIndex231 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l232
+						position2947, tokenIndex2947 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2948
 						}
 						position++
-						goto l231
-					l232:
-						position, tokenIndex = position231, tokenIndex231
-						if buffer[position] != rune('N') {
-							goto l218
+						goto l2947
+					l2948:
+						position, tokenIndex = position2947, tokenIndex2947
+						if buffer[position] != rune('L') {
+							goto l2932
 						}
 						position++
 					}
-				l231:
+				l2947:
+					if buffer[position] != rune('0') {
+						goto l2932
+					}
+					position++
+					goto l2865
+				l2932:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position2950, tokenIndex2950 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2951
+						}
+						position++
+						goto l2950
+					l2951:
+						position, tokenIndex = position2950, tokenIndex2950
+						if buffer[position] != rune('C') {
+							goto l2949
+						}
+						position++
+					}
+				l2950:
 					{
-						position233, tokenIndex233 := position, tokenIndex
+						position2952, tokenIndex2952 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l234
+							goto l2953
 						}
 						position++
-						goto l233
-					l234:
-						position, tokenIndex = position233, tokenIndex233
+						goto l2952
+					l2953:
+						position, tokenIndex = position2952, tokenIndex2952
 						if buffer[position] != rune('T') {
-							goto l218
+							goto l2949
 						}
 						position++
 					}
-				l233:
+				l2952:
 					{
-						position235, tokenIndex235 := position, tokenIndex
+						position2954, tokenIndex2954 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l236
+							goto l2955
 						}
 						position++
-						goto l235
-					l236:
-						position, tokenIndex = position235, tokenIndex235
+						goto l2954
+					l2955:
+						position, tokenIndex = position2954, tokenIndex2954
 						if buffer[position] != rune('R') {
-							goto l218
+							goto l2949
 						}
 						position++
 					}
-				l235:
+				l2954:
+					if buffer[position] != rune('_') {
+						goto l2949
+					}
+					position++
 					{
-						position237, tokenIndex237 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l238
+						position2956, tokenIndex2956 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2957
 						}
 						position++
-						goto l237
-					l238:
-						position, tokenIndex = position237, tokenIndex237
-						if buffer[position] != rune('Y') {
-							goto l218
+						goto l2956
+					l2957:
+						position, tokenIndex = position2956, tokenIndex2956
+						if buffer[position] != rune('E') {
+							goto l2949
 						}
 						position++
 					}
-				l237:
-					goto l149
-				l218:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l239
+				l2956:
+					{
+						position2958, tokenIndex2958 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2959
+						}
+						position++
+						goto l2958
+					l2959:
+						position, tokenIndex = position2958, tokenIndex2958
+						if buffer[position] != rune('L') {
+							goto l2949
+						}
+						position++
+					}
+				l2958:
+					if buffer[position] != rune('0') {
+						goto l2949
 					}
 					position++
+					goto l2865
+				l2949:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position240, tokenIndex240 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l241
+						position2961, tokenIndex2961 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2962
 						}
 						position++
-						goto l240
-					l241:
-						position, tokenIndex = position240, tokenIndex240
-						if buffer[position] != rune('S') {
-							goto l239
+						goto l2961
+					l2962:
+						position, tokenIndex = position2961, tokenIndex2961
+						if buffer[position] != rune('D') {
+							goto l2960
 						}
 						position++
 					}
-				l240:
+				l2961:
 					{
-						position242, tokenIndex242 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l243
+						position2963, tokenIndex2963 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2964
 						}
 						position++
-						goto l242
-					l243:
-						position, tokenIndex = position242, tokenIndex242
-						if buffer[position] != rune('I') {
-							goto l239
+						goto l2963
+					l2964:
+						position, tokenIndex = position2963, tokenIndex2963
+						if buffer[position] != rune('C') {
+							goto l2960
 						}
 						position++
 					}
-				l242:
+				l2963:
 					{
-						position244, tokenIndex244 := position, tokenIndex
+						position2965, tokenIndex2965 := position, tokenIndex
 						if buffer[position] != rune('z') {
-							goto l245
+							goto l2966
 						}
 						position++
-						goto l244
-					l245:
-						position, tokenIndex = position244, tokenIndex244
+						goto l2965
+					l2966:
+						position, tokenIndex = position2965, tokenIndex2965
 						if buffer[position] != rune('Z') {
-							goto l239
+							goto l2960
 						}
 						position++
 					}
-				l244:
+				l2965:
 					{
-						position246, tokenIndex246 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l247
+						position2967, tokenIndex2967 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2968
 						}
 						position++
-						goto l246
-					l247:
-						position, tokenIndex = position246, tokenIndex246
-						if buffer[position] != rune('E') {
-							goto l239
+						goto l2967
+					l2968:
+						position, tokenIndex = position2967, tokenIndex2967
+						if buffer[position] != rune('I') {
+							goto l2960
 						}
 						position++
 					}
-				l246:
-					goto l149
-				l239:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l248
+				l2967:
+					{
+						position2969, tokenIndex2969 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2970
+						}
+						position++
+						goto l2969
+					l2970:
+						position, tokenIndex = position2969, tokenIndex2969
+						if buffer[position] != rune('D') {
+							goto l2960
+						}
+						position++
+					}
+				l2969:
+					if buffer[position] != rune('_') {
+						goto l2960
 					}
 					position++
 					{
-						position249, tokenIndex249 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l250
+						position2971, tokenIndex2971 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2972
 						}
 						position++
-						goto l249
-					l250:
-						position, tokenIndex = position249, tokenIndex249
-						if buffer[position] != rune('T') {
-							goto l248
+						goto l2971
+					l2972:
+						position, tokenIndex = position2971, tokenIndex2971
+						if buffer[position] != rune('E') {
+							goto l2960
 						}
 						position++
 					}
-				l249:
+				l2971:
 					{
-						position251, tokenIndex251 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l252
+						position2973, tokenIndex2973 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2974
 						}
 						position++
-						goto l251
-					l252:
-						position, tokenIndex = position251, tokenIndex251
-						if buffer[position] != rune('Y') {
-							goto l248
+						goto l2973
+					l2974:
+						position, tokenIndex = position2973, tokenIndex2973
+						if buffer[position] != rune('L') {
+							goto l2960
 						}
 						position++
 					}
-				l251:
+				l2973:
+					if buffer[position] != rune('0') {
+						goto l2960
+					}
+					position++
+					goto l2865
+				l2960:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position253, tokenIndex253 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l254
+						position2976, tokenIndex2976 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2977
 						}
 						position++
-						goto l253
-					l254:
-						position, tokenIndex = position253, tokenIndex253
-						if buffer[position] != rune('P') {
-							goto l248
+						goto l2976
+					l2977:
+						position, tokenIndex = position2976, tokenIndex2976
+						if buffer[position] != rune('M') {
+							goto l2975
 						}
 						position++
 					}
-				l253:
+				l2976:
 					{
-						position255, tokenIndex255 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l256
+						position2978, tokenIndex2978 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2979
 						}
 						position++
-						goto l255
-					l256:
-						position, tokenIndex = position255, tokenIndex255
-						if buffer[position] != rune('E') {
-							goto l248
+						goto l2978
+					l2979:
+						position, tokenIndex = position2978, tokenIndex2978
+						if buffer[position] != rune('I') {
+							goto l2975
 						}
 						position++
 					}
-				l255:
-					goto l149
-				l248:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l257
-					}
-					position++
+				l2978:
 					{
-						position258, tokenIndex258 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l259
+						position2980, tokenIndex2980 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2981
 						}
 						position++
-						goto l258
-					l259:
-						position, tokenIndex = position258, tokenIndex258
-						if buffer[position] != rune('U') {
-							goto l257
+						goto l2980
+					l2981:
+						position, tokenIndex = position2980, tokenIndex2980
+						if buffer[position] != rune('D') {
+							goto l2975
 						}
 						position++
 					}
-				l258:
+				l2980:
 					{
-						position260, tokenIndex260 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l261
+						position2982, tokenIndex2982 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2983
 						}
 						position++
-						goto l260
-					l261:
-						position, tokenIndex = position260, tokenIndex260
-						if buffer[position] != rune('L') {
-							goto l257
+						goto l2982
+					l2983:
+						position, tokenIndex = position2982, tokenIndex2982
+						if buffer[position] != rune('R') {
+							goto l2975
 						}
 						position++
 					}
-				l260:
+				l2982:
+					if buffer[position] != rune('_') {
+						goto l2975
+					}
+					position++
 					{
-						position262, tokenIndex262 := position, tokenIndex
+						position2984, tokenIndex2984 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l263
+							goto l2985
 						}
 						position++
-						goto l262
-					l263:
-						position, tokenIndex = position262, tokenIndex262
+						goto l2984
+					l2985:
+						position, tokenIndex = position2984, tokenIndex2984
 						if buffer[position] != rune('E') {
-							goto l257
+							goto l2975
 						}
 						position++
 					}
-				l262:
+				l2984:
 					{
-						position264, tokenIndex264 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l265
+						position2986, tokenIndex2986 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2987
 						}
 						position++
-						goto l264
-					l265:
-						position, tokenIndex = position264, tokenIndex264
-						if buffer[position] != rune('B') {
-							goto l257
+						goto l2986
+					l2987:
+						position, tokenIndex = position2986, tokenIndex2986
+						if buffer[position] != rune('L') {
+							goto l2975
 						}
 						position++
 					}
-				l264:
+				l2986:
 					if buffer[position] != rune('1') {
-						goto l257
-					}
-					position++
-					if buffer[position] != rune('2') {
-						goto l257
-					}
-					position++
-					if buffer[position] != rune('8') {
-						goto l257
-					}
-					position++
-					goto l149
-				l257:
-					position, tokenIndex = position149, tokenIndex149
-					if buffer[position] != rune('.') {
-						goto l147
+						goto l2975
 					}
 					position++
+					goto l2865
+				l2975:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position266, tokenIndex266 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l267
+						position2989, tokenIndex2989 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2990
 						}
 						position++
-						goto l266
-					l267:
-						position, tokenIndex = position266, tokenIndex266
-						if buffer[position] != rune('S') {
-							goto l147
+						goto l2989
+					l2990:
+						position, tokenIndex = position2989, tokenIndex2989
+						if buffer[position] != rune('M') {
+							goto l2988
 						}
 						position++
 					}
-				l266:
+				l2989:
 					{
-						position268, tokenIndex268 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l269
+						position2991, tokenIndex2991 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2992
 						}
 						position++
-						goto l268
-					l269:
-						position, tokenIndex = position268, tokenIndex268
-						if buffer[position] != rune('L') {
-							goto l147
+						goto l2991
+					l2992:
+						position, tokenIndex = position2991, tokenIndex2991
+						if buffer[position] != rune('P') {
+							goto l2988
 						}
 						position++
 					}
-				l268:
+				l2991:
 					{
-						position270, tokenIndex270 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l271
+						position2993, tokenIndex2993 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2994
 						}
 						position++
-						goto l270
-					l271:
-						position, tokenIndex = position270, tokenIndex270
-						if buffer[position] != rune('E') {
-							goto l147
+						goto l2993
+					l2994:
+						position, tokenIndex = position2993, tokenIndex2993
+						if buffer[position] != rune('I') {
+							goto l2988
 						}
 						position++
 					}
-				l270:
+				l2993:
 					{
-						position272, tokenIndex272 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l273
+						position2995, tokenIndex2995 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2996
 						}
 						position++
-						goto l272
-					l273:
-						position, tokenIndex = position272, tokenIndex272
-						if buffer[position] != rune('B') {
-							goto l147
+						goto l2995
+					l2996:
+						position, tokenIndex = position2995, tokenIndex2995
+						if buffer[position] != rune('D') {
+							goto l2988
 						}
 						position++
 					}
-				l272:
-					if buffer[position] != rune('1') {
-						goto l147
-					}
-					position++
-					if buffer[position] != rune('2') {
-						goto l147
-					}
-					position++
-					if buffer[position] != rune('8') {
-						goto l147
-					}
-					position++
-				}
-			l149:
-				add(ruleLabelContainingDirectiveName, position148)
-			}
-			return true
-		l147:
-			position, tokenIndex = position147, tokenIndex147
-			return false
-		},
-		/* 14 SymbolArgs <- <(SymbolArg (WS? ',' WS? SymbolArg)*)> */
-		func() bool {
-			position274, tokenIndex274 := position, tokenIndex
-			{
-				position275 := position
-				if !_rules[ruleSymbolArg]() {
-					goto l274
-				}
-			l276:
-				{
-					position277, tokenIndex277 := position, tokenIndex
+				l2995:
 					{
-						position278, tokenIndex278 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l278
+						position2997, tokenIndex2997 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2998
 						}
-						goto l279
-					l278:
-						position, tokenIndex = position278, tokenIndex278
-					}
-				l279:
-					if buffer[position] != rune(',') {
-						goto l277
-					}
-					position++
-					{
-						position280, tokenIndex280 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l280
+						position++
+						goto l2997
+					l2998:
+						position, tokenIndex = position2997, tokenIndex2997
+						if buffer[position] != rune('R') {
+							goto l2988
 						}
-						goto l281
-					l280:
-						position, tokenIndex = position280, tokenIndex280
-					}
-				l281:
-					if !_rules[ruleSymbolArg]() {
-						goto l277
-					}
-					goto l276
-				l277:
-					position, tokenIndex = position277, tokenIndex277
-				}
-				add(ruleSymbolArgs, position275)
-			}
-			return true
-		l274:
-			position, tokenIndex = position274, tokenIndex274
-			return false
-		},
-		/* 15 SymbolArg <- <(Offset / SymbolType / ((Offset / LocalSymbol / SymbolName / Dot) WS? Operator WS? (Offset / LocalSymbol / SymbolName)) / (LocalSymbol TCMarker?) / (SymbolName Offset) / (SymbolName TCMarker?))> */
-		func() bool {
-			position282, tokenIndex282 := position, tokenIndex
-			{
-				position283 := position
-				{
-					position284, tokenIndex284 := position, tokenIndex
-					if !_rules[ruleOffset]() {
-						goto l285
+						position++
 					}
-					goto l284
-				l285:
-					position, tokenIndex = position284, tokenIndex284
-					if !_rules[ruleSymbolType]() {
-						goto l286
+				l2997:
+					if buffer[position] != rune('_') {
+						goto l2988
 					}
-					goto l284
-				l286:
-					position, tokenIndex = position284, tokenIndex284
+					position++
 					{
-						position288, tokenIndex288 := position, tokenIndex
-						if !_rules[ruleOffset]() {
-							goto l289
-						}
-						goto l288
-					l289:
-						position, tokenIndex = position288, tokenIndex288
-						if !_rules[ruleLocalSymbol]() {
-							goto l290
-						}
-						goto l288
-					l290:
-						position, tokenIndex = position288, tokenIndex288
-						if !_rules[ruleSymbolName]() {
-							goto l291
+						position2999, tokenIndex2999 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3000
 						}
-						goto l288
-					l291:
-						position, tokenIndex = position288, tokenIndex288
-						if !_rules[ruleDot]() {
-							goto l287
+						position++
+						goto l2999
+					l3000:
+						position, tokenIndex = position2999, tokenIndex2999
+						if buffer[position] != rune('E') {
+							goto l2988
 						}
+						position++
 					}
-				l288:
+				l2999:
 					{
-						position292, tokenIndex292 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l292
+						position3001, tokenIndex3001 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l3002
 						}
-						goto l293
-					l292:
-						position, tokenIndex = position292, tokenIndex292
+						position++
+						goto l3001
+					l3002:
+						position, tokenIndex = position3001, tokenIndex3001
+						if buffer[position] != rune('L') {
+							goto l2988
+						}
+						position++
 					}
-				l293:
-					if !_rules[ruleOperator]() {
-						goto l287
+				l3001:
+					if buffer[position] != rune('1') {
+						goto l2988
 					}
+					position++
+					goto l2865
+				l2988:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position294, tokenIndex294 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l294
+						position3004, tokenIndex3004 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3005
 						}
-						goto l295
-					l294:
-						position, tokenIndex = position294, tokenIndex294
+						position++
+						goto l3004
+					l3005:
+						position, tokenIndex = position3004, tokenIndex3004
+						if buffer[position] != rune('I') {
+							goto l3003
+						}
+						position++
 					}
-				l295:
+				l3004:
 					{
-						position296, tokenIndex296 := position, tokenIndex
-						if !_rules[ruleOffset]() {
-							goto l297
-						}
-						goto l296
-					l297:
-						position, tokenIndex = position296, tokenIndex296
-						if !_rules[ruleLocalSymbol]() {
-							goto l298
+						position3006, tokenIndex3006 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l3007
 						}
-						goto l296
-					l298:
-						position, tokenIndex = position296, tokenIndex296
-						if !_rules[ruleSymbolName]() {
-							goto l287
+						position++
+						goto l3006
+					l3007:
+						position, tokenIndex = position3006, tokenIndex3006
+						if buffer[position] != rune('D') {
+							goto l3003
 						}
+						position++
 					}
-				l296:
-					goto l284
-				l287:
-					position, tokenIndex = position284, tokenIndex284
-					if !_rules[ruleLocalSymbol]() {
-						goto l299
+				l3006:
+					if buffer[position] != rune('_') {
+						goto l3003
 					}
+					position++
 					{
-						position300, tokenIndex300 := position, tokenIndex
-						if !_rules[ruleTCMarker]() {
-							goto l300
+						position3008, tokenIndex3008 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3009
 						}
-						goto l301
-					l300:
-						position, tokenIndex = position300, tokenIndex300
-					}
-				l301:
-					goto l284
-				l299:
-					position, tokenIndex = position284, tokenIndex284
-					if !_rules[ruleSymbolName]() {
-						goto l302
-					}
-					if !_rules[ruleOffset]() {
-						goto l302
-					}
-					goto l284
-				l302:
-					position, tokenIndex = position284, tokenIndex284
-					if !_rules[ruleSymbolName]() {
-						goto l282
+						position++
+						goto l3008
+					l3009:
+						position, tokenIndex = position3008, tokenIndex3008
+						if buffer[position] != rune('A') {
+							goto l3003
+						}
+						position++
 					}
+				l3008:
 					{
-						position303, tokenIndex303 := position, tokenIndex
-						if !_rules[ruleTCMarker]() {
-							goto l303
+						position3010, tokenIndex3010 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3011
+						}
+						position++
+						goto l3010
+					l3011:
+						position, tokenIndex = position3010, tokenIndex3010
+						if buffer[position] != rune('A') {
+							goto l3003
 						}
-						goto l304
-					l303:
-						position, tokenIndex = position303, tokenIndex303
+						position++
 					}
-				l304:
-				}
-			l284:
-				add(ruleSymbolArg, position283)
-			}
-			return true
-		l282:
-			position, tokenIndex = position282, tokenIndex282
-			return false
-		},
-		/* 16 SymbolType <- <(('@' / '%') (('f' 'u' 'n' 'c' 't' 'i' 'o' 'n') / ('o' 'b' 'j' 'e' 'c' 't')))> */
-		func() bool {
-			position305, tokenIndex305 := position, tokenIndex
-			{
-				position306 := position
-				{
-					position307, tokenIndex307 := position, tokenIndex
-					if buffer[position] != rune('@') {
-						goto l308
+				l3010:
+					if buffer[position] != rune('6') {
+						goto l3003
 					}
 					position++
-					goto l307
-				l308:
-					position, tokenIndex = position307, tokenIndex307
-					if buffer[position] != rune('%') {
-						goto l305
+					if buffer[position] != rune('4') {
+						goto l3003
 					}
 					position++
-				}
-			l307:
-				{
-					position309, tokenIndex309 := position, tokenIndex
-					if buffer[position] != rune('f') {
-						goto l310
+					{
+						position3012, tokenIndex3012 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3013
+						}
+						position++
+						goto l3012
+					l3013:
+						position, tokenIndex = position3012, tokenIndex3012
+						if buffer[position] != rune('I') {
+							goto l3003
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l310
+				l3012:
+					{
+						position3014, tokenIndex3014 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l3015
+						}
+						position++
+						goto l3014
+					l3015:
+						position, tokenIndex = position3014, tokenIndex3014
+						if buffer[position] != rune('S') {
+							goto l3003
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('n') {
-						goto l310
+				l3014:
+					{
+						position3016, tokenIndex3016 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3017
+						}
+						position++
+						goto l3016
+					l3017:
+						position, tokenIndex = position3016, tokenIndex3016
+						if buffer[position] != rune('A') {
+							goto l3003
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l310
+				l3016:
+					{
+						position3018, tokenIndex3018 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3019
+						}
+						position++
+						goto l3018
+					l3019:
+						position, tokenIndex = position3018, tokenIndex3018
+						if buffer[position] != rune('R') {
+							goto l3003
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l310
+				l3018:
+					if buffer[position] != rune('0') {
+						goto l3003
 					}
 					position++
-					if buffer[position] != rune('i') {
-						goto l310
+					if buffer[position] != rune('_') {
+						goto l3003
 					}
 					position++
-					if buffer[position] != rune('o') {
-						goto l310
+					{
+						position3020, tokenIndex3020 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3021
+						}
+						position++
+						goto l3020
+					l3021:
+						position, tokenIndex = position3020, tokenIndex3020
+						if buffer[position] != rune('E') {
+							goto l3003
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('n') {
-						goto l310
+				l3020:
+					{
+						position3022, tokenIndex3022 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l3023
+						}
+						position++
+						goto l3022
+					l3023:
+						position, tokenIndex = position3022, tokenIndex3022
+						if buffer[position] != rune('L') {
+							goto l3003
+						}
+						position++
 					}
-					position++
-					goto l309
-				l310:
-					position, tokenIndex = position309, tokenIndex309
-					if buffer[position] != rune('o') {
-						goto l305
+				l3022:
+					if buffer[position] != rune('1') {
+						goto l3003
 					}
 					position++
-					if buffer[position] != rune('b') {
-						goto l305
+					goto l2865
+				l3003:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position3025, tokenIndex3025 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3026
+						}
+						position++
+						goto l3025
+					l3026:
+						position, tokenIndex = position3025, tokenIndex3025
+						if buffer[position] != rune('I') {
+							goto l3024
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('j') {
-						goto l305
+				l3025:
+					{
+						position3027, tokenIndex3027 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l3028
+						}
+						position++
+						goto l3027
+					l3028:
+						position, tokenIndex = position3027, tokenIndex3027
+						if buffer[position] != rune('D') {
+							goto l3024
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l305
+				l3027:
+					if buffer[position] != rune('_') {
+						goto l3024
 					}
 					position++
-					if buffer[position] != rune('c') {
-						goto l305
+					{
+						position3029, tokenIndex3029 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3030
+						}
+						position++
+						goto l3029
+					l3030:
+						position, tokenIndex = position3029, tokenIndex3029
+						if buffer[position] != rune('A') {
+							goto l3024
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l305
+				l3029:
+					{
+						position3031, tokenIndex3031 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3032
+						}
+						position++
+						goto l3031
+					l3032:
+						position, tokenIndex = position3031, tokenIndex3031
+						if buffer[position] != rune('A') {
+							goto l3024
+						}
+						position++
 					}
-					position++
-				}
-			l309:
-				add(ruleSymbolType, position306)
-			}
-			return true
-		l305:
-			position, tokenIndex = position305, tokenIndex305
-			return false
-		},
-		/* 17 Dot <- <'.'> */
-		func() bool {
-			position311, tokenIndex311 := position, tokenIndex
-			{
-				position312 := position
-				if buffer[position] != rune('.') {
-					goto l311
-				}
-				position++
-				add(ruleDot, position312)
-			}
-			return true
-		l311:
-			position, tokenIndex = position311, tokenIndex311
-			return false
-		},
-		/* 18 TCMarker <- <('[' 'T' 'C' ']')> */
-		func() bool {
-			position313, tokenIndex313 := position, tokenIndex
-			{
-				position314 := position
-				if buffer[position] != rune('[') {
-					goto l313
-				}
-				position++
-				if buffer[position] != rune('T') {
-					goto l313
-				}
-				position++
-				if buffer[position] != rune('C') {
-					goto l313
-				}
-				position++
-				if buffer[position] != rune(']') {
-					goto l313
-				}
-				position++
-				add(ruleTCMarker, position314)
-			}
-			return true
-		l313:
-			position, tokenIndex = position313, tokenIndex313
-			return false
-		},
-		/* 19 EscapedChar <- <('\\' .)> */
-		func() bool {
-			position315, tokenIndex315 := position, tokenIndex
-			{
-				position316 := position
-				if buffer[position] != rune('\\') {
-					goto l315
-				}
-				position++
-				if !matchDot() {
-					goto l315
-				}
-				add(ruleEscapedChar, position316)
-			}
-			return true
-		l315:
-			position, tokenIndex = position315, tokenIndex315
-			return false
-		},
-		/* 20 WS <- <(' ' / '\t')+> */
-		func() bool {
-			position317, tokenIndex317 := position, tokenIndex
-			{
-				position318 := position
-				{
-					position321, tokenIndex321 := position, tokenIndex
-					if buffer[position] != rune(' ') {
-						goto l322
+				l3031:
+					if buffer[position] != rune('6') {
+						goto l3024
 					}
 					position++
-					goto l321
-				l322:
-					position, tokenIndex = position321, tokenIndex321
-					if buffer[position] != rune('\t') {
-						goto l317
+					if buffer[position] != rune('4') {
+						goto l3024
 					}
 					position++
-				}
-			l321:
-			l319:
-				{
-					position320, tokenIndex320 := position, tokenIndex
 					{
-						position323, tokenIndex323 := position, tokenIndex
-						if buffer[position] != rune(' ') {
-							goto l324
+						position3033, tokenIndex3033 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3034
 						}
 						position++
-						goto l323
-					l324:
-						position, tokenIndex = position323, tokenIndex323
-						if buffer[position] != rune('\t') {
-							goto l320
+						goto l3033
+					l3034:
+						position, tokenIndex = position3033, tokenIndex3033
+						if buffer[position] != rune('I') {
+							goto l3024
 						}
 						position++
 					}
-				l323:
-					goto l319
-				l320:
-					position, tokenIndex = position320, tokenIndex320
-				}
-				add(ruleWS, position318)
-			}
-			return true
-		l317:
-			position, tokenIndex = position317, tokenIndex317
-			return false
-		},
-		/* 21 Comment <- <((('/' '/') / '#') (!'\n' .)*)> */
-		func() bool {
-			position325, tokenIndex325 := position, tokenIndex
-			{
-				position326 := position
-				{
-					position327, tokenIndex327 := position, tokenIndex
-					if buffer[position] != rune('/') {
-						goto l328
+				l3033:
+					{
+						position3035, tokenIndex3035 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l3036
+						}
+						position++
+						goto l3035
+					l3036:
+						position, tokenIndex = position3035, tokenIndex3035
+						if buffer[position] != rune('S') {
+							goto l3024
+						}
+						position++
+					}
+				l3035:
+					{
+						position3037, tokenIndex3037 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3038
+						}
+						position++
+						goto l3037
+					l3038:
+						position, tokenIndex = position3037, tokenIndex3037
+						if buffer[position] != rune('A') {
+							goto l3024
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('/') {
-						goto l328
+				l3037:
+					{
+						position3039, tokenIndex3039 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3040
+						}
+						position++
+						goto l3039
+					l3040:
+						position, tokenIndex = position3039, tokenIndex3039
+						if buffer[position] != rune('R') {
+							goto l3024
+						}
+						position++
+					}
+				l3039:
+					if buffer[position] != rune('1') {
+						goto l3024
 					}
 					position++
-					goto l327
-				l328:
-					position, tokenIndex = position327, tokenIndex327
-					if buffer[position] != rune('#') {
-						goto l325
+					if buffer[position] != rune('_') {
+						goto l3024
 					}
 					position++
-				}
-			l327:
-			l329:
-				{
-					position330, tokenIndex330 := position, tokenIndex
 					{
-						position331, tokenIndex331 := position, tokenIndex
-						if buffer[position] != rune('\n') {
-							goto l331
+						position3041, tokenIndex3041 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3042
+						}
+						position++
+						goto l3041
+					l3042:
+						position, tokenIndex = position3041, tokenIndex3041
+						if buffer[position] != rune('E') {
+							goto l3024
 						}
 						position++
-						goto l330
-					l331:
-						position, tokenIndex = position331, tokenIndex331
-					}
-					if !matchDot() {
-						goto l330
-					}
-					goto l329
-				l330:
-					position, tokenIndex = position330, tokenIndex330
-				}
-				add(ruleComment, position326)
-			}
-			return true
-		l325:
-			position, tokenIndex = position325, tokenIndex325
-			return false
-		},
-		/* 22 Label <- <((LocalSymbol / LocalLabel / SymbolName) ':')> */
-		func() bool {
-			position332, tokenIndex332 := position, tokenIndex
-			{
-				position333 := position
-				{
-					position334, tokenIndex334 := position, tokenIndex
-					if !_rules[ruleLocalSymbol]() {
-						goto l335
-					}
-					goto l334
-				l335:
-					position, tokenIndex = position334, tokenIndex334
-					if !_rules[ruleLocalLabel]() {
-						goto l336
 					}
-					goto l334
-				l336:
-					position, tokenIndex = position334, tokenIndex334
-					if !_rules[ruleSymbolName]() {
-						goto l332
+				l3041:
+					{
+						position3043, tokenIndex3043 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l3044
+						}
+						position++
+						goto l3043
+					l3044:
+						position, tokenIndex = position3043, tokenIndex3043
+						if buffer[position] != rune('L') {
+							goto l3024
+						}
+						position++
 					}
-				}
-			l334:
-				if buffer[position] != rune(':') {
-					goto l332
-				}
-				position++
-				add(ruleLabel, position333)
-			}
-			return true
-		l332:
-			position, tokenIndex = position332, tokenIndex332
-			return false
-		},
-		/* 23 SymbolName <- <(([a-z] / [A-Z] / '.' / '_') ([a-z] / [A-Z] / '.' / ([0-9] / [0-9]) / '$' / '_')*)> */
-		func() bool {
-			position337, tokenIndex337 := position, tokenIndex
-			{
-				position338 := position
-				{
-					position339, tokenIndex339 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l340
+				l3043:
+					if buffer[position] != rune('1') {
+						goto l3024
 					}
 					position++
-					goto l339
-				l340:
-					position, tokenIndex = position339, tokenIndex339
-					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l341
+					goto l2865
+				l3024:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position3046, tokenIndex3046 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3047
+						}
+						position++
+						goto l3046
+					l3047:
+						position, tokenIndex = position3046, tokenIndex3046
+						if buffer[position] != rune('I') {
+							goto l3045
+						}
+						position++
 					}
-					position++
-					goto l339
-				l341:
-					position, tokenIndex = position339, tokenIndex339
-					if buffer[position] != rune('.') {
-						goto l342
+				l3046:
+					{
+						position3048, tokenIndex3048 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l3049
+						}
+						position++
+						goto l3048
+					l3049:
+						position, tokenIndex = position3048, tokenIndex3048
+						if buffer[position] != rune('D') {
+							goto l3045
+						}
+						position++
 					}
-					position++
-					goto l339
-				l342:
-					position, tokenIndex = position339, tokenIndex339
+				l3048:
 					if buffer[position] != rune('_') {
-						goto l337
+						goto l3045
 					}
 					position++
-				}
-			l339:
-			l343:
-				{
-					position344, tokenIndex344 := position, tokenIndex
 					{
-						position345, tokenIndex345 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l346
-						}
-						position++
-						goto l345
-					l346:
-						position, tokenIndex = position345, tokenIndex345
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l347
+						position3050, tokenIndex3050 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3051
 						}
 						position++
-						goto l345
-					l347:
-						position, tokenIndex = position345, tokenIndex345
-						if buffer[position] != rune('.') {
-							goto l348
+						goto l3050
+					l3051:
+						position, tokenIndex = position3050, tokenIndex3050
+						if buffer[position] != rune('A') {
+							goto l3045
 						}
 						position++
-						goto l345
-					l348:
-						position, tokenIndex = position345, tokenIndex345
-						{
-							position350, tokenIndex350 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l351
-							}
-							position++
-							goto l350
-						l351:
-							position, tokenIndex = position350, tokenIndex350
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l349
-							}
-							position++
-						}
-					l350:
-						goto l345
-					l349:
-						position, tokenIndex = position345, tokenIndex345
-						if buffer[position] != rune('$') {
-							goto l352
+					}
+				l3050:
+					{
+						position3052, tokenIndex3052 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3053
 						}
 						position++
-						goto l345
-					l352:
-						position, tokenIndex = position345, tokenIndex345
-						if buffer[position] != rune('_') {
-							goto l344
+						goto l3052
+					l3053:
+						position, tokenIndex = position3052, tokenIndex3052
+						if buffer[position] != rune('A') {
+							goto l3045
 						}
 						position++
 					}
-				l345:
-					goto l343
-				l344:
-					position, tokenIndex = position344, tokenIndex344
-				}
-				add(ruleSymbolName, position338)
-			}
-			return true
-		l337:
-			position, tokenIndex = position337, tokenIndex337
-			return false
-		},
-		/* 24 LocalSymbol <- <('.' 'L' ([a-z] / [A-Z] / ([a-z] / [A-Z]) / '.' / ([0-9] / [0-9]) / '$' / '_')+)> */
-		func() bool {
-			position353, tokenIndex353 := position, tokenIndex
-			{
-				position354 := position
-				if buffer[position] != rune('.') {
-					goto l353
-				}
-				position++
-				if buffer[position] != rune('L') {
-					goto l353
-				}
-				position++
-				{
-					position357, tokenIndex357 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l358
+				l3052:
+					if buffer[position] != rune('6') {
+						goto l3045
 					}
 					position++
-					goto l357
-				l358:
-					position, tokenIndex = position357, tokenIndex357
-					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l359
+					if buffer[position] != rune('4') {
+						goto l3045
 					}
 					position++
-					goto l357
-				l359:
-					position, tokenIndex = position357, tokenIndex357
 					{
-						position361, tokenIndex361 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l362
+						position3054, tokenIndex3054 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l3055
 						}
 						position++
-						goto l361
-					l362:
-						position, tokenIndex = position361, tokenIndex361
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l360
+						goto l3054
+					l3055:
+						position, tokenIndex = position3054, tokenIndex3054
+						if buffer[position] != rune('P') {
+							goto l3045
 						}
 						position++
 					}
-				l361:
-					goto l357
-				l360:
-					position, tokenIndex = position357, tokenIndex357
-					if buffer[position] != rune('.') {
-						goto l363
+				l3054:
+					{
+						position3056, tokenIndex3056 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l3057
+						}
+						position++
+						goto l3056
+					l3057:
+						position, tokenIndex = position3056, tokenIndex3056
+						if buffer[position] != rune('F') {
+							goto l3045
+						}
+						position++
 					}
-					position++
-					goto l357
-				l363:
-					position, tokenIndex = position357, tokenIndex357
+				l3056:
 					{
-						position365, tokenIndex365 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l366
+						position3058, tokenIndex3058 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3059
 						}
 						position++
-						goto l365
-					l366:
-						position, tokenIndex = position365, tokenIndex365
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l364
+						goto l3058
+					l3059:
+						position, tokenIndex = position3058, tokenIndex3058
+						if buffer[position] != rune('R') {
+							goto l3045
 						}
 						position++
 					}
-				l365:
-					goto l357
-				l364:
-					position, tokenIndex = position357, tokenIndex357
-					if buffer[position] != rune('$') {
-						goto l367
+				l3058:
+					if buffer[position] != rune('0') {
+						goto l3045
 					}
 					position++
-					goto l357
-				l367:
-					position, tokenIndex = position357, tokenIndex357
 					if buffer[position] != rune('_') {
-						goto l353
+						goto l3045
 					}
 					position++
-				}
-			l357:
-			l355:
-				{
-					position356, tokenIndex356 := position, tokenIndex
 					{
-						position368, tokenIndex368 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l369
-						}
-						position++
-						goto l368
-					l369:
-						position, tokenIndex = position368, tokenIndex368
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l370
+						position3060, tokenIndex3060 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3061
 						}
 						position++
-						goto l368
-					l370:
-						position, tokenIndex = position368, tokenIndex368
-						{
-							position372, tokenIndex372 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l373
-							}
-							position++
-							goto l372
-						l373:
-							position, tokenIndex = position372, tokenIndex372
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l371
-							}
-							position++
-						}
-					l372:
-						goto l368
-					l371:
-						position, tokenIndex = position368, tokenIndex368
-						if buffer[position] != rune('.') {
-							goto l374
+						goto l3060
+					l3061:
+						position, tokenIndex = position3060, tokenIndex3060
+						if buffer[position] != rune('E') {
+							goto l3045
 						}
 						position++
-						goto l368
-					l374:
-						position, tokenIndex = position368, tokenIndex368
-						{
-							position376, tokenIndex376 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l377
-							}
-							position++
-							goto l376
-						l377:
-							position, tokenIndex = position376, tokenIndex376
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l375
-							}
-							position++
-						}
-					l376:
-						goto l368
-					l375:
-						position, tokenIndex = position368, tokenIndex368
-						if buffer[position] != rune('$') {
-							goto l378
+					}
+				l3060:
+					{
+						position3062, tokenIndex3062 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l3063
 						}
 						position++
-						goto l368
-					l378:
-						position, tokenIndex = position368, tokenIndex368
-						if buffer[position] != rune('_') {
-							goto l356
+						goto l3062
+					l3063:
+						position, tokenIndex = position3062, tokenIndex3062
+						if buffer[position] != rune('L') {
+							goto l3045
 						}
 						position++
 					}
-				l368:
-					goto l355
-				l356:
-					position, tokenIndex = position356, tokenIndex356
-				}
-				add(ruleLocalSymbol, position354)
-			}
-			return true
-		l353:
-			position, tokenIndex = position353, tokenIndex353
-			return false
-		},
-		/* 25 LocalLabel <- <([0-9] ([0-9] / '$')*)> */
-		func() bool {
-			position379, tokenIndex379 := position, tokenIndex
-			{
-				position380 := position
-				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l379
-				}
-				position++
-			l381:
-				{
-					position382, tokenIndex382 := position, tokenIndex
+				l3062:
+					if buffer[position] != rune('1') {
+						goto l3045
+					}
+					position++
+					goto l2865
+				l3045:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position383, tokenIndex383 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l384
+						position3065, tokenIndex3065 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3066
 						}
 						position++
-						goto l383
-					l384:
-						position, tokenIndex = position383, tokenIndex383
-						if buffer[position] != rune('$') {
-							goto l382
+						goto l3065
+					l3066:
+						position, tokenIndex = position3065, tokenIndex3065
+						if buffer[position] != rune('I') {
+							goto l3064
 						}
 						position++
 					}
-				l383:
-					goto l381
-				l382:
-					position, tokenIndex = position382, tokenIndex382
-				}
-				add(ruleLocalLabel, position380)
-			}
-			return true
-		l379:
-			position, tokenIndex = position379, tokenIndex379
-			return false
-		},
-		/* 26 LocalLabelRef <- <([0-9] ([0-9] / '$')* ('b' / 'f'))> */
-		func() bool {
-			position385, tokenIndex385 := position, tokenIndex
-			{
-				position386 := position
-				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l385
-				}
-				position++
-			l387:
-				{
-					position388, tokenIndex388 := position, tokenIndex
+				l3065:
 					{
-						position389, tokenIndex389 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l390
+						position3067, tokenIndex3067 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l3068
 						}
 						position++
-						goto l389
-					l390:
-						position, tokenIndex = position389, tokenIndex389
-						if buffer[position] != rune('$') {
-							goto l388
+						goto l3067
+					l3068:
+						position, tokenIndex = position3067, tokenIndex3067
+						if buffer[position] != rune('D') {
+							goto l3064
 						}
 						position++
 					}
-				l389:
-					goto l387
-				l388:
-					position, tokenIndex = position388, tokenIndex388
-				}
-				{
-					position391, tokenIndex391 := position, tokenIndex
-					if buffer[position] != rune('b') {
-						goto l392
-					}
-					position++
-					goto l391
-				l392:
-					position, tokenIndex = position391, tokenIndex391
-					if buffer[position] != rune('f') {
-						goto l385
+				l3067:
+					if buffer[position] != rune('_') {
+						goto l3064
 					}
 					position++
-				}
-			l391:
-				add(ruleLocalLabelRef, position386)
-			}
-			return true
-		l385:
-			position, tokenIndex = position385, tokenIndex385
-			return false
-		},
-		/* 27 Instruction <- <(InstructionName (WS InstructionArg (WS? ',' WS? InstructionArg)*)?)> */
-		func() bool {
-			position393, tokenIndex393 := position, tokenIndex
-			{
-				position394 := position
-				if !_rules[ruleInstructionName]() {
-					goto l393
-				}
-				{
-					position395, tokenIndex395 := position, tokenIndex
-					if !_rules[ruleWS]() {
-						goto l395
-					}
-					if !_rules[ruleInstructionArg]() {
-						goto l395
-					}
-				l397:
 					{
-						position398, tokenIndex398 := position, tokenIndex
-						{
-							position399, tokenIndex399 := position, tokenIndex
-							if !_rules[ruleWS]() {
-								goto l399
-							}
-							goto l400
-						l399:
-							position, tokenIndex = position399, tokenIndex399
+						position3069, tokenIndex3069 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3070
 						}
-					l400:
-						if buffer[position] != rune(',') {
-							goto l398
+						position++
+						goto l3069
+					l3070:
+						position, tokenIndex = position3069, tokenIndex3069
+						if buffer[position] != rune('A') {
+							goto l3064
 						}
 						position++
-						{
-							position401, tokenIndex401 := position, tokenIndex
-							if !_rules[ruleWS]() {
-								goto l401
-							}
-							goto l402
-						l401:
-							position, tokenIndex = position401, tokenIndex401
+					}
+				l3069:
+					{
+						position3071, tokenIndex3071 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3072
 						}
-					l402:
-						if !_rules[ruleInstructionArg]() {
-							goto l398
+						position++
+						goto l3071
+					l3072:
+						position, tokenIndex = position3071, tokenIndex3071
+						if buffer[position] != rune('A') {
+							goto l3064
 						}
-						goto l397
-					l398:
-						position, tokenIndex = position398, tokenIndex398
+						position++
 					}
-					goto l396
-				l395:
-					position, tokenIndex = position395, tokenIndex395
-				}
-			l396:
-				add(ruleInstruction, position394)
-			}
-			return true
-		l393:
-			position, tokenIndex = position393, tokenIndex393
-			return false
-		},
-		/* 28 InstructionName <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / '.' / ([0-9] / [0-9]))* ('.' / '+' / '-')?)> */
-		func() bool {
-			position403, tokenIndex403 := position, tokenIndex
-			{
-				position404 := position
-				{
-					position405, tokenIndex405 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l406
+				l3071:
+					if buffer[position] != rune('6') {
+						goto l3064
 					}
 					position++
-					goto l405
-				l406:
-					position, tokenIndex = position405, tokenIndex405
-					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l403
+					if buffer[position] != rune('4') {
+						goto l3064
 					}
 					position++
-				}
-			l405:
-			l407:
-				{
-					position408, tokenIndex408 := position, tokenIndex
 					{
-						position409, tokenIndex409 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l410
+						position3073, tokenIndex3073 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l3074
 						}
 						position++
-						goto l409
-					l410:
-						position, tokenIndex = position409, tokenIndex409
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l411
+						goto l3073
+					l3074:
+						position, tokenIndex = position3073, tokenIndex3073
+						if buffer[position] != rune('M') {
+							goto l3064
 						}
 						position++
-						goto l409
-					l411:
-						position, tokenIndex = position409, tokenIndex409
-						if buffer[position] != rune('.') {
-							goto l412
+					}
+				l3073:
+					{
+						position3075, tokenIndex3075 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l3076
 						}
 						position++
-						goto l409
-					l412:
-						position, tokenIndex = position409, tokenIndex409
-						{
-							position413, tokenIndex413 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l414
-							}
-							position++
-							goto l413
-						l414:
-							position, tokenIndex = position413, tokenIndex413
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l408
-							}
-							position++
+						goto l3075
+					l3076:
+						position, tokenIndex = position3075, tokenIndex3075
+						if buffer[position] != rune('M') {
+							goto l3064
 						}
-					l413:
+						position++
 					}
-				l409:
-					goto l407
-				l408:
-					position, tokenIndex = position408, tokenIndex408
-				}
-				{
-					position415, tokenIndex415 := position, tokenIndex
+				l3075:
 					{
-						position417, tokenIndex417 := position, tokenIndex
-						if buffer[position] != rune('.') {
-							goto l418
+						position3077, tokenIndex3077 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l3078
 						}
 						position++
-						goto l417
-					l418:
-						position, tokenIndex = position417, tokenIndex417
-						if buffer[position] != rune('+') {
-							goto l419
+						goto l3077
+					l3078:
+						position, tokenIndex = position3077, tokenIndex3077
+						if buffer[position] != rune('F') {
+							goto l3064
 						}
 						position++
-						goto l417
-					l419:
-						position, tokenIndex = position417, tokenIndex417
-						if buffer[position] != rune('-') {
-							goto l415
+					}
+				l3077:
+					{
+						position3079, tokenIndex3079 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3080
+						}
+						position++
+						goto l3079
+					l3080:
+						position, tokenIndex = position3079, tokenIndex3079
+						if buffer[position] != rune('R') {
+							goto l3064
 						}
 						position++
 					}
-				l417:
-					goto l416
-				l415:
-					position, tokenIndex = position415, tokenIndex415
-				}
-			l416:
-				add(ruleInstructionName, position404)
-			}
-			return true
-		l403:
-			position, tokenIndex = position403, tokenIndex403
-			return false
-		},
-		/* 29 InstructionArg <- <(IndirectionIndicator? (ARMConstantTweak / RegisterOrConstant / LocalLabelRef / TOCRefHigh / TOCRefLow / GOTLocation / GOTSymbolOffset / MemoryRef) AVX512Token*)> */
-		func() bool {
-			position420, tokenIndex420 := position, tokenIndex
-			{
-				position421 := position
-				{
-					position422, tokenIndex422 := position, tokenIndex
-					if !_rules[ruleIndirectionIndicator]() {
-						goto l422
-					}
-					goto l423
-				l422:
-					position, tokenIndex = position422, tokenIndex422
-				}
-			l423:
-				{
-					position424, tokenIndex424 := position, tokenIndex
-					if !_rules[ruleARMConstantTweak]() {
-						goto l425
-					}
-					goto l424
-				l425:
-					position, tokenIndex = position424, tokenIndex424
-					if !_rules[ruleRegisterOrConstant]() {
-						goto l426
-					}
-					goto l424
-				l426:
-					position, tokenIndex = position424, tokenIndex424
-					if !_rules[ruleLocalLabelRef]() {
-						goto l427
-					}
-					goto l424
-				l427:
-					position, tokenIndex = position424, tokenIndex424
-					if !_rules[ruleTOCRefHigh]() {
-						goto l428
-					}
-					goto l424
-				l428:
-					position, tokenIndex = position424, tokenIndex424
-					if !_rules[ruleTOCRefLow]() {
-						goto l429
-					}
-					goto l424
-				l429:
-					position, tokenIndex = position424, tokenIndex424
-					if !_rules[ruleGOTLocation]() {
-						goto l430
-					}
-					goto l424
-				l430:
-					position, tokenIndex = position424, tokenIndex424
-					if !_rules[ruleGOTSymbolOffset]() {
-						goto l431
-					}
-					goto l424
-				l431:
-					position, tokenIndex = position424, tokenIndex424
-					if !_rules[ruleMemoryRef]() {
-						goto l420
-					}
-				}
-			l424:
-			l432:
-				{
-					position433, tokenIndex433 := position, tokenIndex
-					if !_rules[ruleAVX512Token]() {
-						goto l433
-					}
-					goto l432
-				l433:
-					position, tokenIndex = position433, tokenIndex433
-				}
-				add(ruleInstructionArg, position421)
-			}
-			return true
-		l420:
-			position, tokenIndex = position420, tokenIndex420
-			return false
-		},
-		/* 30 GOTLocation <- <('$' '_' 'G' 'L' 'O' 'B' 'A' 'L' '_' 'O' 'F' 'F' 'S' 'E' 'T' '_' 'T' 'A' 'B' 'L' 'E' '_' '-' LocalSymbol)> */
-		func() bool {
-			position434, tokenIndex434 := position, tokenIndex
-			{
-				position435 := position
-				if buffer[position] != rune('$') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('_') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('G') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('L') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('O') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('B') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('A') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('L') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('_') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('O') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('F') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('F') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('S') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('E') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('T') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('_') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('T') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('A') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('B') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('L') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('E') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('_') {
-					goto l434
-				}
-				position++
-				if buffer[position] != rune('-') {
-					goto l434
-				}
-				position++
-				if !_rules[ruleLocalSymbol]() {
-					goto l434
-				}
-				add(ruleGOTLocation, position435)
-			}
-			return true
-		l434:
-			position, tokenIndex = position434, tokenIndex434
-			return false
-		},
-		/* 31 GOTSymbolOffset <- <(('$' SymbolName ('@' 'G' 'O' 'T') ('O' 'F' 'F')?) / (':' ('g' / 'G') ('o' / 'O') ('t' / 'T') ':' SymbolName))> */
-		func() bool {
-			position436, tokenIndex436 := position, tokenIndex
-			{
-				position437 := position
-				{
-					position438, tokenIndex438 := position, tokenIndex
-					if buffer[position] != rune('$') {
-						goto l439
-					}
-					position++
-					if !_rules[ruleSymbolName]() {
-						goto l439
-					}
-					if buffer[position] != rune('@') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('G') {
-						goto l439
-					}
-					position++
-					if buffer[position] != rune('O') {
-						goto l439
+				l3079:
+					if buffer[position] != rune('0') {
+						goto l3064
 					}
 					position++
-					if buffer[position] != rune('T') {
-						goto l439
+					if buffer[position] != rune('_') {
+						goto l3064
 					}
 					position++
-					{
-						position440, tokenIndex440 := position, tokenIndex
-						if buffer[position] != rune('O') {
-							goto l440
+					{
+						position3081, tokenIndex3081 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3082
 						}
 						position++
-						if buffer[position] != rune('F') {
-							goto l440
+						goto l3081
+					l3082:
+						position, tokenIndex = position3081, tokenIndex3081
+						if buffer[position] != rune('E') {
+							goto l3064
 						}
 						position++
-						if buffer[position] != rune('F') {
-							goto l440
+					}
+				l3081:
+					{
+						position3083, tokenIndex3083 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l3084
+						}
+						position++
+						goto l3083
+					l3084:
+						position, tokenIndex = position3083, tokenIndex3083
+						if buffer[position] != rune('L') {
+							goto l3064
 						}
 						position++
-						goto l441
-					l440:
-						position, tokenIndex = position440, tokenIndex440
 					}
-				l441:
-					goto l438
-				l439:
-					position, tokenIndex = position438, tokenIndex438
-					if buffer[position] != rune(':') {
-						goto l436
+				l3083:
+					if buffer[position] != rune('1') {
+						goto l3064
 					}
 					position++
+					goto l2865
+				l3064:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position442, tokenIndex442 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l443
+						position3086, tokenIndex3086 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l3087
 						}
 						position++
-						goto l442
-					l443:
-						position, tokenIndex = position442, tokenIndex442
-						if buffer[position] != rune('G') {
-							goto l436
+						goto l3086
+					l3087:
+						position, tokenIndex = position3086, tokenIndex3086
+						if buffer[position] != rune('N') {
+							goto l3085
 						}
 						position++
 					}
-				l442:
+				l3086:
 					{
-						position444, tokenIndex444 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l445
+						position3088, tokenIndex3088 := position, tokenIndex
+						if buffer[position] != rune('z') {
+							goto l3089
 						}
 						position++
-						goto l444
-					l445:
-						position, tokenIndex = position444, tokenIndex444
-						if buffer[position] != rune('O') {
-							goto l436
+						goto l3088
+					l3089:
+						position, tokenIndex = position3088, tokenIndex3088
+						if buffer[position] != rune('Z') {
+							goto l3085
 						}
 						position++
 					}
-				l444:
+				l3088:
 					{
-						position446, tokenIndex446 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l447
+						position3090, tokenIndex3090 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l3091
 						}
 						position++
-						goto l446
-					l447:
-						position, tokenIndex = position446, tokenIndex446
-						if buffer[position] != rune('T') {
-							goto l436
+						goto l3090
+					l3091:
+						position, tokenIndex = position3090, tokenIndex3090
+						if buffer[position] != rune('C') {
+							goto l3085
 						}
 						position++
 					}
-				l446:
-					if buffer[position] != rune(':') {
-						goto l436
-					}
-					position++
-					if !_rules[ruleSymbolName]() {
-						goto l436
-					}
-				}
-			l438:
-				add(ruleGOTSymbolOffset, position437)
-			}
-			return true
-		l436:
-			position, tokenIndex = position436, tokenIndex436
-			return false
-		},
-		/* 32 AVX512Token <- <(WS? '{' '%'? ([0-9] / [a-z])* '}')> */
-		func() bool {
-			position448, tokenIndex448 := position, tokenIndex
-			{
-				position449 := position
-				{
-					position450, tokenIndex450 := position, tokenIndex
-					if !_rules[ruleWS]() {
-						goto l450
-					}
-					goto l451
-				l450:
-					position, tokenIndex = position450, tokenIndex450
-				}
-			l451:
-				if buffer[position] != rune('{') {
-					goto l448
-				}
-				position++
-				{
-					position452, tokenIndex452 := position, tokenIndex
-					if buffer[position] != rune('%') {
-						goto l452
-					}
-					position++
-					goto l453
-				l452:
-					position, tokenIndex = position452, tokenIndex452
-				}
-			l453:
-			l454:
-				{
-					position455, tokenIndex455 := position, tokenIndex
+				l3090:
 					{
-						position456, tokenIndex456 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l457
+						position3092, tokenIndex3092 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l3093
 						}
 						position++
-						goto l456
-					l457:
-						position, tokenIndex = position456, tokenIndex456
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l455
+						goto l3092
+					l3093:
+						position, tokenIndex = position3092, tokenIndex3092
+						if buffer[position] != rune('V') {
+							goto l3085
 						}
 						position++
 					}
-				l456:
-					goto l454
-				l455:
-					position, tokenIndex = position455, tokenIndex455
-				}
-				if buffer[position] != rune('}') {
-					goto l448
-				}
-				position++
-				add(ruleAVX512Token, position449)
-			}
-			return true
-		l448:
-			position, tokenIndex = position448, tokenIndex448
-			return false
-		},
-		/* 33 TOCRefHigh <- <('.' 'T' 'O' 'C' '.' '-' (('0' 'b') / ('.' 'L' ([a-z] / [A-Z] / '_' / [0-9])+)) ('@' ('h' / 'H') ('a' / 'A')))> */
-		func() bool {
-			position458, tokenIndex458 := position, tokenIndex
-			{
-				position459 := position
-				if buffer[position] != rune('.') {
-					goto l458
-				}
-				position++
-				if buffer[position] != rune('T') {
-					goto l458
-				}
-				position++
-				if buffer[position] != rune('O') {
-					goto l458
-				}
-				position++
-				if buffer[position] != rune('C') {
-					goto l458
-				}
-				position++
-				if buffer[position] != rune('.') {
-					goto l458
-				}
-				position++
-				if buffer[position] != rune('-') {
-					goto l458
-				}
-				position++
-				{
-					position460, tokenIndex460 := position, tokenIndex
-					if buffer[position] != rune('0') {
-						goto l461
-					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l461
-					}
-					position++
-					goto l460
-				l461:
-					position, tokenIndex = position460, tokenIndex460
-					if buffer[position] != rune('.') {
-						goto l458
-					}
-					position++
-					if buffer[position] != rune('L') {
-						goto l458
-					}
-					position++
+				l3092:
+					goto l2865
+				l3085:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position464, tokenIndex464 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l465
+						position3095, tokenIndex3095 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l3096
 						}
 						position++
-						goto l464
-					l465:
-						position, tokenIndex = position464, tokenIndex464
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l466
+						goto l3095
+					l3096:
+						position, tokenIndex = position3095, tokenIndex3095
+						if buffer[position] != rune('D') {
+							goto l3094
 						}
 						position++
-						goto l464
-					l466:
-						position, tokenIndex = position464, tokenIndex464
-						if buffer[position] != rune('_') {
-							goto l467
+					}
+				l3095:
+					{
+						position3097, tokenIndex3097 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3098
 						}
 						position++
-						goto l464
-					l467:
-						position, tokenIndex = position464, tokenIndex464
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l458
+						goto l3097
+					l3098:
+						position, tokenIndex = position3097, tokenIndex3097
+						if buffer[position] != rune('A') {
+							goto l3094
 						}
 						position++
 					}
-				l464:
-				l462:
+				l3097:
 					{
-						position463, tokenIndex463 := position, tokenIndex
-						{
-							position468, tokenIndex468 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l469
-							}
-							position++
-							goto l468
-						l469:
-							position, tokenIndex = position468, tokenIndex468
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l470
-							}
-							position++
-							goto l468
-						l470:
-							position, tokenIndex = position468, tokenIndex468
-							if buffer[position] != rune('_') {
-								goto l471
-							}
-							position++
-							goto l468
-						l471:
-							position, tokenIndex = position468, tokenIndex468
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l463
-							}
-							position++
+						position3099, tokenIndex3099 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3100
 						}
-					l468:
-						goto l462
-					l463:
-						position, tokenIndex = position463, tokenIndex463
-					}
-				}
-			l460:
-				if buffer[position] != rune('@') {
-					goto l458
-				}
-				position++
-				{
-					position472, tokenIndex472 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l473
-					}
-					position++
-					goto l472
-				l473:
-					position, tokenIndex = position472, tokenIndex472
-					if buffer[position] != rune('H') {
-						goto l458
-					}
-					position++
-				}
-			l472:
-				{
-					position474, tokenIndex474 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l475
-					}
-					position++
-					goto l474
-				l475:
-					position, tokenIndex = position474, tokenIndex474
-					if buffer[position] != rune('A') {
-						goto l458
-					}
-					position++
-				}
-			l474:
-				add(ruleTOCRefHigh, position459)
-			}
-			return true
-		l458:
-			position, tokenIndex = position458, tokenIndex458
-			return false
-		},
-		/* 34 TOCRefLow <- <('.' 'T' 'O' 'C' '.' '-' (('0' 'b') / ('.' 'L' ([a-z] / [A-Z] / '_' / [0-9])+)) ('@' ('l' / 'L')))> */
-		func() bool {
-			position476, tokenIndex476 := position, tokenIndex
-			{
-				position477 := position
-				if buffer[position] != rune('.') {
-					goto l476
-				}
-				position++
-				if buffer[position] != rune('T') {
-					goto l476
-				}
-				position++
-				if buffer[position] != rune('O') {
-					goto l476
-				}
-				position++
-				if buffer[position] != rune('C') {
-					goto l476
-				}
-				position++
-				if buffer[position] != rune('.') {
-					goto l476
-				}
-				position++
-				if buffer[position] != rune('-') {
-					goto l476
-				}
-				position++
-				{
-					position478, tokenIndex478 := position, tokenIndex
-					if buffer[position] != rune('0') {
-						goto l479
+						position++
+						goto l3099
+					l3100:
+						position, tokenIndex = position3099, tokenIndex3099
+						if buffer[position] != rune('I') {
+							goto l3094
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('b') {
-						goto l479
+				l3099:
+					{
+						position3101, tokenIndex3101 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l3102
+						}
+						position++
+						goto l3101
+					l3102:
+						position, tokenIndex = position3101, tokenIndex3101
+						if buffer[position] != rune('F') {
+							goto l3094
+						}
+						position++
 					}
-					position++
-					goto l478
-				l479:
-					position, tokenIndex = position478, tokenIndex478
-					if buffer[position] != rune('.') {
-						goto l476
+				l3101:
+					{
+						position3103, tokenIndex3103 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l3104
+						}
+						position++
+						goto l3103
+					l3104:
+						position, tokenIndex = position3103, tokenIndex3103
+						if buffer[position] != rune('S') {
+							goto l3094
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('L') {
-						goto l476
+				l3103:
+					{
+						position3105, tokenIndex3105 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3106
+						}
+						position++
+						goto l3105
+					l3106:
+						position, tokenIndex = position3105, tokenIndex3105
+						if buffer[position] != rune('E') {
+							goto l3094
+						}
+						position++
 					}
-					position++
+				l3105:
 					{
-						position482, tokenIndex482 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l483
+						position3107, tokenIndex3107 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l3108
 						}
 						position++
-						goto l482
-					l483:
-						position, tokenIndex = position482, tokenIndex482
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l484
+						goto l3107
+					l3108:
+						position, tokenIndex = position3107, tokenIndex3107
+						if buffer[position] != rune('T') {
+							goto l3094
 						}
 						position++
-						goto l482
-					l484:
-						position, tokenIndex = position482, tokenIndex482
-						if buffer[position] != rune('_') {
-							goto l485
+					}
+				l3107:
+					goto l2865
+				l3094:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position3110, tokenIndex3110 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l3111
 						}
 						position++
-						goto l482
-					l485:
-						position, tokenIndex = position482, tokenIndex482
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l476
+						goto l3110
+					l3111:
+						position, tokenIndex = position3110, tokenIndex3110
+						if buffer[position] != rune('D') {
+							goto l3109
 						}
 						position++
 					}
-				l482:
-				l480:
+				l3110:
 					{
-						position481, tokenIndex481 := position, tokenIndex
-						{
-							position486, tokenIndex486 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l487
-							}
-							position++
-							goto l486
-						l487:
-							position, tokenIndex = position486, tokenIndex486
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l488
-							}
-							position++
-							goto l486
-						l488:
-							position, tokenIndex = position486, tokenIndex486
-							if buffer[position] != rune('_') {
-								goto l489
-							}
-							position++
-							goto l486
-						l489:
-							position, tokenIndex = position486, tokenIndex486
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l481
-							}
-							position++
+						position3112, tokenIndex3112 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3113
 						}
-					l486:
-						goto l480
-					l481:
-						position, tokenIndex = position481, tokenIndex481
-					}
-				}
-			l478:
-				if buffer[position] != rune('@') {
-					goto l476
-				}
-				position++
-				{
-					position490, tokenIndex490 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l491
-					}
-					position++
-					goto l490
-				l491:
-					position, tokenIndex = position490, tokenIndex490
-					if buffer[position] != rune('L') {
-						goto l476
-					}
-					position++
-				}
-			l490:
-				add(ruleTOCRefLow, position477)
-			}
-			return true
-		l476:
-			position, tokenIndex = position476, tokenIndex476
-			return false
-		},
-		/* 35 IndirectionIndicator <- <'*'> */
-		func() bool {
-			position492, tokenIndex492 := position, tokenIndex
-			{
-				position493 := position
-				if buffer[position] != rune('*') {
-					goto l492
-				}
-				position++
-				add(ruleIndirectionIndicator, position493)
-			}
-			return true
-		l492:
-			position, tokenIndex = position492, tokenIndex492
-			return false
-		},
-		/* 36 RegisterOrConstant <- <((('%' ([a-z] / [A-Z]) ([a-z] / [A-Z] / ([0-9] / [0-9]))*) / ('$'? ((Offset Offset) / Offset)) / ('#' Offset ('*' [0-9]+ ('-' [0-9] [0-9]*)?)?) / ('#' '~'? '(' [0-9] WS? ('<' '<') WS? [0-9] ')') / ARMRegister) !('f' / 'b' / ':' / '(' / '+' / '-'))> */
-		func() bool {
-			position494, tokenIndex494 := position, tokenIndex
-			{
-				position495 := position
-				{
-					position496, tokenIndex496 := position, tokenIndex
-					if buffer[position] != rune('%') {
-						goto l497
+						position++
+						goto l3112
+					l3113:
+						position, tokenIndex = position3112, tokenIndex3112
+						if buffer[position] != rune('A') {
+							goto l3109
+						}
+						position++
 					}
-					position++
+				l3112:
 					{
-						position498, tokenIndex498 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l499
+						position3114, tokenIndex3114 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3115
 						}
 						position++
-						goto l498
-					l499:
-						position, tokenIndex = position498, tokenIndex498
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l497
+						goto l3114
+					l3115:
+						position, tokenIndex = position3114, tokenIndex3114
+						if buffer[position] != rune('I') {
+							goto l3109
 						}
 						position++
 					}
-				l498:
-				l500:
+				l3114:
 					{
-						position501, tokenIndex501 := position, tokenIndex
-						{
-							position502, tokenIndex502 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l503
-							}
-							position++
-							goto l502
-						l503:
-							position, tokenIndex = position502, tokenIndex502
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l504
-							}
-							position++
-							goto l502
-						l504:
-							position, tokenIndex = position502, tokenIndex502
-							{
-								position505, tokenIndex505 := position, tokenIndex
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l506
-								}
-								position++
-								goto l505
-							l506:
-								position, tokenIndex = position505, tokenIndex505
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l501
-								}
-								position++
-							}
-						l505:
+						position3116, tokenIndex3116 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l3117
 						}
-					l502:
-						goto l500
-					l501:
-						position, tokenIndex = position501, tokenIndex501
+						position++
+						goto l3116
+					l3117:
+						position, tokenIndex = position3116, tokenIndex3116
+						if buffer[position] != rune('F') {
+							goto l3109
+						}
+						position++
 					}
-					goto l496
-				l497:
-					position, tokenIndex = position496, tokenIndex496
+				l3116:
 					{
-						position508, tokenIndex508 := position, tokenIndex
-						if buffer[position] != rune('$') {
-							goto l508
+						position3118, tokenIndex3118 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l3119
+						}
+						position++
+						goto l3118
+					l3119:
+						position, tokenIndex = position3118, tokenIndex3118
+						if buffer[position] != rune('C') {
+							goto l3109
 						}
 						position++
-						goto l509
-					l508:
-						position, tokenIndex = position508, tokenIndex508
 					}
-				l509:
+				l3118:
 					{
-						position510, tokenIndex510 := position, tokenIndex
-						if !_rules[ruleOffset]() {
-							goto l511
-						}
-						if !_rules[ruleOffset]() {
-							goto l511
+						position3120, tokenIndex3120 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l3121
 						}
-						goto l510
-					l511:
-						position, tokenIndex = position510, tokenIndex510
-						if !_rules[ruleOffset]() {
-							goto l507
+						position++
+						goto l3120
+					l3121:
+						position, tokenIndex = position3120, tokenIndex3120
+						if buffer[position] != rune('L') {
+							goto l3109
 						}
+						position++
 					}
-				l510:
-					goto l496
-				l507:
-					position, tokenIndex = position496, tokenIndex496
-					if buffer[position] != rune('#') {
-						goto l512
-					}
-					position++
-					if !_rules[ruleOffset]() {
-						goto l512
-					}
+				l3120:
 					{
-						position513, tokenIndex513 := position, tokenIndex
-						if buffer[position] != rune('*') {
-							goto l513
+						position3122, tokenIndex3122 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3123
 						}
 						position++
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l513
+						goto l3122
+					l3123:
+						position, tokenIndex = position3122, tokenIndex3122
+						if buffer[position] != rune('R') {
+							goto l3109
 						}
 						position++
-					l515:
-						{
-							position516, tokenIndex516 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l516
-							}
-							position++
-							goto l515
-						l516:
-							position, tokenIndex = position516, tokenIndex516
+					}
+				l3122:
+					goto l2865
+				l3109:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position3125, tokenIndex3125 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l3126
 						}
-						{
-							position517, tokenIndex517 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l517
-							}
-							position++
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l517
-							}
-							position++
-						l519:
-							{
-								position520, tokenIndex520 := position, tokenIndex
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l520
-								}
-								position++
-								goto l519
-							l520:
-								position, tokenIndex = position520, tokenIndex520
-							}
-							goto l518
-						l517:
-							position, tokenIndex = position517, tokenIndex517
+						position++
+						goto l3125
+					l3126:
+						position, tokenIndex = position3125, tokenIndex3125
+						if buffer[position] != rune('D') {
+							goto l3124
 						}
-					l518:
-						goto l514
-					l513:
-						position, tokenIndex = position513, tokenIndex513
-					}
-				l514:
-					goto l496
-				l512:
-					position, tokenIndex = position496, tokenIndex496
-					if buffer[position] != rune('#') {
-						goto l521
+						position++
 					}
-					position++
+				l3125:
 					{
-						position522, tokenIndex522 := position, tokenIndex
-						if buffer[position] != rune('~') {
-							goto l522
+						position3127, tokenIndex3127 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l3128
+						}
+						position++
+						goto l3127
+					l3128:
+						position, tokenIndex = position3127, tokenIndex3127
+						if buffer[position] != rune('A') {
+							goto l3124
 						}
 						position++
-						goto l523
-					l522:
-						position, tokenIndex = position522, tokenIndex522
-					}
-				l523:
-					if buffer[position] != rune('(') {
-						goto l521
-					}
-					position++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l521
 					}
-					position++
+				l3127:
 					{
-						position524, tokenIndex524 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l524
+						position3129, tokenIndex3129 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3130
 						}
-						goto l525
-					l524:
-						position, tokenIndex = position524, tokenIndex524
-					}
-				l525:
-					if buffer[position] != rune('<') {
-						goto l521
-					}
-					position++
-					if buffer[position] != rune('<') {
-						goto l521
+						position++
+						goto l3129
+					l3130:
+						position, tokenIndex = position3129, tokenIndex3129
+						if buffer[position] != rune('I') {
+							goto l3124
+						}
+						position++
 					}
-					position++
+				l3129:
 					{
-						position526, tokenIndex526 := position, tokenIndex
-						if !_rules[ruleWS]() {
-							goto l526
+						position3131, tokenIndex3131 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l3132
 						}
-						goto l527
-					l526:
-						position, tokenIndex = position526, tokenIndex526
-					}
-				l527:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l521
+						position++
+						goto l3131
+					l3132:
+						position, tokenIndex = position3131, tokenIndex3131
+						if buffer[position] != rune('F') {
+							goto l3124
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune(')') {
-						goto l521
+				l3131:
+					goto l2865
+				l3124:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position3134, tokenIndex3134 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l3135
+						}
+						position++
+						goto l3134
+					l3135:
+						position, tokenIndex = position3134, tokenIndex3134
+						if buffer[position] != rune('F') {
+							goto l3133
+						}
+						position++
 					}
-					position++
-					goto l496
-				l521:
-					position, tokenIndex = position496, tokenIndex496
-					if !_rules[ruleARMRegister]() {
-						goto l494
+				l3134:
+					{
+						position3136, tokenIndex3136 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l3137
+						}
+						position++
+						goto l3136
+					l3137:
+						position, tokenIndex = position3136, tokenIndex3136
+						if buffer[position] != rune('P') {
+							goto l3133
+						}
+						position++
 					}
-				}
-			l496:
-				{
-					position528, tokenIndex528 := position, tokenIndex
+				l3136:
 					{
-						position529, tokenIndex529 := position, tokenIndex
-						if buffer[position] != rune('f') {
-							goto l530
+						position3138, tokenIndex3138 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l3139
 						}
 						position++
-						goto l529
-					l530:
-						position, tokenIndex = position529, tokenIndex529
-						if buffer[position] != rune('b') {
-							goto l531
+						goto l3138
+					l3139:
+						position, tokenIndex = position3138, tokenIndex3138
+						if buffer[position] != rune('C') {
+							goto l3133
 						}
 						position++
-						goto l529
-					l531:
-						position, tokenIndex = position529, tokenIndex529
-						if buffer[position] != rune(':') {
-							goto l532
+					}
+				l3138:
+					{
+						position3140, tokenIndex3140 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3141
 						}
 						position++
-						goto l529
-					l532:
-						position, tokenIndex = position529, tokenIndex529
-						if buffer[position] != rune('(') {
-							goto l533
+						goto l3140
+					l3141:
+						position, tokenIndex = position3140, tokenIndex3140
+						if buffer[position] != rune('R') {
+							goto l3133
 						}
 						position++
-						goto l529
-					l533:
-						position, tokenIndex = position529, tokenIndex529
-						if buffer[position] != rune('+') {
-							goto l534
+					}
+				l3140:
+					goto l2865
+				l3133:
+					position, tokenIndex = position2865, tokenIndex2865
+					{
+						position3143, tokenIndex3143 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l3144
 						}
 						position++
-						goto l529
-					l534:
-						position, tokenIndex = position529, tokenIndex529
-						if buffer[position] != rune('-') {
-							goto l528
+						goto l3143
+					l3144:
+						position, tokenIndex = position3143, tokenIndex3143
+						if buffer[position] != rune('F') {
+							goto l3142
 						}
 						position++
 					}
-				l529:
-					goto l494
-				l528:
-					position, tokenIndex = position528, tokenIndex528
-				}
-				add(ruleRegisterOrConstant, position495)
-			}
-			return true
-		l494:
-			position, tokenIndex = position494, tokenIndex494
-			return false
-		},
-		/* 37 ARMConstantTweak <- <(((('l' / 'L') ('s' / 'S') ('l' / 'L')) / (('s' / 'S') ('x' / 'X') ('t' / 'T') ('w' / 'W')) / (('u' / 'U') ('x' / 'X') ('t' / 'T') ('w' / 'W')) / (('u' / 'U') ('x' / 'X') ('t' / 'T') ('b' / 'B')) / (('l' / 'L') ('s' / 'S') ('r' / 'R')) / (('r' / 'R') ('o' / 'O') ('r' / 'R')) / (('a' / 'A') ('s' / 'S') ('r' / 'R'))) (WS '#' Offset)?)> */
-		func() bool {
-			position535, tokenIndex535 := position, tokenIndex
-			{
-				position536 := position
-				{
-					position537, tokenIndex537 := position, tokenIndex
+				l3143:
 					{
-						position539, tokenIndex539 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l540
+						position3145, tokenIndex3145 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l3146
 						}
 						position++
-						goto l539
-					l540:
-						position, tokenIndex = position539, tokenIndex539
-						if buffer[position] != rune('L') {
-							goto l538
+						goto l3145
+					l3146:
+						position, tokenIndex = position3145, tokenIndex3145
+						if buffer[position] != rune('P') {
+							goto l3142
 						}
 						position++
 					}
-				l539:
+				l3145:
 					{
-						position541, tokenIndex541 := position, tokenIndex
+						position3147, tokenIndex3147 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l542
+							goto l3148
 						}
 						position++
-						goto l541
-					l542:
-						position, tokenIndex = position541, tokenIndex541
+						goto l3147
+					l3148:
+						position, tokenIndex = position3147, tokenIndex3147
 						if buffer[position] != rune('S') {
-							goto l538
+							goto l3142
 						}
 						position++
 					}
-				l541:
+				l3147:
 					{
-						position543, tokenIndex543 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l544
+						position3149, tokenIndex3149 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3150
 						}
 						position++
-						goto l543
-					l544:
-						position, tokenIndex = position543, tokenIndex543
-						if buffer[position] != rune('L') {
-							goto l538
+						goto l3149
+					l3150:
+						position, tokenIndex = position3149, tokenIndex3149
+						if buffer[position] != rune('R') {
+							goto l3142
 						}
 						position++
 					}
-				l543:
-					goto l537
-				l538:
-					position, tokenIndex = position537, tokenIndex537
+				l3149:
+					goto l2865
+				l3142:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position546, tokenIndex546 := position, tokenIndex
+						position3152, tokenIndex3152 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l547
+							goto l3153
 						}
 						position++
-						goto l546
-					l547:
-						position, tokenIndex = position546, tokenIndex546
+						goto l3152
+					l3153:
+						position, tokenIndex = position3152, tokenIndex3152
 						if buffer[position] != rune('S') {
-							goto l545
+							goto l3151
 						}
 						position++
 					}
-				l546:
+				l3152:
 					{
-						position548, tokenIndex548 := position, tokenIndex
-						if buffer[position] != rune('x') {
-							goto l549
+						position3154, tokenIndex3154 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l3155
 						}
 						position++
-						goto l548
-					l549:
-						position, tokenIndex = position548, tokenIndex548
-						if buffer[position] != rune('X') {
-							goto l545
+						goto l3154
+					l3155:
+						position, tokenIndex = position3154, tokenIndex3154
+						if buffer[position] != rune('P') {
+							goto l3151
 						}
 						position++
 					}
-				l548:
+				l3154:
 					{
-						position550, tokenIndex550 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l551
+						position3156, tokenIndex3156 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l3157
 						}
 						position++
-						goto l550
-					l551:
-						position, tokenIndex = position550, tokenIndex550
-						if buffer[position] != rune('T') {
-							goto l545
+						goto l3156
+					l3157:
+						position, tokenIndex = position3156, tokenIndex3156
+						if buffer[position] != rune('S') {
+							goto l3151
 						}
 						position++
 					}
-				l550:
+				l3156:
 					{
-						position552, tokenIndex552 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l553
+						position3158, tokenIndex3158 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3159
 						}
 						position++
-						goto l552
-					l553:
-						position, tokenIndex = position552, tokenIndex552
-						if buffer[position] != rune('W') {
-							goto l545
+						goto l3158
+					l3159:
+						position, tokenIndex = position3158, tokenIndex3158
+						if buffer[position] != rune('E') {
+							goto l3151
 						}
 						position++
 					}
-				l552:
-					goto l537
-				l545:
-					position, tokenIndex = position537, tokenIndex537
+				l3158:
 					{
-						position555, tokenIndex555 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l556
+						position3160, tokenIndex3160 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l3161
 						}
 						position++
-						goto l555
-					l556:
-						position, tokenIndex = position555, tokenIndex555
-						if buffer[position] != rune('U') {
-							goto l554
+						goto l3160
+					l3161:
+						position, tokenIndex = position3160, tokenIndex3160
+						if buffer[position] != rune('L') {
+							goto l3151
 						}
 						position++
 					}
-				l555:
+				l3160:
+					goto l2865
+				l3151:
+					position, tokenIndex = position2865, tokenIndex2865
 					{
-						position557, tokenIndex557 := position, tokenIndex
-						if buffer[position] != rune('x') {
-							goto l558
+						position3163, tokenIndex3163 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l3164
 						}
 						position++
-						goto l557
-					l558:
-						position, tokenIndex = position557, tokenIndex557
-						if buffer[position] != rune('X') {
-							goto l554
+						goto l3163
+					l3164:
+						position, tokenIndex = position3163, tokenIndex3163
+						if buffer[position] != rune('C') {
+							goto l3162
 						}
 						position++
 					}
-				l557:
+				l3163:
 					{
-						position559, tokenIndex559 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l560
+						position3165, tokenIndex3165 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l3166
 						}
 						position++
-						goto l559
-					l560:
-						position, tokenIndex = position559, tokenIndex559
-						if buffer[position] != rune('T') {
-							goto l554
+						goto l3165
+					l3166:
+						position, tokenIndex = position3165, tokenIndex3165
+						if buffer[position] != rune('U') {
+							goto l3162
 						}
 						position++
 					}
-				l559:
+				l3165:
 					{
-						position561, tokenIndex561 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l562
+						position3167, tokenIndex3167 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3168
 						}
 						position++
-						goto l561
-					l562:
-						position, tokenIndex = position561, tokenIndex561
-						if buffer[position] != rune('W') {
-							goto l554
+						goto l3167
+					l3168:
+						position, tokenIndex = position3167, tokenIndex3167
+						if buffer[position] != rune('R') {
+							goto l3162
 						}
 						position++
 					}
-				l561:
-					goto l537
-				l554:
-					position, tokenIndex = position537, tokenIndex537
+				l3167:
 					{
-						position564, tokenIndex564 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l565
+						position3169, tokenIndex3169 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l3170
 						}
 						position++
-						goto l564
-					l565:
-						position, tokenIndex = position564, tokenIndex564
-						if buffer[position] != rune('U') {
-							goto l563
+						goto l3169
+					l3170:
+						position, tokenIndex = position3169, tokenIndex3169
+						if buffer[position] != rune('R') {
+							goto l3162
 						}
 						position++
 					}
-				l564:
+				l3169:
 					{
-						position566, tokenIndex566 := position, tokenIndex
-						if buffer[position] != rune('x') {
-							goto l567
+						position3171, tokenIndex3171 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3172
 						}
 						position++
-						goto l566
-					l567:
-						position, tokenIndex = position566, tokenIndex566
-						if buffer[position] != rune('X') {
-							goto l563
+						goto l3171
+					l3172:
+						position, tokenIndex = position3171, tokenIndex3171
+						if buffer[position] != rune('E') {
+							goto l3162
 						}
 						position++
 					}
-				l566:
+				l3171:
+					{
+						position3173, tokenIndex3173 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l3174
+						}
+						position++
+						goto l3173
+					l3174:
+						position, tokenIndex = position3173, tokenIndex3173
+						if buffer[position] != rune('N') {
+							goto l3162
+						}
+						position++
+					}
+				l3173:
 					{
-						position568, tokenIndex568 := position, tokenIndex
+						position3175, tokenIndex3175 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l569
+							goto l3176
 						}
 						position++
-						goto l568
-					l569:
-						position, tokenIndex = position568, tokenIndex568
+						goto l3175
+					l3176:
+						position, tokenIndex = position3175, tokenIndex3175
 						if buffer[position] != rune('T') {
-							goto l563
+							goto l3162
 						}
 						position++
 					}
-				l568:
+				l3175:
 					{
-						position570, tokenIndex570 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l571
+						position3177, tokenIndex3177 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3178
 						}
 						position++
-						goto l570
-					l571:
-						position, tokenIndex = position570, tokenIndex570
-						if buffer[position] != rune('B') {
-							goto l563
+						goto l3177
+					l3178:
+						position, tokenIndex = position3177, tokenIndex3177
+						if buffer[position] != rune('E') {
+							goto l3162
 						}
 						position++
 					}
-				l570:
-					goto l537
-				l563:
-					position, tokenIndex = position537, tokenIndex537
+				l3177:
 					{
-						position573, tokenIndex573 := position, tokenIndex
+						position3179, tokenIndex3179 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l574
+							goto l3180
 						}
 						position++
-						goto l573
-					l574:
-						position, tokenIndex = position573, tokenIndex573
+						goto l3179
+					l3180:
+						position, tokenIndex = position3179, tokenIndex3179
 						if buffer[position] != rune('L') {
-							goto l572
+							goto l3162
 						}
 						position++
 					}
-				l573:
+				l3179:
+					goto l2865
+				l3162:
+					position, tokenIndex = position2865, tokenIndex2865
+					if buffer[position] != rune('s') {
+						goto l2863
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('3') {
+						goto l2863
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2863
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('7') {
+						goto l2863
+					}
+					position++
+					if buffer[position] != rune('_') {
+						goto l2863
+					}
+					position++
 					{
-						position575, tokenIndex575 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l576
+						position3181, tokenIndex3181 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l3182
 						}
 						position++
-						goto l575
-					l576:
-						position, tokenIndex = position575, tokenIndex575
-						if buffer[position] != rune('S') {
-							goto l572
+						goto l3181
+					l3182:
+						position, tokenIndex = position3181, tokenIndex3181
+						if buffer[position] != rune('C') {
+							goto l2863
 						}
 						position++
 					}
-				l575:
+				l3181:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2863
+					}
+					position++
 					{
-						position577, tokenIndex577 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l578
-						}
-						position++
-						goto l577
-					l578:
-						position, tokenIndex = position577, tokenIndex577
-						if buffer[position] != rune('R') {
-							goto l572
+						position3183, tokenIndex3183 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3183
 						}
 						position++
+						goto l3184
+					l3183:
+						position, tokenIndex = position3183, tokenIndex3183
 					}
-				l577:
-					goto l537
-				l572:
-					position, tokenIndex = position537, tokenIndex537
+				l3184:
+					if buffer[position] != rune('_') {
+						goto l2863
+					}
+					position++
 					{
-						position580, tokenIndex580 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l581
+						position3185, tokenIndex3185 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l3186
 						}
 						position++
-						goto l580
-					l581:
-						position, tokenIndex = position580, tokenIndex580
-						if buffer[position] != rune('R') {
-							goto l579
+						goto l3185
+					l3186:
+						position, tokenIndex = position3185, tokenIndex3185
+						if buffer[position] != rune('C') {
+							goto l2863
 						}
 						position++
 					}
-				l580:
+				l3185:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2863
+					}
+					position++
 					{
-						position582, tokenIndex582 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l583
-						}
-						position++
-						goto l582
-					l583:
-						position, tokenIndex = position582, tokenIndex582
-						if buffer[position] != rune('O') {
-							goto l579
+						position3187, tokenIndex3187 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3187
 						}
 						position++
+						goto l3188
+					l3187:
+						position, tokenIndex = position3187, tokenIndex3187
 					}
-				l582:
+				l3188:
+					if buffer[position] != rune('_') {
+						goto l2863
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('7') {
+						goto l2863
+					}
+					position++
+				}
+			l2865:
+				{
+					position3189, tokenIndex3189 := position, tokenIndex
 					{
-						position584, tokenIndex584 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l585
+						position3190, tokenIndex3190 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l3191
 						}
 						position++
-						goto l584
-					l585:
-						position, tokenIndex = position584, tokenIndex584
-						if buffer[position] != rune('R') {
-							goto l579
+						goto l3190
+					l3191:
+						position, tokenIndex = position3190, tokenIndex3190
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l3192
 						}
 						position++
-					}
-				l584:
-					goto l537
-				l579:
-					position, tokenIndex = position537, tokenIndex537
-					{
-						position586, tokenIndex586 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l587
+						goto l3190
+					l3192:
+						position, tokenIndex = position3190, tokenIndex3190
+						{
+							position3194, tokenIndex3194 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l3195
+							}
+							position++
+							goto l3194
+						l3195:
+							position, tokenIndex = position3194, tokenIndex3194
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l3193
+							}
+							position++
 						}
-						position++
-						goto l586
-					l587:
-						position, tokenIndex = position586, tokenIndex586
-						if buffer[position] != rune('A') {
-							goto l535
+					l3194:
+						goto l3190
+					l3193:
+						position, tokenIndex = position3190, tokenIndex3190
+						if buffer[position] != rune('_') {
+							goto l3196
 						}
 						position++
-					}
-				l586:
-					{
-						position588, tokenIndex588 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l589
+						goto l3190
+					l3196:
+						position, tokenIndex = position3190, tokenIndex3190
+						if buffer[position] != rune('(') {
+							goto l3197
 						}
 						position++
-						goto l588
-					l589:
-						position, tokenIndex = position588, tokenIndex588
-						if buffer[position] != rune('S') {
-							goto l535
+						goto l3190
+					l3197:
+						position, tokenIndex = position3190, tokenIndex3190
+						if buffer[position] != rune('+') {
+							goto l3198
 						}
 						position++
-					}
-				l588:
-					{
-						position590, tokenIndex590 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l591
+						goto l3190
+					l3198:
+						position, tokenIndex = position3190, tokenIndex3190
+						if buffer[position] != rune('-') {
+							goto l3199
 						}
 						position++
-						goto l590
-					l591:
-						position, tokenIndex = position590, tokenIndex590
-						if buffer[position] != rune('R') {
-							goto l535
+						goto l3190
+					l3199:
+						position, tokenIndex = position3190, tokenIndex3190
+						if buffer[position] != rune('@') {
+							goto l3189
 						}
 						position++
 					}
-				l590:
+				l3190:
+					goto l2863
+				l3189:
+					position, tokenIndex = position3189, tokenIndex3189
+				}
+				add(ruleARMSystemRegister, position2864)
+			}
+			return true
+		l2863:
+			position, tokenIndex = position2863, tokenIndex2863
+			return false
+		},
+		/* 105 ARMLiteralPoolOperand <- <('=' (Offset / LocalSymbol / SymbolName))> */
+		func() bool {
+			position3200, tokenIndex3200 := position, tokenIndex
+			{
+				position3201 := position
+				if buffer[position] != rune('=') {
+					goto l3200
 				}
-			l537:
+				position++
 				{
-					position592, tokenIndex592 := position, tokenIndex
-					if !_rules[ruleWS]() {
-						goto l592
+					position3202, tokenIndex3202 := position, tokenIndex
+					if !_rules[ruleOffset]() {
+						goto l3203
 					}
-					if buffer[position] != rune('#') {
-						goto l592
+					goto l3202
+				l3203:
+					position, tokenIndex = position3202, tokenIndex3202
+					if !_rules[ruleLocalSymbol]() {
+						goto l3204
 					}
-					position++
-					if !_rules[ruleOffset]() {
-						goto l592
+					goto l3202
+				l3204:
+					position, tokenIndex = position3202, tokenIndex3202
+					if !_rules[ruleSymbolName]() {
+						goto l3200
 					}
-					goto l593
-				l592:
-					position, tokenIndex = position592, tokenIndex592
 				}
-			l593:
-				add(ruleARMConstantTweak, position536)
+			l3202:
+				add(ruleARMLiteralPoolOperand, position3201)
 			}
 			return true
-		l535:
-			position, tokenIndex = position535, tokenIndex535
+		l3200:
+			position, tokenIndex = position3200, tokenIndex3200
 			return false
 		},
-		/* 38 ARMRegister <- <((('s' / 'S') ('p' / 'P')) / (('x' / 'w' / 'd' / 'q' / 's') [0-9] [0-9]?) / (('x' / 'X') ('z' / 'Z') ('r' / 'R')) / (('w' / 'W') ('z' / 'Z') ('r' / 'R')) / ARMVectorRegister / ('{' WS? ARMVectorRegister (',' WS? ARMVectorRegister)* WS? '}' ('[' [0-9] ']')?))> */
+		/* 106 ARMRegister <- <((('s' / 'S') ('p' / 'P')) / (('x' / 'w' / 'd' / 'q' / 's') [0-9] [0-9]?) / (('x' / 'X') ('z' / 'Z') ('r' / 'R')) / (('w' / 'W') ('z' / 'Z') ('r' / 'R')) / ARMVectorRegister / ('{' WS? ARMVectorRegister (',' WS? ARMVectorRegister)* WS? '}' ('[' [0-9] ']')?))> */
 		func() bool {
-			position594, tokenIndex594 := position, tokenIndex
+			position3205, tokenIndex3205 := position, tokenIndex
 			{
-				position595 := position
+				position3206 := position
 				{
-					position596, tokenIndex596 := position, tokenIndex
+					position3207, tokenIndex3207 := position, tokenIndex
 					{
-						position598, tokenIndex598 := position, tokenIndex
+						position3209, tokenIndex3209 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l599
+							goto l3210
 						}
 						position++
-						goto l598
-					l599:
-						position, tokenIndex = position598, tokenIndex598
+						goto l3209
+					l3210:
+						position, tokenIndex = position3209, tokenIndex3209
 						if buffer[position] != rune('S') {
-							goto l597
+							goto l3208
 						}
 						position++
 					}
-				l598:
+				l3209:
 					{
-						position600, tokenIndex600 := position, tokenIndex
+						position3211, tokenIndex3211 := position, tokenIndex
 						if buffer[position] != rune('p') {
-							goto l601
+							goto l3212
 						}
 						position++
-						goto l600
-					l601:
-						position, tokenIndex = position600, tokenIndex600
+						goto l3211
+					l3212:
+						position, tokenIndex = position3211, tokenIndex3211
 						if buffer[position] != rune('P') {
-							goto l597
+							goto l3208
 						}
 						position++
 					}
-				l600:
-					goto l596
-				l597:
-					position, tokenIndex = position596, tokenIndex596
+				l3211:
+					goto l3207
+				l3208:
+					position, tokenIndex = position3207, tokenIndex3207
 					{
-						position603, tokenIndex603 := position, tokenIndex
+						position3214, tokenIndex3214 := position, tokenIndex
 						if buffer[position] != rune('x') {
-							goto l604
+							goto l3215
 						}
 						position++
-						goto l603
-					l604:
-						position, tokenIndex = position603, tokenIndex603
+						goto l3214
+					l3215:
+						position, tokenIndex = position3214, tokenIndex3214
 						if buffer[position] != rune('w') {
-							goto l605
+							goto l3216
 						}
 						position++
-						goto l603
-					l605:
-						position, tokenIndex = position603, tokenIndex603
+						goto l3214
+					l3216:
+						position, tokenIndex = position3214, tokenIndex3214
 						if buffer[position] != rune('d') {
-							goto l606
+							goto l3217
 						}
 						position++
-						goto l603
-					l606:
-						position, tokenIndex = position603, tokenIndex603
+						goto l3214
+					l3217:
+						position, tokenIndex = position3214, tokenIndex3214
 						if buffer[position] != rune('q') {
-							goto l607
+							goto l3218
 						}
 						position++
-						goto l603
-					l607:
-						position, tokenIndex = position603, tokenIndex603
+						goto l3214
+					l3218:
+						position, tokenIndex = position3214, tokenIndex3214
 						if buffer[position] != rune('s') {
-							goto l602
+							goto l3213
 						}
 						position++
 					}
-				l603:
+				l3214:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l602
+						goto l3213
 					}
 					position++
 					{
-						position608, tokenIndex608 := position, tokenIndex
+						position3219, tokenIndex3219 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l608
+							goto l3219
 						}
 						position++
-						goto l609
-					l608:
-						position, tokenIndex = position608, tokenIndex608
+						goto l3220
+					l3219:
+						position, tokenIndex = position3219, tokenIndex3219
 					}
-				l609:
-					goto l596
-				l602:
-					position, tokenIndex = position596, tokenIndex596
+				l3220:
+					goto l3207
+				l3213:
+					position, tokenIndex = position3207, tokenIndex3207
 					{
-						position611, tokenIndex611 := position, tokenIndex
+						position3222, tokenIndex3222 := position, tokenIndex
 						if buffer[position] != rune('x') {
-							goto l612
+							goto l3223
 						}
 						position++
-						goto l611
-					l612:
-						position, tokenIndex = position611, tokenIndex611
+						goto l3222
+					l3223:
+						position, tokenIndex = position3222, tokenIndex3222
 						if buffer[position] != rune('X') {
-							goto l610
+							goto l3221
 						}
 						position++
 					}
-				l611:
+				l3222:
 					{
-						position613, tokenIndex613 := position, tokenIndex
+						position3224, tokenIndex3224 := position, tokenIndex
 						if buffer[position] != rune('z') {
-							goto l614
+							goto l3225
 						}
 						position++
-						goto l613
-					l614:
-						position, tokenIndex = position613, tokenIndex613
+						goto l3224
+					l3225:
+						position, tokenIndex = position3224, tokenIndex3224
 						if buffer[position] != rune('Z') {
-							goto l610
+							goto l3221
 						}
 						position++
 					}
-				l613:
+				l3224:
 					{
-						position615, tokenIndex615 := position, tokenIndex
+						position3226, tokenIndex3226 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l616
+							goto l3227
 						}
 						position++
-						goto l615
-					l616:
-						position, tokenIndex = position615, tokenIndex615
+						goto l3226
+					l3227:
+						position, tokenIndex = position3226, tokenIndex3226
 						if buffer[position] != rune('R') {
-							goto l610
+							goto l3221
 						}
 						position++
 					}
-				l615:
-					goto l596
-				l610:
-					position, tokenIndex = position596, tokenIndex596
+				l3226:
+					goto l3207
+				l3221:
+					position, tokenIndex = position3207, tokenIndex3207
 					{
-						position618, tokenIndex618 := position, tokenIndex
+						position3229, tokenIndex3229 := position, tokenIndex
 						if buffer[position] != rune('w') {
-							goto l619
+							goto l3230
 						}
 						position++
-						goto l618
-					l619:
-						position, tokenIndex = position618, tokenIndex618
+						goto l3229
+					l3230:
+						position, tokenIndex = position3229, tokenIndex3229
 						if buffer[position] != rune('W') {
-							goto l617
+							goto l3228
 						}
 						position++
 					}
-				l618:
+				l3229:
 					{
-						position620, tokenIndex620 := position, tokenIndex
+						position3231, tokenIndex3231 := position, tokenIndex
 						if buffer[position] != rune('z') {
-							goto l621
+							goto l3232
 						}
 						position++
-						goto l620
-					l621:
-						position, tokenIndex = position620, tokenIndex620
+						goto l3231
+					l3232:
+						position, tokenIndex = position3231, tokenIndex3231
 						if buffer[position] != rune('Z') {
-							goto l617
+							goto l3228
 						}
 						position++
 					}
-				l620:
+				l3231:
 					{
-						position622, tokenIndex622 := position, tokenIndex
+						position3233, tokenIndex3233 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l623
+							goto l3234
 						}
 						position++
-						goto l622
-					l623:
-						position, tokenIndex = position622, tokenIndex622
+						goto l3233
+					l3234:
+						position, tokenIndex = position3233, tokenIndex3233
 						if buffer[position] != rune('R') {
-							goto l617
+							goto l3228
 						}
 						position++
 					}
-				l622:
-					goto l596
-				l617:
-					position, tokenIndex = position596, tokenIndex596
+				l3233:
+					goto l3207
+				l3228:
+					position, tokenIndex = position3207, tokenIndex3207
 					if !_rules[ruleARMVectorRegister]() {
-						goto l624
+						goto l3235
 					}
-					goto l596
-				l624:
-					position, tokenIndex = position596, tokenIndex596
+					goto l3207
+				l3235:
+					position, tokenIndex = position3207, tokenIndex3207
 					if buffer[position] != rune('{') {
-						goto l594
+						goto l3205
 					}
 					position++
 					{
-						position625, tokenIndex625 := position, tokenIndex
+						position3236, tokenIndex3236 := position, tokenIndex
 						if !_rules[ruleWS]() {
-							goto l625
+							goto l3236
 						}
-						goto l626
-					l625:
-						position, tokenIndex = position625, tokenIndex625
+						goto l3237
+					l3236:
+						position, tokenIndex = position3236, tokenIndex3236
 					}
-				l626:
+				l3237:
 					if !_rules[ruleARMVectorRegister]() {
-						goto l594
+						goto l3205
 					}
-				l627:
+				l3238:
 					{
-						position628, tokenIndex628 := position, tokenIndex
+						position3239, tokenIndex3239 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l628
+							goto l3239
 						}
 						position++
 						{
-							position629, tokenIndex629 := position, tokenIndex
+							position3240, tokenIndex3240 := position, tokenIndex
 							if !_rules[ruleWS]() {
-								goto l629
+								goto l3240
 							}
-							goto l630
-						l629:
-							position, tokenIndex = position629, tokenIndex629
+							goto l3241
+						l3240:
+							position, tokenIndex = position3240, tokenIndex3240
 						}
-					l630:
+					l3241:
 						if !_rules[ruleARMVectorRegister]() {
-							goto l628
+							goto l3239
 						}
-						goto l627
-					l628:
-						position, tokenIndex = position628, tokenIndex628
+						goto l3238
+					l3239:
+						position, tokenIndex = position3239, tokenIndex3239
 					}
 					{
-						position631, tokenIndex631 := position, tokenIndex
+						position3242, tokenIndex3242 := position, tokenIndex
 						if !_rules[ruleWS]() {
-							goto l631
+							goto l3242
 						}
-						goto l632
-					l631:
-						position, tokenIndex = position631, tokenIndex631
+						goto l3243
+					l3242:
+						position, tokenIndex = position3242, tokenIndex3242
 					}
-				l632:
+				l3243:
 					if buffer[position] != rune('}') {
-						goto l594
+						goto l3205
 					}
 					position++
 					{
-						position633, tokenIndex633 := position, tokenIndex
+						position3244, tokenIndex3244 := position, tokenIndex
 						if buffer[position] != rune('[') {
-							goto l633
+							goto l3244
 						}
 						position++
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l633
+							goto l3244
 						}
 						position++
 						if buffer[position] != rune(']') {
-							goto l633
+							goto l3244
 						}
 						position++
-						goto l634
-					l633:
-						position, tokenIndex = position633, tokenIndex633
+						goto l3245
+					l3244:
+						position, tokenIndex = position3244, tokenIndex3244
 					}
-				l634:
+				l3245:
 				}
-			l596:
-				add(ruleARMRegister, position595)
+			l3207:
+				add(ruleARMRegister, position3206)
 			}
 			return true
-		l594:
-			position, tokenIndex = position594, tokenIndex594
+		l3205:
+			position, tokenIndex = position3205, tokenIndex3205
 			return false
 		},
-		/* 39 ARMVectorRegister <- <(('v' / 'V') [0-9] [0-9]? ('.' [0-9]* ('b' / 's' / 'd' / 'h' / 'q') ('[' [0-9] [0-9]? ']')?)?)> */
+		/* 107 ARMVectorRegister <- <(('v' / 'V') [0-9] [0-9]? ('.' [0-9]* ('b' / 's' / 'd' / 'h' / 'q') ('[' [0-9] [0-9]? ']')?)?)> */
 		func() bool {
-			position635, tokenIndex635 := position, tokenIndex
+			position3246, tokenIndex3246 := position, tokenIndex
 			{
-				position636 := position
+				position3247 := position
 				{
-					position637, tokenIndex637 := position, tokenIndex
+					position3248, tokenIndex3248 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l638
+						goto l3249
 					}
 					position++
-					goto l637
-				l638:
-					position, tokenIndex = position637, tokenIndex637
+					goto l3248
+				l3249:
+					position, tokenIndex = position3248, tokenIndex3248
 					if buffer[position] != rune('V') {
-						goto l635
+						goto l3246
 					}
 					position++
 				}
-			l637:
+			l3248:
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l635
+					goto l3246
 				}
 				position++
 				{
-					position639, tokenIndex639 := position, tokenIndex
+					position3250, tokenIndex3250 := position, tokenIndex
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l639
+						goto l3250
 					}
 					position++
-					goto l640
-				l639:
-					position, tokenIndex = position639, tokenIndex639
+					goto l3251
+				l3250:
+					position, tokenIndex = position3250, tokenIndex3250
 				}
-			l640:
+			l3251:
 				{
-					position641, tokenIndex641 := position, tokenIndex
+					position3252, tokenIndex3252 := position, tokenIndex
 					if buffer[position] != rune('.') {
-						goto l641
+						goto l3252
 					}
 					position++
-				l643:
+				l3254:
 					{
-						position644, tokenIndex644 := position, tokenIndex
+						position3255, tokenIndex3255 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l644
+							goto l3255
 						}
 						position++
-						goto l643
-					l644:
-						position, tokenIndex = position644, tokenIndex644
+						goto l3254
+					l3255:
+						position, tokenIndex = position3255, tokenIndex3255
 					}
 					{
-						position645, tokenIndex645 := position, tokenIndex
+						position3256, tokenIndex3256 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l646
+							goto l3257
 						}
 						position++
-						goto l645
-					l646:
-						position, tokenIndex = position645, tokenIndex645
+						goto l3256
+					l3257:
+						position, tokenIndex = position3256, tokenIndex3256
 						if buffer[position] != rune('s') {
-							goto l647
+							goto l3258
 						}
 						position++
-						goto l645
-					l647:
-						position, tokenIndex = position645, tokenIndex645
+						goto l3256
+					l3258:
+						position, tokenIndex = position3256, tokenIndex3256
 						if buffer[position] != rune('d') {
-							goto l648
+							goto l3259
 						}
 						position++
-						goto l645
-					l648:
-						position, tokenIndex = position645, tokenIndex645
+						goto l3256
+					l3259:
+						position, tokenIndex = position3256, tokenIndex3256
 						if buffer[position] != rune('h') {
-							goto l649
+							goto l3260
 						}
 						position++
-						goto l645
-					l649:
-						position, tokenIndex = position645, tokenIndex645
+						goto l3256
+					l3260:
+						position, tokenIndex = position3256, tokenIndex3256
 						if buffer[position] != rune('q') {
-							goto l641
+							goto l3252
 						}
 						position++
 					}
-				l645:
+				l3256:
 					{
-						position650, tokenIndex650 := position, tokenIndex
+						position3261, tokenIndex3261 := position, tokenIndex
 						if buffer[position] != rune('[') {
-							goto l650
+							goto l3261
 						}
 						position++
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l650
+							goto l3261
 						}
 						position++
 						{
-							position652, tokenIndex652 := position, tokenIndex
+							position3263, tokenIndex3263 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l652
+								goto l3263
 							}
 							position++
-							goto l653
-						l652:
-							position, tokenIndex = position652, tokenIndex652
+							goto l3264
+						l3263:
+							position, tokenIndex = position3263, tokenIndex3263
 						}
-					l653:
+					l3264:
 						if buffer[position] != rune(']') {
-							goto l650
+							goto l3261
 						}
 						position++
-						goto l651
-					l650:
-						position, tokenIndex = position650, tokenIndex650
+						goto l3262
+					l3261:
+						position, tokenIndex = position3261, tokenIndex3261
 					}
-				l651:
-					goto l642
-				l641:
-					position, tokenIndex = position641, tokenIndex641
+				l3262:
+					goto l3253
+				l3252:
+					position, tokenIndex = position3252, tokenIndex3252
 				}
-			l642:
-				add(ruleARMVectorRegister, position636)
+			l3253:
+				add(ruleARMVectorRegister, position3247)
 			}
 			return true
-		l635:
-			position, tokenIndex = position635, tokenIndex635
+		l3246:
+			position, tokenIndex = position3246, tokenIndex3246
 			return false
 		},
-		/* 40 MemoryRef <- <((SymbolRef BaseIndexScale) / SymbolRef / Low12BitsSymbolRef / (Offset* BaseIndexScale) / (SegmentRegister Offset BaseIndexScale) / (SegmentRegister BaseIndexScale) / (SegmentRegister Offset) / ARMBaseIndexScale / BaseIndexScale)> */
+		/* 108 MemoryRef <- <((SymbolRef BaseIndexScale) / SymbolRef / Low12BitsSymbolRef / (Offset* BaseIndexScale) / (SegmentRegister Offset BaseIndexScale) / (SegmentRegister BaseIndexScale) / (SegmentRegister Offset) / ARMBaseIndexScale / BaseIndexScale)> */
 		func() bool {
-			position654, tokenIndex654 := position, tokenIndex
+			position3265, tokenIndex3265 := position, tokenIndex
 			{
-				position655 := position
+				position3266 := position
 				{
-					position656, tokenIndex656 := position, tokenIndex
+					position3267, tokenIndex3267 := position, tokenIndex
 					if !_rules[ruleSymbolRef]() {
-						goto l657
+						goto l3268
 					}
 					if !_rules[ruleBaseIndexScale]() {
-						goto l657
+						goto l3268
 					}
-					goto l656
-				l657:
-					position, tokenIndex = position656, tokenIndex656
+					goto l3267
+				l3268:
+					position, tokenIndex = position3267, tokenIndex3267
 					if !_rules[ruleSymbolRef]() {
-						goto l658
+						goto l3269
 					}
-					goto l656
-				l658:
-					position, tokenIndex = position656, tokenIndex656
+					goto l3267
+				l3269:
+					position, tokenIndex = position3267, tokenIndex3267
 					if !_rules[ruleLow12BitsSymbolRef]() {
-						goto l659
+						goto l3270
 					}
-					goto l656
-				l659:
-					position, tokenIndex = position656, tokenIndex656
-				l661:
+					goto l3267
+				l3270:
+					position, tokenIndex = position3267, tokenIndex3267
+				l3272:
 					{
-						position662, tokenIndex662 := position, tokenIndex
+						position3273, tokenIndex3273 := position, tokenIndex
 						if !_rules[ruleOffset]() {
-							goto l662
+							goto l3273
 						}
-						goto l661
-					l662:
-						position, tokenIndex = position662, tokenIndex662
+						goto l3272
+					l3273:
+						position, tokenIndex = position3273, tokenIndex3273
 					}
 					if !_rules[ruleBaseIndexScale]() {
-						goto l660
+						goto l3271
 					}
-					goto l656
-				l660:
-					position, tokenIndex = position656, tokenIndex656
+					goto l3267
+				l3271:
+					position, tokenIndex = position3267, tokenIndex3267
 					if !_rules[ruleSegmentRegister]() {
-						goto l663
+						goto l3274
 					}
 					if !_rules[ruleOffset]() {
-						goto l663
+						goto l3274
 					}
 					if !_rules[ruleBaseIndexScale]() {
-						goto l663
+						goto l3274
 					}
-					goto l656
-				l663:
-					position, tokenIndex = position656, tokenIndex656
+					goto l3267
+				l3274:
+					position, tokenIndex = position3267, tokenIndex3267
 					if !_rules[ruleSegmentRegister]() {
-						goto l664
+						goto l3275
 					}
 					if !_rules[ruleBaseIndexScale]() {
-						goto l664
+						goto l3275
 					}
-					goto l656
-				l664:
-					position, tokenIndex = position656, tokenIndex656
+					goto l3267
+				l3275:
+					position, tokenIndex = position3267, tokenIndex3267
 					if !_rules[ruleSegmentRegister]() {
-						goto l665
+						goto l3276
 					}
 					if !_rules[ruleOffset]() {
-						goto l665
+						goto l3276
 					}
-					goto l656
-				l665:
-					position, tokenIndex = position656, tokenIndex656
+					goto l3267
+				l3276:
+					position, tokenIndex = position3267, tokenIndex3267
 					if !_rules[ruleARMBaseIndexScale]() {
-						goto l666
+						goto l3277
 					}
-					goto l656
-				l666:
-					position, tokenIndex = position656, tokenIndex656
+					goto l3267
+				l3277:
+					position, tokenIndex = position3267, tokenIndex3267
 					if !_rules[ruleBaseIndexScale]() {
-						goto l654
+						goto l3265
 					}
 				}
-			l656:
-				add(ruleMemoryRef, position655)
+			l3267:
+				add(ruleMemoryRef, position3266)
 			}
 			return true
-		l654:
-			position, tokenIndex = position654, tokenIndex654
+		l3265:
+			position, tokenIndex = position3265, tokenIndex3265
 			return false
 		},
-		/* 41 SymbolRef <- <((Offset* '+')? (LocalSymbol / SymbolName) Offset* ('@' Section Offset*)?)> */
+		/* 109 SymbolRef <- <((Offset* '+')? (LocalSymbol / SymbolName) Offset* ('@' Section Offset*)?)> */
 		func() bool {
-			position667, tokenIndex667 := position, tokenIndex
+			position3278, tokenIndex3278 := position, tokenIndex
 			{
-				position668 := position
+				position3279 := position
 				{
-					position669, tokenIndex669 := position, tokenIndex
-				l671:
+					position3280, tokenIndex3280 := position, tokenIndex
+				l3282:
 					{
-						position672, tokenIndex672 := position, tokenIndex
+						position3283, tokenIndex3283 := position, tokenIndex
 						if !_rules[ruleOffset]() {
-							goto l672
+							goto l3283
 						}
-						goto l671
-					l672:
-						position, tokenIndex = position672, tokenIndex672
+						goto l3282
+					l3283:
+						position, tokenIndex = position3283, tokenIndex3283
 					}
 					if buffer[position] != rune('+') {
-						goto l669
+						goto l3280
 					}
 					position++
-					goto l670
-				l669:
-					position, tokenIndex = position669, tokenIndex669
+					goto l3281
+				l3280:
+					position, tokenIndex = position3280, tokenIndex3280
 				}
-			l670:
+			l3281:
 				{
-					position673, tokenIndex673 := position, tokenIndex
+					position3284, tokenIndex3284 := position, tokenIndex
 					if !_rules[ruleLocalSymbol]() {
-						goto l674
+						goto l3285
 					}
-					goto l673
-				l674:
-					position, tokenIndex = position673, tokenIndex673
+					goto l3284
+				l3285:
+					position, tokenIndex = position3284, tokenIndex3284
 					if !_rules[ruleSymbolName]() {
-						goto l667
+						goto l3278
 					}
 				}
-			l673:
-			l675:
+			l3284:
+			l3286:
 				{
-					position676, tokenIndex676 := position, tokenIndex
+					position3287, tokenIndex3287 := position, tokenIndex
 					if !_rules[ruleOffset]() {
-						goto l676
+						goto l3287
 					}
-					goto l675
-				l676:
-					position, tokenIndex = position676, tokenIndex676
+					goto l3286
+				l3287:
+					position, tokenIndex = position3287, tokenIndex3287
 				}
 				{
-					position677, tokenIndex677 := position, tokenIndex
+					position3288, tokenIndex3288 := position, tokenIndex
 					if buffer[position] != rune('@') {
-						goto l677
+						goto l3288
 					}
 					position++
 					if !_rules[ruleSection]() {
-						goto l677
+						goto l3288
 					}
-				l679:
+				l3290:
 					{
-						position680, tokenIndex680 := position, tokenIndex
+						position3291, tokenIndex3291 := position, tokenIndex
 						if !_rules[ruleOffset]() {
-							goto l680
+							goto l3291
 						}
-						goto l679
-					l680:
-						position, tokenIndex = position680, tokenIndex680
+						goto l3290
+					l3291:
+						position, tokenIndex = position3291, tokenIndex3291
 					}
-					goto l678
-				l677:
-					position, tokenIndex = position677, tokenIndex677
+					goto l3289
+				l3288:
+					position, tokenIndex = position3288, tokenIndex3288
 				}
-			l678:
-				add(ruleSymbolRef, position668)
+			l3289:
+				add(ruleSymbolRef, position3279)
 			}
 			return true
-		l667:
-			position, tokenIndex = position667, tokenIndex667
+		l3278:
+			position, tokenIndex = position3278, tokenIndex3278
 			return false
 		},
-		/* 42 Low12BitsSymbolRef <- <(':' ('l' / 'L') ('o' / 'O') '1' '2' ':' (LocalSymbol / SymbolName) Offset?)> */
+		/* 110 Low12BitsSymbolRef <- <(':' ('l' / 'L') ('o' / 'O') '1' '2' ':' (LocalSymbol / SymbolName) Offset?)> */
 		func() bool {
-			position681, tokenIndex681 := position, tokenIndex
+			position3292, tokenIndex3292 := position, tokenIndex
 			{
-				position682 := position
+				position3293 := position
 				if buffer[position] != rune(':') {
-					goto l681
+					goto l3292
 				}
 				position++
 				{
-					position683, tokenIndex683 := position, tokenIndex
+					position3294, tokenIndex3294 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l684
+						goto l3295
 					}
 					position++
-					goto l683
-				l684:
-					position, tokenIndex = position683, tokenIndex683
+					goto l3294
+				l3295:
+					position, tokenIndex = position3294, tokenIndex3294
 					if buffer[position] != rune('L') {
-						goto l681
+						goto l3292
 					}
 					position++
 				}
-			l683:
+			l3294:
 				{
-					position685, tokenIndex685 := position, tokenIndex
+					position3296, tokenIndex3296 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l686
+						goto l3297
 					}
 					position++
-					goto l685
-				l686:
-					position, tokenIndex = position685, tokenIndex685
+					goto l3296
+				l3297:
+					position, tokenIndex = position3296, tokenIndex3296
 					if buffer[position] != rune('O') {
-						goto l681
+						goto l3292
 					}
 					position++
 				}
-			l685:
+			l3296:
 				if buffer[position] != rune('1') {
-					goto l681
+					goto l3292
 				}
 				position++
 				if buffer[position] != rune('2') {
-					goto l681
+					goto l3292
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l681
+					goto l3292
 				}
 				position++
 				{
-					position687, tokenIndex687 := position, tokenIndex
+					position3298, tokenIndex3298 := position, tokenIndex
 					if !_rules[ruleLocalSymbol]() {
-						goto l688
+						goto l3299
 					}
-					goto l687
-				l688:
-					position, tokenIndex = position687, tokenIndex687
+					goto l3298
+				l3299:
+					position, tokenIndex = position3298, tokenIndex3298
 					if !_rules[ruleSymbolName]() {
-						goto l681
+						goto l3292
 					}
 				}
-			l687:
+			l3298:
 				{
-					position689, tokenIndex689 := position, tokenIndex
+					position3300, tokenIndex3300 := position, tokenIndex
 					if !_rules[ruleOffset]() {
-						goto l689
+						goto l3300
 					}
-					goto l690
-				l689:
-					position, tokenIndex = position689, tokenIndex689
+					goto l3301
+				l3300:
+					position, tokenIndex = position3300, tokenIndex3300
 				}
-			l690:
-				add(ruleLow12BitsSymbolRef, position682)
+			l3301:
+				add(ruleLow12BitsSymbolRef, position3293)
 			}
 			return true
-		l681:
-			position, tokenIndex = position681, tokenIndex681
+		l3292:
+			position, tokenIndex = position3292, tokenIndex3292
 			return false
 		},
-		/* 43 ARMBaseIndexScale <- <('[' ARMRegister (',' WS? (('#' Offset ('*' [0-9]+)?) / ARMGOTLow12 / Low12BitsSymbolRef / ARMRegister) (',' WS? ARMConstantTweak)?)? ']' ARMPostincrement?)> */
+		/* 111 ARMBaseIndexScale <- <('[' ARMRegister (',' WS? (('#' Offset ('*' [0-9]+)?) / ARMGOTLow12 / Low12BitsSymbolRef / ARMRegister) (',' WS? ARMConstantTweak)?)? ']' ARMPostincrement?)> */
 		func() bool {
-			position691, tokenIndex691 := position, tokenIndex
+			position3302, tokenIndex3302 := position, tokenIndex
 			{
-				position692 := position
+				position3303 := position
 				if buffer[position] != rune('[') {
-					goto l691
+					goto l3302
 				}
 				position++
 				if !_rules[ruleARMRegister]() {
-					goto l691
+					goto l3302
 				}
 				{
-					position693, tokenIndex693 := position, tokenIndex
+					position3304, tokenIndex3304 := position, tokenIndex
 					if buffer[position] != rune(',') {
-						goto l693
+						goto l3304
 					}
 					position++
 					{
-						position695, tokenIndex695 := position, tokenIndex
+						position3306, tokenIndex3306 := position, tokenIndex
 						if !_rules[ruleWS]() {
-							goto l695
+							goto l3306
 						}
-						goto l696
-					l695:
-						position, tokenIndex = position695, tokenIndex695
+						goto l3307
+					l3306:
+						position, tokenIndex = position3306, tokenIndex3306
 					}
-				l696:
+				l3307:
 					{
-						position697, tokenIndex697 := position, tokenIndex
+						position3308, tokenIndex3308 := position, tokenIndex
 						if buffer[position] != rune('#') {
-							goto l698
+							goto l3309
 						}
 						position++
 						if !_rules[ruleOffset]() {
-							goto l698
+							goto l3309
 						}
 						{
-							position699, tokenIndex699 := position, tokenIndex
+							position3310, tokenIndex3310 := position, tokenIndex
 							if buffer[position] != rune('*') {
-								goto l699
+								goto l3310
 							}
 							position++
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l699
+								goto l3310
 							}
 							position++
-						l701:
+						l3312:
 							{
-								position702, tokenIndex702 := position, tokenIndex
+								position3313, tokenIndex3313 := position, tokenIndex
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l702
+									goto l3313
 								}
 								position++
-								goto l701
-							l702:
-								position, tokenIndex = position702, tokenIndex702
+								goto l3312
+							l3313:
+								position, tokenIndex = position3313, tokenIndex3313
 							}
-							goto l700
-						l699:
-							position, tokenIndex = position699, tokenIndex699
-						}
-					l700:
-						goto l697
-					l698:
-						position, tokenIndex = position697, tokenIndex697
+							goto l3311
+						l3310:
+							position, tokenIndex = position3310, tokenIndex3310
+						}
+					l3311:
+						goto l3308
+					l3309:
+						position, tokenIndex = position3308, tokenIndex3308
 						if !_rules[ruleARMGOTLow12]() {
-							goto l703
+							goto l3314
 						}
-						goto l697
-					l703:
-						position, tokenIndex = position697, tokenIndex697
+						goto l3308
+					l3314:
+						position, tokenIndex = position3308, tokenIndex3308
 						if !_rules[ruleLow12BitsSymbolRef]() {
-							goto l704
+							goto l3315
 						}
-						goto l697
-					l704:
-						position, tokenIndex = position697, tokenIndex697
+						goto l3308
+					l3315:
+						position, tokenIndex = position3308, tokenIndex3308
 						if !_rules[ruleARMRegister]() {
-							goto l693
+							goto l3304
 						}
 					}
-				l697:
+				l3308:
 					{
-						position705, tokenIndex705 := position, tokenIndex
+						position3316, tokenIndex3316 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l705
+							goto l3316
 						}
 						position++
 						{
-							position707, tokenIndex707 := position, tokenIndex
+							position3318, tokenIndex3318 := position, tokenIndex
 							if !_rules[ruleWS]() {
-								goto l707
+								goto l3318
 							}
-							goto l708
-						l707:
-							position, tokenIndex = position707, tokenIndex707
+							goto l3319
+						l3318:
+							position, tokenIndex = position3318, tokenIndex3318
 						}
-					l708:
+					l3319:
 						if !_rules[ruleARMConstantTweak]() {
-							goto l705
+							goto l3316
 						}
-						goto l706
-					l705:
-						position, tokenIndex = position705, tokenIndex705
+						goto l3317
+					l3316:
+						position, tokenIndex = position3316, tokenIndex3316
 					}
-				l706:
-					goto l694
-				l693:
-					position, tokenIndex = position693, tokenIndex693
+				l3317:
+					goto l3305
+				l3304:
+					position, tokenIndex = position3304, tokenIndex3304
 				}
-			l694:
+			l3305:
 				if buffer[position] != rune(']') {
-					goto l691
+					goto l3302
 				}
 				position++
 				{
-					position709, tokenIndex709 := position, tokenIndex
+					position3320, tokenIndex3320 := position, tokenIndex
 					if !_rules[ruleARMPostincrement]() {
-						goto l709
+						goto l3320
 					}
-					goto l710
-				l709:
-					position, tokenIndex = position709, tokenIndex709
+					goto l3321
+				l3320:
+					position, tokenIndex = position3320, tokenIndex3320
 				}
-			l710:
-				add(ruleARMBaseIndexScale, position692)
+			l3321:
+				add(ruleARMBaseIndexScale, position3303)
 			}
 			return true
-		l691:
-			position, tokenIndex = position691, tokenIndex691
+		l3302:
+			position, tokenIndex = position3302, tokenIndex3302
 			return false
 		},
-		/* 44 ARMGOTLow12 <- <(':' ('g' / 'G') ('o' / 'O') ('t' / 'T') '_' ('l' / 'L') ('o' / 'O') '1' '2' ':' SymbolName)> */
+		/* 112 ARMGOTLow12 <- <(':' ('g' / 'G') ('o' / 'O') ('t' / 'T') '_' ('l' / 'L') ('o' / 'O') '1' '2' ':' SymbolName)> */
 		func() bool {
-			position711, tokenIndex711 := position, tokenIndex
+			position3322, tokenIndex3322 := position, tokenIndex
 			{
-				position712 := position
+				position3323 := position
 				if buffer[position] != rune(':') {
-					goto l711
+					goto l3322
 				}
 				position++
 				{
-					position713, tokenIndex713 := position, tokenIndex
+					position3324, tokenIndex3324 := position, tokenIndex
 					if buffer[position] != rune('g') {
-						goto l714
+						goto l3325
 					}
 					position++
-					goto l713
-				l714:
-					position, tokenIndex = position713, tokenIndex713
+					goto l3324
+				l3325:
+					position, tokenIndex = position3324, tokenIndex3324
 					if buffer[position] != rune('G') {
-						goto l711
+						goto l3322
 					}
 					position++
 				}
-			l713:
+			l3324:
 				{
-					position715, tokenIndex715 := position, tokenIndex
+					position3326, tokenIndex3326 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l716
+						goto l3327
 					}
 					position++
-					goto l715
-				l716:
-					position, tokenIndex = position715, tokenIndex715
+					goto l3326
+				l3327:
+					position, tokenIndex = position3326, tokenIndex3326
 					if buffer[position] != rune('O') {
-						goto l711
+						goto l3322
 					}
 					position++
 				}
-			l715:
+			l3326:
 				{
-					position717, tokenIndex717 := position, tokenIndex
+					position3328, tokenIndex3328 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l718
+						goto l3329
 					}
 					position++
-					goto l717
-				l718:
-					position, tokenIndex = position717, tokenIndex717
+					goto l3328
+				l3329:
+					position, tokenIndex = position3328, tokenIndex3328
 					if buffer[position] != rune('T') {
-						goto l711
+						goto l3322
 					}
 					position++
 				}
-			l717:
+			l3328:
 				if buffer[position] != rune('_') {
-					goto l711
+					goto l3322
 				}
 				position++
 				{
-					position719, tokenIndex719 := position, tokenIndex
+					position3330, tokenIndex3330 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l720
+						goto l3331
 					}
 					position++
-					goto l719
-				l720:
-					position, tokenIndex = position719, tokenIndex719
+					goto l3330
+				l3331:
+					position, tokenIndex = position3330, tokenIndex3330
 					if buffer[position] != rune('L') {
-						goto l711
+						goto l3322
 					}
 					position++
 				}
-			l719:
+			l3330:
 				{
-					position721, tokenIndex721 := position, tokenIndex
+					position3332, tokenIndex3332 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l722
+						goto l3333
 					}
 					position++
-					goto l721
-				l722:
-					position, tokenIndex = position721, tokenIndex721
+					goto l3332
+				l3333:
+					position, tokenIndex = position3332, tokenIndex3332
 					if buffer[position] != rune('O') {
-						goto l711
+						goto l3322
 					}
 					position++
 				}
-			l721:
+			l3332:
 				if buffer[position] != rune('1') {
-					goto l711
+					goto l3322
 				}
 				position++
 				if buffer[position] != rune('2') {
-					goto l711
+					goto l3322
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l711
+					goto l3322
 				}
 				position++
 				if !_rules[ruleSymbolName]() {
-					goto l711
+					goto l3322
 				}
-				add(ruleARMGOTLow12, position712)
+				add(ruleARMGOTLow12, position3323)
 			}
 			return true
-		l711:
-			position, tokenIndex = position711, tokenIndex711
+		l3322:
+			position, tokenIndex = position3322, tokenIndex3322
 			return false
 		},
-		/* 45 ARMPostincrement <- <'!'> */
+		/* 113 ARMPostincrement <- <'!'> */
 		func() bool {
-			position723, tokenIndex723 := position, tokenIndex
+			position3334, tokenIndex3334 := position, tokenIndex
 			{
-				position724 := position
+				position3335 := position
 				if buffer[position] != rune('!') {
-					goto l723
+					goto l3334
 				}
 				position++
-				add(ruleARMPostincrement, position724)
+				add(ruleARMPostincrement, position3335)
 			}
 			return true
-		l723:
-			position, tokenIndex = position723, tokenIndex723
+		l3334:
+			position, tokenIndex = position3334, tokenIndex3334
 			return false
 		},
-		/* 46 BaseIndexScale <- <('(' RegisterOrConstant? WS? (',' WS? RegisterOrConstant WS? (',' [0-9]+)?)? ')')> */
+		/* 114 BaseIndexScale <- <('(' RegisterOrConstant? WS? (',' WS? RegisterOrConstant WS? (',' [0-9]+)?)? ')')> */
 		func() bool {
-			position725, tokenIndex725 := position, tokenIndex
+			position3336, tokenIndex3336 := position, tokenIndex
 			{
-				position726 := position
+				position3337 := position
 				if buffer[position] != rune('(') {
-					goto l725
+					goto l3336
 				}
 				position++
 				{
-					position727, tokenIndex727 := position, tokenIndex
+					position3338, tokenIndex3338 := position, tokenIndex
 					if !_rules[ruleRegisterOrConstant]() {
-						goto l727
+						goto l3338
 					}
-					goto l728
-				l727:
-					position, tokenIndex = position727, tokenIndex727
+					goto l3339
+				l3338:
+					position, tokenIndex = position3338, tokenIndex3338
 				}
-			l728:
+			l3339:
 				{
-					position729, tokenIndex729 := position, tokenIndex
+					position3340, tokenIndex3340 := position, tokenIndex
 					if !_rules[ruleWS]() {
-						goto l729
+						goto l3340
 					}
-					goto l730
-				l729:
-					position, tokenIndex = position729, tokenIndex729
+					goto l3341
+				l3340:
+					position, tokenIndex = position3340, tokenIndex3340
 				}
-			l730:
+			l3341:
 				{
-					position731, tokenIndex731 := position, tokenIndex
+					position3342, tokenIndex3342 := position, tokenIndex
 					if buffer[position] != rune(',') {
-						goto l731
+						goto l3342
 					}
 					position++
 					{
-						position733, tokenIndex733 := position, tokenIndex
+						position3344, tokenIndex3344 := position, tokenIndex
 						if !_rules[ruleWS]() {
-							goto l733
+							goto l3344
 						}
-						goto l734
-					l733:
-						position, tokenIndex = position733, tokenIndex733
+						goto l3345
+					l3344:
+						position, tokenIndex = position3344, tokenIndex3344
 					}
-				l734:
+				l3345:
 					if !_rules[ruleRegisterOrConstant]() {
-						goto l731
+						goto l3342
 					}
 					{
-						position735, tokenIndex735 := position, tokenIndex
+						position3346, tokenIndex3346 := position, tokenIndex
 						if !_rules[ruleWS]() {
-							goto l735
+							goto l3346
 						}
-						goto l736
-					l735:
-						position, tokenIndex = position735, tokenIndex735
+						goto l3347
+					l3346:
+						position, tokenIndex = position3346, tokenIndex3346
 					}
-				l736:
+				l3347:
 					{
-						position737, tokenIndex737 := position, tokenIndex
+						position3348, tokenIndex3348 := position, tokenIndex
 						if buffer[position] != rune(',') {
-							goto l737
+							goto l3348
 						}
 						position++
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l737
+							goto l3348
 						}
 						position++
-					l739:
+					l3350:
 						{
-							position740, tokenIndex740 := position, tokenIndex
+							position3351, tokenIndex3351 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l740
+								goto l3351
 							}
 							position++
-							goto l739
-						l740:
-							position, tokenIndex = position740, tokenIndex740
+							goto l3350
+						l3351:
+							position, tokenIndex = position3351, tokenIndex3351
+						}
+						goto l3349
+					l3348:
+						position, tokenIndex = position3348, tokenIndex3348
+					}
+				l3349:
+					goto l3343
+				l3342:
+					position, tokenIndex = position3342, tokenIndex3342
+				}
+			l3343:
+				if buffer[position] != rune(')') {
+					goto l3336
+				}
+				position++
+				add(ruleBaseIndexScale, position3337)
+			}
+			return true
+		l3336:
+			position, tokenIndex = position3336, tokenIndex3336
+			return false
+		},
+		/* 115 Operator <- <('+' / '-')> */
+		func() bool {
+			position3352, tokenIndex3352 := position, tokenIndex
+			{
+				position3353 := position
+				{
+					position3354, tokenIndex3354 := position, tokenIndex
+					if buffer[position] != rune('+') {
+						goto l3355
+					}
+					position++
+					goto l3354
+				l3355:
+					position, tokenIndex = position3354, tokenIndex3354
+					if buffer[position] != rune('-') {
+						goto l3352
+					}
+					position++
+				}
+			l3354:
+				add(ruleOperator, position3353)
+			}
+			return true
+		l3352:
+			position, tokenIndex = position3352, tokenIndex3352
+			return false
+		},
+		/* 116 Expression <- <(ExpressionBitwise (WS? AdditiveOperator WS? ExpressionBitwise)*)> */
+		func() bool {
+			position3356, tokenIndex3356 := position, tokenIndex
+			{
+				position3357 := position
+				if !_rules[ruleExpressionBitwise]() {
+					goto l3356
+				}
+			l3358:
+				{
+					position3359, tokenIndex3359 := position, tokenIndex
+					{
+						position3360, tokenIndex3360 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3360
 						}
-						goto l738
-					l737:
-						position, tokenIndex = position737, tokenIndex737
+						goto l3361
+					l3360:
+						position, tokenIndex = position3360, tokenIndex3360
+					}
+				l3361:
+					if !_rules[ruleAdditiveOperator]() {
+						goto l3359
+					}
+					{
+						position3362, tokenIndex3362 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3362
+						}
+						goto l3363
+					l3362:
+						position, tokenIndex = position3362, tokenIndex3362
 					}
-				l738:
-					goto l732
-				l731:
-					position, tokenIndex = position731, tokenIndex731
+				l3363:
+					if !_rules[ruleExpressionBitwise]() {
+						goto l3359
+					}
+					goto l3358
+				l3359:
+					position, tokenIndex = position3359, tokenIndex3359
 				}
-			l732:
-				if buffer[position] != rune(')') {
-					goto l725
+				add(ruleExpression, position3357)
+			}
+			return true
+		l3356:
+			position, tokenIndex = position3356, tokenIndex3356
+			return false
+		},
+		/* 117 ExpressionBitwise <- <(ExpressionProduct (WS? BitwiseOperator WS? ExpressionProduct)*)> */
+		func() bool {
+			position3364, tokenIndex3364 := position, tokenIndex
+			{
+				position3365 := position
+				if !_rules[ruleExpressionProduct]() {
+					goto l3364
+				}
+			l3366:
+				{
+					position3367, tokenIndex3367 := position, tokenIndex
+					{
+						position3368, tokenIndex3368 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3368
+						}
+						goto l3369
+					l3368:
+						position, tokenIndex = position3368, tokenIndex3368
+					}
+				l3369:
+					if !_rules[ruleBitwiseOperator]() {
+						goto l3367
+					}
+					{
+						position3370, tokenIndex3370 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3370
+						}
+						goto l3371
+					l3370:
+						position, tokenIndex = position3370, tokenIndex3370
+					}
+				l3371:
+					if !_rules[ruleExpressionProduct]() {
+						goto l3367
+					}
+					goto l3366
+				l3367:
+					position, tokenIndex = position3367, tokenIndex3367
+				}
+				add(ruleExpressionBitwise, position3365)
+			}
+			return true
+		l3364:
+			position, tokenIndex = position3364, tokenIndex3364
+			return false
+		},
+		/* 118 ExpressionProduct <- <(ExpressionTerm (WS? ProductOperator WS? ExpressionTerm)*)> */
+		func() bool {
+			position3372, tokenIndex3372 := position, tokenIndex
+			{
+				position3373 := position
+				if !_rules[ruleExpressionTerm]() {
+					goto l3372
+				}
+			l3374:
+				{
+					position3375, tokenIndex3375 := position, tokenIndex
+					{
+						position3376, tokenIndex3376 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3376
+						}
+						goto l3377
+					l3376:
+						position, tokenIndex = position3376, tokenIndex3376
+					}
+				l3377:
+					if !_rules[ruleProductOperator]() {
+						goto l3375
+					}
+					{
+						position3378, tokenIndex3378 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3378
+						}
+						goto l3379
+					l3378:
+						position, tokenIndex = position3378, tokenIndex3378
+					}
+				l3379:
+					if !_rules[ruleExpressionTerm]() {
+						goto l3375
+					}
+					goto l3374
+				l3375:
+					position, tokenIndex = position3375, tokenIndex3375
+				}
+				add(ruleExpressionProduct, position3373)
+			}
+			return true
+		l3372:
+			position, tokenIndex = position3372, tokenIndex3372
+			return false
+		},
+		/* 119 ExpressionTerm <- <((UnaryOperator WS? ExpressionTerm) / ('(' WS? Expression WS? ')') / CharConstant / Offset)> */
+		func() bool {
+			position3380, tokenIndex3380 := position, tokenIndex
+			{
+				position3381 := position
+				{
+					position3382, tokenIndex3382 := position, tokenIndex
+					if !_rules[ruleUnaryOperator]() {
+						goto l3383
+					}
+					{
+						position3384, tokenIndex3384 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3384
+						}
+						goto l3385
+					l3384:
+						position, tokenIndex = position3384, tokenIndex3384
+					}
+				l3385:
+					if !_rules[ruleExpressionTerm]() {
+						goto l3383
+					}
+					goto l3382
+				l3383:
+					position, tokenIndex = position3382, tokenIndex3382
+					if buffer[position] != rune('(') {
+						goto l3386
+					}
+					position++
+					{
+						position3387, tokenIndex3387 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3387
+						}
+						goto l3388
+					l3387:
+						position, tokenIndex = position3387, tokenIndex3387
+					}
+				l3388:
+					if !_rules[ruleExpression]() {
+						goto l3386
+					}
+					{
+						position3389, tokenIndex3389 := position, tokenIndex
+						if !_rules[ruleWS]() {
+							goto l3389
+						}
+						goto l3390
+					l3389:
+						position, tokenIndex = position3389, tokenIndex3389
+					}
+				l3390:
+					if buffer[position] != rune(')') {
+						goto l3386
+					}
+					position++
+					goto l3382
+				l3386:
+					position, tokenIndex = position3382, tokenIndex3382
+					if !_rules[ruleCharConstant]() {
+						goto l3391
+					}
+					goto l3382
+				l3391:
+					position, tokenIndex = position3382, tokenIndex3382
+					if !_rules[ruleOffset]() {
+						goto l3380
+					}
+				}
+			l3382:
+				add(ruleExpressionTerm, position3381)
+			}
+			return true
+		l3380:
+			position, tokenIndex = position3380, tokenIndex3380
+			return false
+		},
+		/* 120 CharConstant <- <('\'' (EscapedChar / (!('\\' / '\n') .)) '\''?)> */
+		func() bool {
+			position3392, tokenIndex3392 := position, tokenIndex
+			{
+				position3393 := position
+				if buffer[position] != rune('\'') {
+					goto l3392
 				}
 				position++
-				add(ruleBaseIndexScale, position726)
+				{
+					position3394, tokenIndex3394 := position, tokenIndex
+					if !_rules[ruleEscapedChar]() {
+						goto l3395
+					}
+					goto l3394
+				l3395:
+					position, tokenIndex = position3394, tokenIndex3394
+					{
+						position3396, tokenIndex3396 := position, tokenIndex
+						{
+							position3397, tokenIndex3397 := position, tokenIndex
+							if buffer[position] != rune('\\') {
+								goto l3398
+							}
+							position++
+							goto l3397
+						l3398:
+							position, tokenIndex = position3397, tokenIndex3397
+							if buffer[position] != rune('\n') {
+								goto l3396
+							}
+							position++
+						}
+					l3397:
+						goto l3392
+					l3396:
+						position, tokenIndex = position3396, tokenIndex3396
+					}
+					if !matchDot() {
+						goto l3392
+					}
+				}
+			l3394:
+				{
+					position3399, tokenIndex3399 := position, tokenIndex
+					if buffer[position] != rune('\'') {
+						goto l3399
+					}
+					position++
+					goto l3400
+				l3399:
+					position, tokenIndex = position3399, tokenIndex3399
+				}
+			l3400:
+				add(ruleCharConstant, position3393)
+			}
+			return true
+		l3392:
+			position, tokenIndex = position3392, tokenIndex3392
+			return false
+		},
+		/* 121 UnaryOperator <- <('~' / '-')> */
+		func() bool {
+			position3401, tokenIndex3401 := position, tokenIndex
+			{
+				position3402 := position
+				{
+					position3403, tokenIndex3403 := position, tokenIndex
+					if buffer[position] != rune('~') {
+						goto l3404
+					}
+					position++
+					goto l3403
+				l3404:
+					position, tokenIndex = position3403, tokenIndex3403
+					if buffer[position] != rune('-') {
+						goto l3401
+					}
+					position++
+				}
+			l3403:
+				add(ruleUnaryOperator, position3402)
 			}
 			return true
-		l725:
-			position, tokenIndex = position725, tokenIndex725
+		l3401:
+			position, tokenIndex = position3401, tokenIndex3401
 			return false
 		},
-		/* 47 Operator <- <('+' / '-')> */
+		/* 122 AdditiveOperator <- <('+' / '-')> */
 		func() bool {
-			position741, tokenIndex741 := position, tokenIndex
+			position3405, tokenIndex3405 := position, tokenIndex
 			{
-				position742 := position
+				position3406 := position
 				{
-					position743, tokenIndex743 := position, tokenIndex
+					position3407, tokenIndex3407 := position, tokenIndex
 					if buffer[position] != rune('+') {
-						goto l744
+						goto l3408
 					}
 					position++
-					goto l743
-				l744:
-					position, tokenIndex = position743, tokenIndex743
+					goto l3407
+				l3408:
+					position, tokenIndex = position3407, tokenIndex3407
 					if buffer[position] != rune('-') {
-						goto l741
+						goto l3405
+					}
+					position++
+				}
+			l3407:
+				add(ruleAdditiveOperator, position3406)
+			}
+			return true
+		l3405:
+			position, tokenIndex = position3405, tokenIndex3405
+			return false
+		},
+		/* 123 BitwiseOperator <- <('&' / '|' / '^')> */
+		func() bool {
+			position3409, tokenIndex3409 := position, tokenIndex
+			{
+				position3410 := position
+				{
+					position3411, tokenIndex3411 := position, tokenIndex
+					if buffer[position] != rune('&') {
+						goto l3412
+					}
+					position++
+					goto l3411
+				l3412:
+					position, tokenIndex = position3411, tokenIndex3411
+					if buffer[position] != rune('|') {
+						goto l3413
+					}
+					position++
+					goto l3411
+				l3413:
+					position, tokenIndex = position3411, tokenIndex3411
+					if buffer[position] != rune('^') {
+						goto l3409
+					}
+					position++
+				}
+			l3411:
+				add(ruleBitwiseOperator, position3410)
+			}
+			return true
+		l3409:
+			position, tokenIndex = position3409, tokenIndex3409
+			return false
+		},
+		/* 124 ProductOperator <- <(('<' '<') / ('>' '>') / '*')> */
+		func() bool {
+			position3414, tokenIndex3414 := position, tokenIndex
+			{
+				position3415 := position
+				{
+					position3416, tokenIndex3416 := position, tokenIndex
+					if buffer[position] != rune('<') {
+						goto l3417
+					}
+					position++
+					if buffer[position] != rune('<') {
+						goto l3417
+					}
+					position++
+					goto l3416
+				l3417:
+					position, tokenIndex = position3416, tokenIndex3416
+					if buffer[position] != rune('>') {
+						goto l3418
+					}
+					position++
+					if buffer[position] != rune('>') {
+						goto l3418
+					}
+					position++
+					goto l3416
+				l3418:
+					position, tokenIndex = position3416, tokenIndex3416
+					if buffer[position] != rune('*') {
+						goto l3414
 					}
 					position++
 				}
-			l743:
-				add(ruleOperator, position742)
+			l3416:
+				add(ruleProductOperator, position3415)
 			}
 			return true
-		l741:
-			position, tokenIndex = position741, tokenIndex741
+		l3414:
+			position, tokenIndex = position3414, tokenIndex3414
 			return false
 		},
-		/* 48 Offset <- <('+'? '-'? (('0' ('b' / 'B') ('0' / '1')+) / ('0' ('x' / 'X') ([0-9] / [0-9] / ([a-f] / [A-F]))+) / [0-9]+))> */
+		/* 125 Offset <- <('+'? '-'? (('0' ('b' / 'B') ('0' / '1')+) / ('0' ('x' / 'X') ([0-9] / [0-9] / ([a-f] / [A-F]))+) / [0-9]+))> */
 		func() bool {
-			position745, tokenIndex745 := position, tokenIndex
+			position3419, tokenIndex3419 := position, tokenIndex
 			{
-				position746 := position
+				position3420 := position
 				{
-					position747, tokenIndex747 := position, tokenIndex
+					position3421, tokenIndex3421 := position, tokenIndex
 					if buffer[position] != rune('+') {
-						goto l747
+						goto l3421
 					}
 					position++
-					goto l748
-				l747:
-					position, tokenIndex = position747, tokenIndex747
+					goto l3422
+				l3421:
+					position, tokenIndex = position3421, tokenIndex3421
 				}
-			l748:
+			l3422:
 				{
-					position749, tokenIndex749 := position, tokenIndex
+					position3423, tokenIndex3423 := position, tokenIndex
 					if buffer[position] != rune('-') {
-						goto l749
+						goto l3423
 					}
 					position++
-					goto l750
-				l749:
-					position, tokenIndex = position749, tokenIndex749
+					goto l3424
+				l3423:
+					position, tokenIndex = position3423, tokenIndex3423
 				}
-			l750:
+			l3424:
 				{
-					position751, tokenIndex751 := position, tokenIndex
+					position3425, tokenIndex3425 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l752
+						goto l3426
 					}
 					position++
 					{
-						position753, tokenIndex753 := position, tokenIndex
+						position3427, tokenIndex3427 := position, tokenIndex
 						if buffer[position] != rune('b') {
-							goto l754
+							goto l3428
 						}
 						position++
-						goto l753
-					l754:
-						position, tokenIndex = position753, tokenIndex753
+						goto l3427
+					l3428:
+						position, tokenIndex = position3427, tokenIndex3427
 						if buffer[position] != rune('B') {
-							goto l752
+							goto l3426
 						}
 						position++
 					}
-				l753:
+				l3427:
 					{
-						position757, tokenIndex757 := position, tokenIndex
+						position3431, tokenIndex3431 := position, tokenIndex
 						if buffer[position] != rune('0') {
-							goto l758
+							goto l3432
 						}
 						position++
-						goto l757
-					l758:
-						position, tokenIndex = position757, tokenIndex757
+						goto l3431
+					l3432:
+						position, tokenIndex = position3431, tokenIndex3431
 						if buffer[position] != rune('1') {
-							goto l752
+							goto l3426
 						}
 						position++
 					}
-				l757:
-				l755:
+				l3431:
+				l3429:
 					{
-						position756, tokenIndex756 := position, tokenIndex
+						position3430, tokenIndex3430 := position, tokenIndex
 						{
-							position759, tokenIndex759 := position, tokenIndex
+							position3433, tokenIndex3433 := position, tokenIndex
 							if buffer[position] != rune('0') {
-								goto l760
+								goto l3434
 							}
 							position++
-							goto l759
-						l760:
-							position, tokenIndex = position759, tokenIndex759
+							goto l3433
+						l3434:
+							position, tokenIndex = position3433, tokenIndex3433
 							if buffer[position] != rune('1') {
-								goto l756
+								goto l3430
 							}
 							position++
 						}
-					l759:
-						goto l755
-					l756:
-						position, tokenIndex = position756, tokenIndex756
+					l3433:
+						goto l3429
+					l3430:
+						position, tokenIndex = position3430, tokenIndex3430
 					}
-					goto l751
-				l752:
-					position, tokenIndex = position751, tokenIndex751
+					goto l3425
+				l3426:
+					position, tokenIndex = position3425, tokenIndex3425
 					if buffer[position] != rune('0') {
-						goto l761
+						goto l3435
 					}
 					position++
 					{
-						position762, tokenIndex762 := position, tokenIndex
+						position3436, tokenIndex3436 := position, tokenIndex
 						if buffer[position] != rune('x') {
-							goto l763
+							goto l3437
 						}
 						position++
-						goto l762
-					l763:
-						position, tokenIndex = position762, tokenIndex762
+						goto l3436
+					l3437:
+						position, tokenIndex = position3436, tokenIndex3436
 						if buffer[position] != rune('X') {
-							goto l761
+							goto l3435
 						}
 						position++
 					}
-				l762:
+				l3436:
 					{
-						position766, tokenIndex766 := position, tokenIndex
+						position3440, tokenIndex3440 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l767
+							goto l3441
 						}
 						position++
-						goto l766
-					l767:
-						position, tokenIndex = position766, tokenIndex766
+						goto l3440
+					l3441:
+						position, tokenIndex = position3440, tokenIndex3440
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l768
+							goto l3442
 						}
 						position++
-						goto l766
-					l768:
-						position, tokenIndex = position766, tokenIndex766
+						goto l3440
+					l3442:
+						position, tokenIndex = position3440, tokenIndex3440
 						{
-							position769, tokenIndex769 := position, tokenIndex
+							position3443, tokenIndex3443 := position, tokenIndex
 							if c := buffer[position]; c < rune('a') || c > rune('f') {
-								goto l770
+								goto l3444
 							}
 							position++
-							goto l769
-						l770:
-							position, tokenIndex = position769, tokenIndex769
+							goto l3443
+						l3444:
+							position, tokenIndex = position3443, tokenIndex3443
 							if c := buffer[position]; c < rune('A') || c > rune('F') {
-								goto l761
+								goto l3435
 							}
 							position++
 						}
-					l769:
+					l3443:
 					}
-				l766:
-				l764:
+				l3440:
+				l3438:
 					{
-						position765, tokenIndex765 := position, tokenIndex
+						position3439, tokenIndex3439 := position, tokenIndex
 						{
-							position771, tokenIndex771 := position, tokenIndex
+							position3445, tokenIndex3445 := position, tokenIndex
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l772
+								goto l3446
 							}
 							position++
-							goto l771
-						l772:
-							position, tokenIndex = position771, tokenIndex771
+							goto l3445
+						l3446:
+							position, tokenIndex = position3445, tokenIndex3445
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l773
+								goto l3447
 							}
 							position++
-							goto l771
-						l773:
-							position, tokenIndex = position771, tokenIndex771
+							goto l3445
+						l3447:
+							position, tokenIndex = position3445, tokenIndex3445
 							{
-								position774, tokenIndex774 := position, tokenIndex
+								position3448, tokenIndex3448 := position, tokenIndex
 								if c := buffer[position]; c < rune('a') || c > rune('f') {
-									goto l775
+									goto l3449
 								}
 								position++
-								goto l774
-							l775:
-								position, tokenIndex = position774, tokenIndex774
+								goto l3448
+							l3449:
+								position, tokenIndex = position3448, tokenIndex3448
 								if c := buffer[position]; c < rune('A') || c > rune('F') {
-									goto l765
+									goto l3439
 								}
 								position++
 							}
-						l774:
+						l3448:
 						}
-					l771:
-						goto l764
-					l765:
-						position, tokenIndex = position765, tokenIndex765
+					l3445:
+						goto l3438
+					l3439:
+						position, tokenIndex = position3439, tokenIndex3439
 					}
-					goto l751
-				l761:
-					position, tokenIndex = position751, tokenIndex751
+					goto l3425
+				l3435:
+					position, tokenIndex = position3425, tokenIndex3425
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l745
+						goto l3419
 					}
 					position++
-				l776:
+				l3450:
 					{
-						position777, tokenIndex777 := position, tokenIndex
+						position3451, tokenIndex3451 := position, tokenIndex
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l777
+							goto l3451
 						}
 						position++
-						goto l776
-					l777:
-						position, tokenIndex = position777, tokenIndex777
+						goto l3450
+					l3451:
+						position, tokenIndex = position3451, tokenIndex3451
 					}
 				}
-			l751:
-				add(ruleOffset, position746)
+			l3425:
+				add(ruleOffset, position3420)
 			}
 			return true
-		l745:
-			position, tokenIndex = position745, tokenIndex745
+		l3419:
+			position, tokenIndex = position3419, tokenIndex3419
 			return false
 		},
-		/* 49 Section <- <([a-z] / [A-Z] / '@')+> */
+		/* 126 Section <- <([a-z] / [A-Z] / '@')+> */
 		func() bool {
-			position778, tokenIndex778 := position, tokenIndex
+			position3452, tokenIndex3452 := position, tokenIndex
 			{
-				position779 := position
+				position3453 := position
 				{
-					position782, tokenIndex782 := position, tokenIndex
+					position3456, tokenIndex3456 := position, tokenIndex
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l783
+						goto l3457
 					}
 					position++
-					goto l782
-				l783:
-					position, tokenIndex = position782, tokenIndex782
+					goto l3456
+				l3457:
+					position, tokenIndex = position3456, tokenIndex3456
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l784
+						goto l3458
 					}
 					position++
-					goto l782
-				l784:
-					position, tokenIndex = position782, tokenIndex782
+					goto l3456
+				l3458:
+					position, tokenIndex = position3456, tokenIndex3456
 					if buffer[position] != rune('@') {
-						goto l778
+						goto l3452
 					}
 					position++
 				}
-			l782:
-			l780:
+			l3456:
+			l3454:
 				{
-					position781, tokenIndex781 := position, tokenIndex
+					position3455, tokenIndex3455 := position, tokenIndex
 					{
-						position785, tokenIndex785 := position, tokenIndex
+						position3459, tokenIndex3459 := position, tokenIndex
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l786
+							goto l3460
 						}
 						position++
-						goto l785
-					l786:
-						position, tokenIndex = position785, tokenIndex785
+						goto l3459
+					l3460:
+						position, tokenIndex = position3459, tokenIndex3459
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l787
+							goto l3461
 						}
 						position++
-						goto l785
-					l787:
-						position, tokenIndex = position785, tokenIndex785
+						goto l3459
+					l3461:
+						position, tokenIndex = position3459, tokenIndex3459
 						if buffer[position] != rune('@') {
-							goto l781
+							goto l3455
 						}
 						position++
 					}
-				l785:
-					goto l780
-				l781:
-					position, tokenIndex = position781, tokenIndex781
+				l3459:
+					goto l3454
+				l3455:
+					position, tokenIndex = position3455, tokenIndex3455
 				}
-				add(ruleSection, position779)
+				add(ruleSection, position3453)
 			}
 			return true
-		l778:
-			position, tokenIndex = position778, tokenIndex778
+		l3452:
+			position, tokenIndex = position3452, tokenIndex3452
 			return false
 		},
-		/* 50 SegmentRegister <- <('%' ([c-g] / 's') ('s' ':'))> */
+		/* 127 SegmentRegister <- <('%' ([c-g] / 's') ('s' ':'))> */
 		func() bool {
-			position788, tokenIndex788 := position, tokenIndex
+			position3462, tokenIndex3462 := position, tokenIndex
 			{
-				position789 := position
+				position3463 := position
 				if buffer[position] != rune('%') {
-					goto l788
+					goto l3462
 				}
 				position++
 				{
-					position790, tokenIndex790 := position, tokenIndex
+					position3464, tokenIndex3464 := position, tokenIndex
 					if c := buffer[position]; c < rune('c') || c > rune('g') {
-						goto l791
+						goto l3465
 					}
 					position++
-					goto l790
-				l791:
-					position, tokenIndex = position790, tokenIndex790
+					goto l3464
+				l3465:
+					position, tokenIndex = position3464, tokenIndex3464
 					if buffer[position] != rune('s') {
-						goto l788
+						goto l3462
 					}
 					position++
 				}
-			l790:
+			l3464:
 				if buffer[position] != rune('s') {
-					goto l788
+					goto l3462
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l788
+					goto l3462
 				}
 				position++
-				add(ruleSegmentRegister, position789)
+				add(ruleSegmentRegister, position3463)
 			}
 			return true
-		l788:
-			position, tokenIndex = position788, tokenIndex788
+		l3462:
+			position, tokenIndex = position3462, tokenIndex3462
 			return false
 		},
 	}
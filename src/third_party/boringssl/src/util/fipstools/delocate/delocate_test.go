@@ -18,7 +18,10 @@ import (
 	"bytes"
 	"flag"
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -44,6 +47,8 @@ var delocateTests = []delocateTest{
 	{"ppc64le-Sample2", []string{"in.s"}, "out.s"},
 	{"ppc64le-Sample", []string{"in.s"}, "out.s"},
 	{"ppc64le-TOCWithOffset", []string{"in.s"}, "out.s"},
+	{"ppc64le-GnuAttribute", []string{"in.s"}, "out.s"},
+	{"ppc64le-ConditionRegisters", []string{"in.s"}, "out.s"},
 	{"x86_64-Basic", []string{"in.s"}, "out.s"},
 	{"x86_64-BSS", []string{"in.s"}, "out.s"},
 	{"x86_64-GOTRewrite", []string{"in.s"}, "out.s"},
@@ -51,7 +56,20 @@ var delocateTests = []delocateTest{
 	{"x86_64-LabelRewrite", []string{"in1.s", "in2.s"}, "out.s"},
 	{"x86_64-Sections", []string{"in.s"}, "out.s"},
 	{"x86_64-ThreeArg", []string{"in.s"}, "out.s"},
+	{"x86_64-CFI", []string{"in.s", "in2.s"}, "out.s"},
+	{"x86_64-LocalLabels", []string{"in.s"}, "out.s"},
+	{"x86_64-Equ", []string{"in1.s", "in2.s"}, "out.s"},
+	{"x86_64-AbsoluteAddress", []string{"in.s"}, "out.s"},
+	{"x86_64-EncodingHints", []string{"in.s"}, "out.s"},
+	{"x86_64-Prefixes", []string{"in.s"}, "out.s"},
+	{"x86_64-DataConstants", []string{"in1.s", "in2.s"}, "out.s"},
+	{"x86_64-Reloc", []string{"in.s"}, "out.s"},
+	{"x86_64-JumpTable", []string{"in1.s", "in2.s"}, "out.s"},
+	{"x86_64-DebugSections", []string{"in.s"}, "out.s"},
+	{"x86_64-SymbolTypes", []string{"in.s"}, "out.s"},
+	{"x86_64-Alias", []string{"in.s"}, "out.s"},
 	{"aarch64-Basic", []string{"in.s"}, "out.s"},
+	{"aarch64-CFI", []string{"in.s"}, "out.s"},
 }
 
 func TestDelocate(t *testing.T) {
@@ -88,3 +106,1021 @@ func TestDelocate(t *testing.T) {
 		})
 	}
 }
+
+type parseTest struct {
+	name string
+	// coff, if true, enables the grammar rules for COFF assembly.
+	coff  bool
+	input string
+	// statements, if not nil, contains the expected rule of each non-empty
+	// statement.
+	statements []pegRule
+	// counts maps rules to the number of times each is expected to occur
+	// in the parse tree.
+	counts map[pegRule]int
+	// path, if not nil, is a path of rules below each statement, and
+	// pathContents contains the expected text of each node at its end.
+	path         []pegRule
+	pathContents []string
+}
+
+var parseTests = []parseTest{
+	{
+		name: "SEHPrologue",
+		coff: true,
+		input: `	.seh_proc foo
+foo:
+	pushq %rbp
+	.seh_pushreg %rbp
+	subq $48, %rsp
+	.seh_stackalloc 48
+	leaq 48(%rsp), %rbp
+	.seh_setframe %rbp, 48
+	movaps %xmm6, 0(%rsp)
+	.seh_savexmm %xmm6, 0
+	.seh_endprologue
+	ret
+	.seh_endproc
+`,
+		statements: []pegRule{
+			ruleSEHDirective, ruleLabel,
+			ruleInstruction, ruleSEHDirective,
+			ruleInstruction, ruleSEHDirective,
+			ruleInstruction, ruleSEHDirective,
+			ruleInstruction, ruleSEHDirective,
+			ruleSEHDirective,
+			ruleInstruction,
+			ruleSEHDirective,
+		},
+	},
+	{
+		name:       "SEHWithoutCOFF",
+		input:      "\t.seh_proc foo\n\t.seh_endprologue\n",
+		statements: []pegRule{ruleDirective, ruleDirective},
+	},
+	{
+		name: "COFFSections",
+		coff: true,
+		input: `	.section .text$mn,"xr"
+	.section .rdata,"dr"
+	.section .rdata$r,"dr",discard,foo
+	.section .data
+`,
+		statements: []pegRule{
+			ruleCOFFSectionDirective,
+			ruleCOFFSectionDirective,
+			ruleCOFFSectionDirective,
+			ruleCOFFSectionDirective,
+		},
+	},
+	{
+		name: "COFFSymbolDescriptor",
+		coff: true,
+		input: `	.def foo; .scl 2; .type 32; .endef
+	.globl foo
+foo:
+	ret
+`,
+		statements: []pegRule{
+			ruleCOFFDefDirective,
+			ruleGlobalDirective,
+			ruleLabel,
+			ruleInstruction,
+		},
+	},
+	{
+		name: "InlineAsmMarkers",
+		input: `foo:
+#APP
+# 12 "foo.c" 1
+	movq %rax, %rbx
+	xorq %rax, %rax
+# 0 "" 2
+#NO_APP
+	ret
+`,
+		statements: []pegRule{
+			ruleLabel,
+			ruleInlineAsmMarker,
+			ruleComment,
+			ruleInstruction,
+			ruleInstruction,
+			ruleComment,
+			ruleInlineAsmMarker,
+			ruleInstruction,
+		},
+	},
+	{
+		name: "Diagnostics",
+		input: `	.print "assembling"
+	.warning "x"
+	.error "y"
+	.errors
+`,
+		statements: []pegRule{
+			ruleDiagnosticDirective,
+			ruleDiagnosticDirective,
+			ruleDiagnosticDirective,
+			ruleDirective,
+		},
+	},
+	{
+		name:       "Ident",
+		input:      "\t.ident\t\"GCC: (Debian 12.2.0-14) 12.2.0\"\n",
+		statements: []pegRule{ruleIdentDirective},
+	},
+	{
+		name: "Subsections",
+		input: `	.text
+	.subsection 1
+	ret
+	.subsection 0
+	nop
+`,
+		statements: []pegRule{
+			ruleDirective,
+			ruleSubsectionDirective,
+			ruleInstruction,
+			ruleSubsectionDirective,
+			ruleInstruction,
+		},
+	},
+	{
+		name: "VariantPCS",
+		input: `	.globl foo
+	.variant_pcs foo
+	.type foo, %function
+foo:
+	ret
+`,
+		statements: []pegRule{
+			ruleGlobalDirective,
+			ruleVariantPCSDirective,
+			ruleLabelContainingDirective,
+			ruleLabel,
+			ruleInstruction,
+		},
+	},
+	{
+		name: "Machine",
+		input: `	.machine push
+	.machine "power9"
+	.machine power8
+	.machine pop
+`,
+		statements: []pegRule{
+			ruleMachineDirective,
+			ruleMachineDirective,
+			ruleMachineDirective,
+			ruleMachineDirective,
+		},
+	},
+	{
+		name: "Attribute",
+		input: `	.attribute arch, "rv64i2p1_m2p0"
+	.attribute 5, "rv64i2p1"
+	.eabi_attribute Tag_ABI_VFP_args, 1
+	.attribute Tag_ABI_align_preserved, 1
+`,
+		statements: []pegRule{
+			ruleAttributeDirective,
+			ruleAttributeDirective,
+			ruleAttributeDirective,
+			ruleAttributeDirective,
+		},
+	},
+	{
+		name: "SuffixedControlFlow",
+		input: `	callq *foo@GOTPCREL(%rip)
+	jmpq .Llocal
+	jmpq *%rax
+	retq
+`,
+		statements: []pegRule{
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+		},
+	},
+	{
+		name: "LiteralPool",
+		input: `f:
+	ldr r0, =0xdeadbeef
+	ldr r1, =some_symbol
+	bx lr
+	.ltorg
+	.pool
+`,
+		statements: []pegRule{
+			ruleLabel,
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+			ruleLiteralPoolDirective,
+			ruleLiteralPoolDirective,
+		},
+	},
+	{
+		// Gathers and scatters use a vector register as the index.
+		name: "GatherScatter",
+		input: `	vgatherdps (%rax,%zmm1,4), %zmm2 {%k1}
+	vscatterdps %zmm2, 8(%rax,%zmm1,4) {%k1}
+`,
+		statements: []pegRule{
+			ruleInstruction,
+			ruleInstruction,
+		},
+	},
+	{
+		name: "Bundles",
+		input: `	.bundle_align_mode 5
+	.bundle_lock
+	movq %rax, %rbx
+	.bundle_unlock
+	.bundle_lock align_to_end
+	call foo
+	.bundle_unlock
+`,
+		statements: []pegRule{
+			ruleBundleDirective,
+			ruleBundleDirective,
+			ruleInstruction,
+			ruleBundleDirective,
+			ruleBundleDirective,
+			ruleInstruction,
+			ruleBundleDirective,
+		},
+	},
+	{
+		name: "MIPSSet",
+		input: `	.set noreorder
+	.set nomacro
+	nop
+	.set macro
+	.set reorder
+	.set at, 1
+`,
+		statements: []pegRule{
+			ruleMIPSSetDirective,
+			ruleMIPSSetDirective,
+			ruleInstruction,
+			ruleMIPSSetDirective,
+			ruleMIPSSetDirective,
+			ruleLabelContainingDirective,
+		},
+	},
+	{
+		// s390x shares AT&T-style register and D(X,B) operand syntax
+		// with x86-64.
+		name: "S390x",
+		input: `	larl %r1, foo@GOTENT
+	lg %r1, 0(%r1)
+	lg %r2, 8(%r1,%r3)
+	br %r14
+`,
+		statements: []pegRule{
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+		},
+	},
+	{
+		// Shifts and bitwise operators in immediates do not need
+		// parentheses.
+		name: "ImmediateExpressions",
+		input: `	movq $1<<4, %rax
+	orl $1 << 4 | 3, %eax
+	andq $~(1<<4), %rax
+	movq $-8, %rax
+`,
+		statements: []pegRule{
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+		},
+	},
+	{
+		name: "RISCVInsn",
+		input: `	.insn r 0x33, 0, 0, a0, a1, a2
+	.insn i 0x13, 0, a0, a1, 13
+	.insn i 0x3, 2, a0, 8(sp)
+`,
+		statements: []pegRule{
+			ruleInsnDirective,
+			ruleInsnDirective,
+			ruleInsnDirective,
+		},
+	},
+	{
+		name: "EquExpressions",
+		input: `	.equ FOO, 4*8
+	.equ BAR, 1+2*3
+	.equ BAZ, .Lfoo+8
+	.equ QUX, 1+foo*2
+`,
+		statements: []pegRule{
+			ruleEquDirective,
+			ruleEquDirective,
+			ruleEquDirective,
+			ruleDirective,
+		},
+	},
+	{
+		name: "CountRule",
+		input: `foo:
+	movq .Llocal(%rip), %rax
+	leaq bar(%rip), %rbx
+	addq %rbx, %rax
+	ret
+.Llocal:
+	.quad 42
+`,
+		counts: map[pegRule]int{
+			ruleInstruction: 4,
+			ruleMemoryRef:   2,
+			ruleLabel:       2,
+			ruleGOTLocation: 0,
+		},
+	},
+	{
+		name: "GOTLocation",
+		input: `	movabsq $_GLOBAL_OFFSET_TABLE_-.L0, %rcx
+	addl $_GLOBAL_OFFSET_TABLE_, %ebx
+`,
+		counts: map[pegRule]int{ruleGOTLocation: 2},
+	},
+	{
+		name: "ARMLiteralPoolOperand",
+		input: `	ldr r0, =0xdeadbeef
+	ldr r1, =some_symbol
+	ldr r2, =.Llocal
+`,
+		counts: map[pegRule]int{ruleARMLiteralPoolOperand: 3},
+	},
+	{
+		// String instructions take implicit operands, so only the
+		// prefix and name should be found.
+		name: "StringInstructions",
+		input: `	rep movsb
+	rep stosq
+	repz scasb
+`,
+		counts: map[pegRule]int{
+			ruleInstructionPrefix: 3,
+			ruleInstructionArg:    0,
+		},
+	},
+	{
+		name: "CFIPersonalityID",
+		input: `	.cfi_startproc
+	.cfi_personality_id 3
+	ret
+	.cfi_endproc
+`,
+		counts: map[pegRule]int{ruleCFIPersonalityIDDirective: 1},
+	},
+	{
+		name: "ARMShiftedImmediates",
+		input: `	movz x0, #0x1234, lsl #0
+	movk x0, #0x5678, lsl #16
+	movk x0, #0x9abc, lsl #32
+	movk x0, #0xdef0, lsl #48
+`,
+		counts: map[pegRule]int{ruleARMConstantTweak: 4},
+	},
+	{
+		name: "CharConstant",
+		input: `	movb $'A, %al
+	movb $'\n', %al
+	cmpb $'\'', %al
+`,
+		counts: map[pegRule]int{ruleCharConstant: 3},
+	},
+	{
+		name: "MaskRegisters",
+		input: `	kmovw %k1, %eax
+	kandw %k2, %k3, %k1
+	kortestw %k1, %k1
+`,
+		counts: map[pegRule]int{ruleRegisterOrConstant: 7},
+	},
+	{
+		// These instructions use fixed registers implicitly, so only
+		// their explicit operands, if any, should be found.
+		name: "ImplicitOperandInstructions",
+		input: `	cmpxchg16b (%rax)
+	lock cmpxchg16b 8(%rdi)
+	cpuid
+	rdtsc
+	xgetbv
+`,
+		counts: map[pegRule]int{
+			ruleInstruction:    5,
+			ruleInstructionArg: 2,
+			ruleMemoryRef:      2,
+		},
+	},
+	{
+		// tbz and tbnz take a register, a bit position and a branch
+		// target.
+		name: "BitTestBranches",
+		input: `.Lback:
+	tbz x0, #5, .Lfwd
+	tbnz w1, #0, .Lback
+	tbz x2, #63, 1f
+	tbnz x3, #31, 1b
+.Lfwd:
+1:
+`,
+		counts: map[pegRule]int{
+			ruleInstructionArg: 12,
+			ruleARMRegister:    4,
+			ruleOffset:         4,
+			ruleLocalSymbol:    4,
+			ruleLocalLabelRef:  2,
+		},
+	},
+	{
+		// The lookup table is an immediate and must not be taken for a
+		// memory operand.
+		name: "TernaryLogicInstructions",
+		input: `	vpternlogd $0x96, %zmm2, %zmm1, %zmm0
+	vpternlogq $0xca, %ymm2, %ymm1, %ymm0
+	vpternlogd $0x96, (%rdi), %zmm1, %zmm0
+	vpternlogq $0xe8, 64(%rsi), %zmm1, %zmm0{%k1}
+`,
+		counts: map[pegRule]int{
+			ruleInstructionArg: 16,
+			ruleMemoryRef:      2,
+		},
+		path: []pegRule{ruleInstruction, ruleInstructionArg, ruleRegisterOrConstant},
+		pathContents: []string{
+			"$0x96", "%zmm2", "%zmm1", "%zmm0",
+			"$0xca", "%ymm2", "%ymm1", "%ymm0",
+			"$0x96", "%zmm1", "%zmm0",
+			"$0xe8", "%zmm1", "%zmm0",
+		},
+	},
+	{
+		name: "LSEAtomics",
+		input: `	cas x0, x1, [x2]
+	casp x0, x1, x2, x3, [x4]
+	caspal w4, w5, w6, w7, [sp]
+	ldadd x0, x1, [x2]
+	swp w0, w1, [x2]
+`,
+		counts: map[pegRule]int{
+			ruleARMRegister:       19,
+			ruleARMBaseIndexScale: 5,
+		},
+	},
+	{
+		// The symbol, offset and %rip base form a single memory
+		// reference.
+		name: "RIPRelativeWithOffset",
+		input: `	leaq sym+16(%rip), %rax
+	leaq sym-8(%rip), %rax
+`,
+		counts: map[pegRule]int{
+			ruleMemoryRef:      2,
+			ruleSymbolRef:      2,
+			ruleBaseIndexScale: 2,
+		},
+	},
+	{
+		name: "PrefetchInstructions",
+		input: `	prefetcht0 (%rax)
+	prefetchnta sym(%rip)
+	prefetchw 8(%rax,%rbx,4)
+`,
+		counts: map[pegRule]int{
+			ruleInstructionArg: 3,
+			ruleMemoryRef:      3,
+		},
+	},
+	{
+		name: "IncbinDirective",
+		input: `	.incbin "data.bin"
+	.incbin "data.bin", 16
+	.incbin "data.bin", 16, 32
+`,
+		counts: map[pegRule]int{
+			ruleIncbinSkip:  2,
+			ruleIncbinCount: 1,
+		},
+		path:         []pegRule{ruleIncbinDirective, ruleIncbinFile, ruleQuotedArg, ruleQuotedText},
+		pathContents: []string{"data.bin", "data.bin", "data.bin"},
+	},
+	{
+		// Only adrp takes a GOT operand. The adr target is a plain
+		// symbol reference.
+		name: "ARMAdr",
+		input: `	adr x0, .Llocal
+	adrp x1, :got:sym
+	adrp x2, .Llocal
+`,
+		counts: map[pegRule]int{
+			ruleGOTSymbolOffset: 1,
+			ruleSymbolRef:       2,
+		},
+		path:         []pegRule{ruleInstruction, ruleInstructionName},
+		pathContents: []string{"adr", "adrp", "adrp"},
+	},
+	{
+		// The full base, index and scale must be kept, so that the
+		// encoding, and thus the length, of the NOP is unchanged.
+		name: "PatchableEntryNOPs",
+		input: `	nopl 0x0(%rax)
+	nopl 0x0(%rax,%rax,1)
+	nopw 0x0(%rax,%rax,1)
+	nopw %cs:0x0(%rax,%rax,1)
+	data16 nopw %cs:0x0(%rax,%rax,1)
+	data16 data16 nopw %cs:0x0(%rax,%rax,1)
+`,
+		counts: map[pegRule]int{
+			ruleBaseIndexScale:    6,
+			ruleInstructionPrefix: 3,
+		},
+	},
+	{
+		name: "CFIAdjustCFAOffset",
+		input: `	.cfi_startproc
+	pushq %rbx
+	.cfi_adjust_cfa_offset 8
+	pushq %rbp
+	.cfi_adjust_cfa_offset 8
+	pushq %r12
+	.cfi_adjust_cfa_offset 8
+	popq %r12
+	.cfi_adjust_cfa_offset -8
+	.cfi_adjust_cfa_offset 8+foo
+	.cfi_endproc
+`,
+		counts:       map[pegRule]int{ruleDirective: 1},
+		path:         []pegRule{ruleCFIDirective, ruleCFIAdjustCFAOffsetDirective, ruleExpression},
+		pathContents: []string{"8", "8", "8", "-8"},
+	},
+	{
+		name: "RetpolineThunks",
+		input: `	movq foo@GOTPCREL(%rip), %rax
+	call __x86_indirect_thunk_rax
+	jmp __x86_return_thunk
+`,
+		path:         []pegRule{ruleInstruction, ruleInstructionArg, ruleMemoryRef, ruleSymbolRef, ruleSymbolName},
+		pathContents: []string{"foo", "__x86_indirect_thunk_rax", "__x86_return_thunk"},
+	},
+	{
+		// Pre-indexing, as in a prologue, writes back the base
+		// register. Post-indexing, as in an epilogue, takes the offset
+		// as an extra argument.
+		name: "ARMPairIndexing",
+		input: `	stp x29, x30, [sp, #-16]!
+	ldp x29, x30, [sp, #-16]!
+	ldp x29, x30, [sp], #16
+	stp x19, x20, [sp], #32
+`,
+		counts: map[pegRule]int{
+			ruleARMBaseIndexScale: 4,
+			ruleARMPostincrement:  2,
+			ruleInstructionArg:    14,
+		},
+	},
+	{
+		name: "TrapInstructions",
+		input: `	int3
+	ud2
+	ud1 (%rax), %eax
+`,
+		counts: map[pegRule]int{
+			ruleInstruction:    3,
+			ruleInstructionArg: 2,
+			ruleMemoryRef:      1,
+		},
+	},
+	{
+		name: "VZeroInstructions",
+		input: `	vzeroupper
+	vzeroall
+`,
+		counts:       map[pegRule]int{ruleInstructionArg: 0},
+		path:         []pegRule{ruleInstruction, ruleInstructionName},
+		pathContents: []string{"vzeroupper", "vzeroall"},
+	},
+	{
+		// System register names are only special on aarch64. Elsewhere
+		// they may be ordinary symbols.
+		name: "SystemRegisterNamesAsSymbols",
+		input: `	mrs x0, tpidr_el0
+	movq tpidr_el0(%rip), %rax
+	leaq cntvct_el0+8(%rip), %rbx
+`,
+		counts: map[pegRule]int{
+			ruleARMSystemRegister: 1,
+			ruleSymbolRef:         2,
+		},
+	},
+	{
+		// A prefix alone, possibly followed by a comment, is an
+		// instruction name.
+		name:  "BarePrefixes",
+		input: "\tlock # c\n\trep \n\tlock addl $1, (%rax)\n",
+		statements: []pegRule{
+			ruleInstruction,
+			ruleInstruction,
+			ruleInstruction,
+		},
+		counts:       map[pegRule]int{ruleInstructionPrefix: 1},
+		path:         []pegRule{ruleInstruction, ruleInstructionName},
+		pathContents: []string{"lock", "rep", "addl"},
+	},
+	{
+		// Condition register names are only special on ppc64le.
+		// Elsewhere they are symbols.
+		name: "ConditionRegisterNamesAsSymbols",
+		input: `	call cr1
+	jmp cr7
+`,
+		counts: map[pegRule]int{
+			rulePPCConditionRegister: 0,
+			ruleSymbolRef:            2,
+		},
+	},
+	{
+		name: "DataExpressions",
+		input: `	.short 4*8
+	.hword 1<<4
+	.long 4+foo
+	.type foo, 2
+`,
+		statements: []pegRule{
+			ruleLabelContainingDirective,
+			ruleLabelContainingDirective,
+			ruleLabelContainingDirective,
+			ruleLabelContainingDirective,
+		},
+		path:         []pegRule{ruleLabelContainingDirective, ruleSymbolArgs, ruleSymbolArg, ruleExpression},
+		pathContents: []string{"4*8", "1<<4"},
+	},
+	{
+		name: "CFIDefCFAOffsetExpression",
+		input: `	.cfi_startproc
+	.cfi_def_cfa_offset 16
+	.cfi_def_cfa_offset 8+16
+	.cfi_def_cfa_offset (2*8) + 8
+	.cfi_def_cfa_offset 16+foo
+	.cfi_endproc
+`,
+		statements: []pegRule{
+			ruleCFIDirective,
+			ruleCFIDirective,
+			ruleCFIDirective,
+			ruleCFIDirective,
+			ruleDirective,
+			ruleCFIDirective,
+		},
+		counts: map[pegRule]int{
+			ruleCFIDefCFAOffsetDirective: 3,
+			ruleAdditiveOperator:         2,
+			ruleProductOperator:          1,
+		},
+	},
+	{
+		name:         "RelocNone",
+		input:        "\t.reloc ., BFD_RELOC_NONE, memcpy\n",
+		statements:   []pegRule{ruleRelocDirective},
+		path:         []pegRule{ruleRelocDirective, ruleRelocType},
+		pathContents: []string{"BFD_RELOC_NONE"},
+	},
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range parseTests {
+		t.Run(test.name, func(t *testing.T) {
+			asm := Asm{Buffer: test.input, Pretty: true, COFF: test.coff}
+			asm.Init()
+			if err := asm.Parse(); err != nil {
+				t.Fatalf("parse failed: %s", err)
+			}
+
+			if test.statements != nil {
+				var got []string
+				for statement := asm.AST().up; statement != nil; statement = statement.next {
+					if node := skipWS(statement.up); node != nil {
+						got = append(got, rul3s[node.pegRule])
+					}
+				}
+
+				var want []string
+				for _, rule := range test.statements {
+					want = append(want, rul3s[rule])
+				}
+
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("statement types differed.\nWanted: %v\nGot:    %v", want, got)
+				}
+			}
+
+			for rule, want := range test.counts {
+				if got := asm.CountRule(rule); got != want {
+					t.Errorf("found %d %s nodes, wanted %d", got, rul3s[rule], want)
+				}
+			}
+
+			if test.path != nil {
+				var got []string
+				forEachPath(asm.AST().up, func(node *node32) {
+					got = append(got, test.input[node.begin:node.end])
+				}, append([]pegRule{ruleStatement}, test.path...)...)
+				if !reflect.DeepEqual(got, test.pathContents) {
+					t.Errorf("contents of %v differed.\nWanted: %q\nGot:    %q", test.path, test.pathContents, got)
+				}
+			}
+		})
+	}
+}
+
+// parseInputFile parses contents, failing the test if it is not valid.
+func parseInputFile(t *testing.T, contents string) inputFile {
+	t.Helper()
+	asm := Asm{Buffer: contents, Pretty: true}
+	asm.Init()
+	if err := asm.Parse(); err != nil {
+		t.Fatalf("%q: parse failed: %s", contents, err)
+	}
+	return inputFile{path: "test.s", contents: contents, ast: asm.AST()}
+}
+
+func TestCOFFSection(t *testing.T) {
+	tests := []struct {
+		input, want string
+	}{
+		{"\t.section .text$mn,\"xr\"\n", "# WAS .section .text$mn,\"xr\"\n.text\n"},
+		{"\t.section .rdata,\"dr\"\n", "# WAS .section .rdata,\"dr\"\n.text\n"},
+		{"\t.section .xdata,\"dr\"\n", "\t.section .xdata,\"dr\"\n"},
+	}
+
+	for _, test := range tests {
+		asm := Asm{Buffer: test.input, Pretty: true, COFF: true}
+		asm.Init()
+		if err := asm.Parse(); err != nil {
+			t.Fatalf("parse of %q failed: %s", test.input, err)
+		}
+
+		var out bytes.Buffer
+		d := &delocation{
+			processor:        x86_64,
+			output:           &out,
+			commentIndicator: "#",
+		}
+		input := inputFile{path: "test.s", contents: test.input, ast: asm.AST()}
+		if err := d.processInput(input); err != nil {
+			t.Fatalf("processing %q failed: %s", test.input, err)
+		}
+
+		if got := out.String(); got != test.want {
+			t.Errorf("processing %q gave %q, wanted %q", test.input, got, test.want)
+		}
+	}
+}
+
+func TestParseInputsCOFF(t *testing.T) {
+	f, err := ioutil.TempFile("", "delocate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString("\t.section .text$mn,\"xr\"\n"); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	// COFF section names are not valid ELF section names.
+	if err := parseInputs([]inputFile{{path: f.Name()}}); err == nil {
+		t.Errorf("parseInputs unexpectedly accepted a COFF section without coff")
+	}
+
+	inputs := []inputFile{{path: f.Name(), coff: true}}
+	if err := parseInputs(inputs); err != nil {
+		t.Fatalf("parseInputs failed: %s", err)
+	}
+	if got := skipWS(inputs[0].ast.up.up).pegRule; got != ruleCOFFSectionDirective {
+		t.Errorf("parsed statement as %s, wanted %s", rul3s[got], rul3s[ruleCOFFSectionDirective])
+	}
+}
+
+func TestFileDirectiveComponents(t *testing.T) {
+	tests := []struct {
+		input  string
+		fileNo int
+		hasMD5 bool
+		ok     bool
+	}{
+		{`.file "foo.c"`, 0, false, false},
+		{`.file 1 "foo.c"`, 1, false, true},
+		{`.file 2 "dir" "foo.c"`, 2, false, true},
+		{`.file 3 "dir" "foo.c" md5 0x0123456789abcdef`, 3, true, true},
+		{`.file 4 "foo.c" md5 0x0123456789abcdef # comment`, 4, true, true},
+	}
+
+	for _, test := range tests {
+		input := parseInputFile(t, test.input+"\n")
+		var directive *node32
+		forEachPath(input.ast.up, func(node *node32) {
+			directive = node
+		}, ruleStatement, ruleLocationDirective, ruleFileDirective, ruleStructuredFileDirective)
+		if directive == nil {
+			t.Errorf("%q: no structured .file directive found", test.input)
+			continue
+		}
+		fileNo, hasMD5, ok := parseFileDirective(input.contents, directive)
+		if fileNo != test.fileNo || hasMD5 != test.hasMD5 || ok != test.ok {
+			t.Errorf("%q: got (%d, %t, %t), wanted (%d, %t, %t)", test.input, fileNo, hasMD5, ok, test.fileNo, test.hasMD5, test.ok)
+		}
+	}
+}
+
+func TestCFIRegions(t *testing.T) {
+	tests := []struct {
+		input  string
+		strict bool
+		ok     bool
+		// bodies contains the statements in each region found.
+		bodies [][]string
+	}{
+		{
+			input: `foo:
+	.cfi_startproc
+	pushq %rbx
+	.cfi_adjust_cfa_offset 8
+	popq %rbx
+	ret
+	.cfi_endproc
+
+bar:
+	.cfi_startproc simple
+	ret
+	.cfi_endproc
+`,
+			strict: true,
+			ok:     true,
+			bodies: [][]string{
+				{"pushq %rbx", ".cfi_adjust_cfa_offset 8", "popq %rbx", "ret"},
+				{"ret"},
+			},
+		},
+		// Nested regions are always an error.
+		{input: "\t.cfi_startproc\n\t.cfi_startproc\n\t.cfi_endproc\n\t.cfi_endproc\n", strict: true, ok: false},
+		{input: "\t.cfi_startproc\n\t.cfi_startproc\n\t.cfi_endproc\n\t.cfi_endproc\n", strict: false, ok: false},
+		// Unmatched directives are only an error in strict mode.
+		{input: "\t.cfi_startproc\n\tret\n", strict: true, ok: false},
+		{input: "\t.cfi_startproc\n\tret\n", strict: false, ok: true},
+		{input: "\tret\n\t.cfi_endproc\n", strict: true, ok: false},
+		{input: "\tret\n\t.cfi_endproc\n", strict: false, ok: true},
+		{input: "\t.cfi_startproc\n\tret\n\t.cfi_endproc\n\t.cfi_endproc\n", strict: true, ok: false},
+		{input: "\t.cfi_startproc\n\tret\n\t.cfi_endproc\n\t.cfi_endproc\n", strict: false, ok: true, bodies: [][]string{{"ret"}}},
+		// Sections switched to and back again within a region are skipped
+		// over.
+		{
+			input:  "\t.cfi_startproc\n\tret\n\t.pushsection .rodata\n\t.quad 0\n\t.popsection\n\t.cfi_endproc\n",
+			strict: true,
+			ok:     true,
+			bodies: [][]string{{"ret", ".pushsection .rodata", ".quad 0", ".popsection"}},
+		},
+		{
+			input:  "\t.text\n\t.cfi_startproc\n\t.section .rodata\n\t.quad 0\n\t.previous\n\t.cfi_endproc\n",
+			strict: true,
+			ok:     true,
+			bodies: [][]string{{".section .rodata", ".quad 0", ".previous"}},
+		},
+		{
+			input:  "\t.text\n\t.cfi_startproc\n\t.section .rodata\n\t.quad 0\n\t.text\n\t.cfi_endproc\n",
+			strict: true,
+			ok:     true,
+			bodies: [][]string{{".section .rodata", ".quad 0", ".text"}},
+		},
+		// A region must end in the section it started in.
+		{input: "\t.cfi_startproc\n\t.pushsection .rodata\n\t.cfi_endproc\n\t.popsection\n", strict: false, ok: false},
+		{input: "\t.text\n\t.cfi_startproc\n\t.section .rodata\n\t.cfi_endproc\n", strict: false, ok: false},
+		{input: "\t.text\n\t.cfi_startproc\n\t.section .rodata\n\t.pushsection .bss\n\t.popsection\n\t.cfi_endproc\n", strict: false, ok: false},
+	}
+
+	for _, test := range tests {
+		regions, err := cfiRegions(parseInputFile(t, test.input), test.strict)
+		if ok := err == nil; ok != test.ok {
+			t.Errorf("%q (strict: %t): cfiRegions returned error %v, wanted success %t", test.input, test.strict, err, test.ok)
+			continue
+		}
+
+		var bodies [][]string
+		for _, region := range regions {
+			if got := strings.TrimSpace(test.input[region.start.begin:region.start.end]); !strings.HasPrefix(got, ".cfi_startproc") {
+				t.Errorf("%q: region starts with %q", test.input, got)
+			}
+			if got := strings.TrimSpace(test.input[region.end.begin:region.end.end]); got != ".cfi_endproc" {
+				t.Errorf("%q: region ends with %q", test.input, got)
+			}
+
+			body := []string{}
+			for statement := region.start.next; statement != region.end; statement = statement.next {
+				body = append(body, strings.TrimSpace(test.input[statement.begin:statement.end]))
+			}
+			bodies = append(bodies, body)
+		}
+		if !reflect.DeepEqual(bodies, test.bodies) {
+			t.Errorf("%q (strict: %t): found regions %q, wanted %q", test.input, test.strict, bodies, test.bodies)
+		}
+	}
+}
+
+func TestTransformUnbalancedCFI(t *testing.T) {
+	tests := []struct {
+		input  string
+		strict bool
+		ok     bool
+	}{
+		{"foo:\n\t.cfi_startproc\n\t.cfi_startproc\n\tmovq %rax, %rbx\n\t.cfi_endproc\n\t.cfi_endproc\n", false, false},
+		{"foo:\n\tmovq %rax, %rbx\n\t.cfi_endproc\n", false, true},
+		{"foo:\n\tmovq %rax, %rbx\n\t.cfi_endproc\n", true, false},
+		{"foo:\n\t.cfi_startproc\n\tmovq %rax, %rbx\n", false, true},
+		{"foo:\n\t.cfi_startproc\n\tmovq %rax, %rbx\n", true, false},
+	}
+
+	for _, test := range tests {
+		input := parseInputFile(t, test.input)
+		input.strictCFI = test.strict
+		var buf bytes.Buffer
+		if err := transform(&buf, []inputFile{input}); (err == nil) != test.ok {
+			t.Errorf("%q (strict: %t): transform returned error %v, wanted success %t", test.input, test.strict, err, test.ok)
+		}
+	}
+}
+
+func TestDebugSectionType(t *testing.T) {
+	for _, section := range []string{".debug_loc", ".debug_ranges", ".debug_str", ".debug_info", ".debug_line.dwo"} {
+		if typ, ok := sectionType(section); !ok || typ != ".debug" {
+			t.Errorf("sectionType(%q) = %q, %t, wanted \".debug\", true", section, typ, ok)
+		}
+	}
+}
+
+func TestSymbolTypeName(t *testing.T) {
+	tests := []struct {
+		processor processorType
+		value     string
+		want      string
+	}{
+		{x86_64, "2", "@function"},
+		{x86_64, "10", "@gnu_indirect_function"},
+		{x86_64, "0x6", "@tls_object"},
+		{aarch64, "10", "%gnu_indirect_function"},
+	}
+	for _, test := range tests {
+		d := delocation{processor: test.processor}
+		if got, err := d.symbolTypeName(test.value); err != nil || got != test.want {
+			t.Errorf("symbolTypeName(%q) = %q, %v, wanted %q", test.value, got, err, test.want)
+		}
+	}
+
+	d := delocation{processor: x86_64}
+	if _, err := d.symbolTypeName("42"); err == nil {
+		t.Errorf("symbolTypeName(\"42\") unexpectedly succeeded")
+	}
+}
+
+func TestIsRetpolineThunk(t *testing.T) {
+	for symbol, want := range map[string]bool{
+		"__x86_indirect_thunk_rax": true,
+		"__x86_indirect_thunk_r11": true,
+		"__x86_return_thunk":       true,
+		"__x86_return_thunk_2":     false,
+		"memcpy":                   false,
+	} {
+		if got := isRetpolineThunk(symbol); got != want {
+			t.Errorf("isRetpolineThunk(%q) = %t, wanted %t", symbol, got, want)
+		}
+	}
+}
+
+func TestSymbolAliases(t *testing.T) {
+	const input = "foo:\n" +
+		"\tret\n" +
+		"\t.set foo_alias, foo\n" +
+		"\t.type foo_alias, @function\n" +
+		"\t.size foo_alias, .-foo\n" +
+		"\t.set foo_alias2, foo_alias\n" +
+		"\t.set offset_alias, foo+8\n" +
+		"\t.set three, 3\n"
+
+	aliases := make(map[string]string)
+	findSymbolAliases(parseInputFile(t, input), aliases)
+	want := map[string]string{
+		"foo_alias":  "foo",
+		"foo_alias2": "foo_alias",
+	}
+	if !reflect.DeepEqual(aliases, want) {
+		t.Errorf("found aliases %v, wanted %v", aliases, want)
+	}
+}
@@ -21,6 +21,11 @@ foo:
 	adrp x10, .Llocal_data2+16
 	add x11, x10, :lo12:.Llocal_data2+16
 
+	// adr is PC-relative without a page relocation, so it is left alone
+	// except for mapping the target.
+	adr x0, .Llocal_data
+	adr x1, local_function
+
 	// Address load with no-op add instruction
 	adrp x0, .Llocal_data
 	add x0, x0, :lo12:.Llocal_data
@@ -37,12 +42,47 @@ foo:
 	adrp x10, .Llocal_data2
 	ldr q0, [x10, :lo12:.Llocal_data2]
 
+	// Prefetch operations are keywords, not symbols
+	prfm pldl1keep, [x0]
+	prfm pstl2strm, [x1, #64]
+
+	// System registers and PSTATE fields are not symbols
+	mrs x0, tpidr_el0
+	msr daifset, #2
+
 	bl local_function
 
 	bl remote_function
 
 	bl bss_symbol_bss_get
 
+	// Shifted immediates are left alone.
+	movz x0, #0x1234, lsl #0
+	movk x0, #0x5678, lsl #16
+	movk x0, #0x9abc, lsl #32
+	movk x0, #0xdef0, lsl #48
+
+	// Literal pool loads of constants are left alone.
+	ldr x0, =0x12345678
+	.ltorg
+
+	// Bit-test branches to local labels.
+.Lbit_test_back:
+	tbz x0, #5, .Lbit_test_fwd
+	tbnz w1, #0, .Lbit_test_back
+	tbz x2, #63, 1f
+	tbnz x3, #31, 1b
+.Lbit_test_fwd:
+1:
+
+	// LSE atomics, including register pairs, are left alone.
+	cas x0, x1, [x2]
+	casp x0, x1, x2, x3, [x4]
+	caspal w4, w5, w6, w7, [sp]
+	ldadd x0, x1, [x2]
+	ldaddal w0, w1, [x2]
+	swp x0, x1, [x2]
+
 local_function:
 
 // BSS data
@@ -0,0 +1,35 @@
+	.text
+
+	// Functions with MTE-tagged stack frames and signed return addresses
+	// carry additional CFI.
+	.type foo, %function
+	.globl foo
+foo:
+	.cfi_startproc
+	.cfi_mte_tagged_frame
+	.cfi_b_key_frame
+	hint #27
+	.cfi_negate_ra_state
+	str x30, [sp, #-16]!
+	.cfi_def_cfa_offset 16
+	ldr x30, [sp], #16
+	hint #31
+	ret
+	.cfi_endproc
+	.size foo, .-foo
+
+	// Signing with the PC as a diversifier uses its own CFI op.
+	.type bar, %function
+	.globl bar
+bar:
+	.cfi_startproc
+.Lbar_sign:
+	paciasppc
+	.cfi_negate_ra_state_with_pc
+	stp x29, x30, [sp, #-16]!
+	.cfi_def_cfa_offset 16
+	ldp x29, x30, [sp], #16
+	autiasppc .Lbar_sign
+	ret
+	.cfi_endproc
+	.size bar, .-bar
@@ -0,0 +1,122 @@
+.text
+.file 1 "inserted_by_delocate.c"
+.loc 1 1 0
+BORINGSSL_bcm_text_start:
+	.text
+
+	// Functions with MTE-tagged stack frames and signed return addresses
+	// carry additional CFI.
+	.type foo, %function
+	.globl foo
+.Lfoo_local_target:
+foo:
+	.cfi_startproc
+	.cfi_mte_tagged_frame
+	.cfi_b_key_frame
+	hint #27
+	.cfi_negate_ra_state
+	str x30, [sp, #-16]!
+	.cfi_def_cfa_offset 16
+	ldr x30, [sp], #16
+	hint #31
+	ret
+	.cfi_endproc
+	.size foo, .-foo
+
+	// Signing with the PC as a diversifier uses its own CFI op.
+	.type bar, %function
+	.globl bar
+.Lbar_local_target:
+bar:
+	.cfi_startproc
+.Lbar_sign:
+
+	paciasppc
+	.cfi_negate_ra_state_with_pc
+	stp x29, x30, [sp, #-16]!
+	.cfi_def_cfa_offset 16
+	ldp x29, x30, [sp], #16
+	autiasppc .Lbar_sign
+	ret
+	.cfi_endproc
+	.size bar, .-bar
+.text
+.loc 1 2 0
+BORINGSSL_bcm_text_end:
+.p2align 2
+.hidden .LOPENSSL_armcap_P_addr
+.type .LOPENSSL_armcap_P_addr, @function
+.LOPENSSL_armcap_P_addr:
+.cfi_startproc
+	adrp x0, OPENSSL_armcap_P
+	add x0, x0, :lo12:OPENSSL_armcap_P
+	ret
+.cfi_endproc
+.size .LOPENSSL_armcap_P_addr, .-.LOPENSSL_armcap_P_addr
+.type BORINGSSL_bcm_text_hash, @object
+.size BORINGSSL_bcm_text_hash, 64
+BORINGSSL_bcm_text_hash:
+.byte 0xae
+.byte 0x2c
+.byte 0xea
+.byte 0x2a
+.byte 0xbd
+.byte 0xa6
+.byte 0xf3
+.byte 0xec
+.byte 0x97
+.byte 0x7f
+.byte 0x9b
+.byte 0xf6
+.byte 0x94
+.byte 0x9a
+.byte 0xfc
+.byte 0x83
+.byte 0x68
+.byte 0x27
+.byte 0xcb
+.byte 0xa0
+.byte 0xa0
+.byte 0x9f
+.byte 0x6b
+.byte 0x6f
+.byte 0xde
+.byte 0x52
+.byte 0xcd
+.byte 0xe2
+.byte 0xcd
+.byte 0xff
+.byte 0x31
+.byte 0x80
+.byte 0xa2
+.byte 0xd4
+.byte 0xc3
+.byte 0x66
+.byte 0xf
+.byte 0xc2
+.byte 0x6a
+.byte 0x7b
+.byte 0xf4
+.byte 0xbe
+.byte 0x39
+.byte 0xa2
+.byte 0xd7
+.byte 0x25
+.byte 0xdb
+.byte 0x21
+.byte 0x98
+.byte 0xe9
+.byte 0xd5
+.byte 0x53
+.byte 0xbf
+.byte 0x5c
+.byte 0x32
+.byte 0x6
+.byte 0x83
+.byte 0x34
+.byte 0xc
+.byte 0x65
+.byte 0x89
+.byte 0x52
+.byte 0xbd
+.byte 0x1f
@@ -0,0 +1,18 @@
+	.abiversion 2
+	.text
+	.globl foo
+	.type foo, @function
+foo:
+.LCF0:
+0:
+	addis 2,12,.TOC.-.LCF0@ha
+	addi 2,2,.TOC.-.LCF0@l
+	.localentry foo,.-foo
+	cmpw cr0, 3, 4
+	cmpw 7,3,4
+	bc 12, 4*cr1+eq, .L1
+	beq cr7, .L1
+	li 3,0
+.L1:
+	blr
+	.size foo,.-foo
@@ -0,0 +1,12 @@
+	.abiversion 2
+	.gnu_attribute 4, 1
+	.gnu_attribute 8,1
+	.gnu_attribute 12, 0x2 # Tag_GNU_Power_ABI_Struct_Return
+	.text
+foo:
+.LCF0:
+0:
+	addis 2,12,.TOC.-.LCF0@ha
+	addi 2,2,.TOC.-.LCF0@l
+	.localentry foo,.-foo
+	blr
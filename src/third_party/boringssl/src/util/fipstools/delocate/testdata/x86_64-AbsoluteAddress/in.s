@@ -0,0 +1,9 @@
+	.type foo, @function
+	.globl foo
+foo:
+	# A bare displacement is an absolute memory operand. These contain no
+	# symbols and pass through unchanged.
+	lea 8, %rax
+	mov 0x10, %eax
+	movq %fs:0x28, %rax
+	ret
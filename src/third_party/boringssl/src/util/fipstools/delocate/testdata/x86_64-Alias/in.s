@@ -0,0 +1,26 @@
+	.text
+	.globl foo
+	.type foo, @function
+foo:
+	movq %rax, %rbx
+	ret
+	.size foo, .-foo
+
+	# An alias of a function in the module is itself in the module.
+	.globl foo_alias
+	.set foo_alias, foo
+	.type foo_alias, @function
+	.size foo_alias, .-foo
+
+	# As are aliases of aliases.
+	.set foo_alias2, foo_alias
+
+	# Aliases of external symbols are not.
+	.set ext_alias, ext
+
+bar:
+	call foo_alias
+	call foo_alias2
+	call ext_alias
+	leaq foo_alias(%rip), %rax
+	ret
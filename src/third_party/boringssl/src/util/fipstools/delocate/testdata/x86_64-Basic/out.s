@@ -46,7 +46,28 @@ foo:
 	vpcmpneqq       .LCPI508_30(%rip){1to8}, %zmm1, %k0
 	vmovdqu64       -88(%rbx), %zmm0 {%k1}
 	vmovdqu64       352(%rsp,%rbx), %ymm1 {%k1}
+	vgatherdps      (%rax,%zmm1,4), %zmm2 {%k1}
+	vscatterdps     %zmm2, 8(%rax,%zmm1,4) {%k1}
+	vpgatherdd      %xmm3, (%rax,%xmm1,4), %xmm2
+	vpternlogd      $0x96, %zmm2, %zmm1, %zmm0
+	vpternlogq      $0xe8, 64(%rsi), %zmm1, %zmm0{%k1}
+	kmovw           %k1, %eax
+	kandw           %k2, %k3, %k1
+	kortestw        %k1, %k1
+	kmovq           %rax, %k7
 	.byte   0xf3,0xc3
+	.byte 0xf3,0x0f,0x1e,0xfa # endbr64
+	andq $~0xff, %rax
+	movq $(1<<20), %rax
+	movq $-(3<<2), %rax
+	movq $1<<4, %rax
+	orl $1 << 4 | 3, %eax
+	lock cmpxchg16b 8(%rdi)
+	cpuid
+	rdtsc
+	xgetbv
+	movb $'A, %al
+	cmpb $'\n', %al
 	movq %rax, %rbx # Comments can be on the same line as an instruction.
 .L3:
  # Or on the same line as a label.
@@ -0,0 +1,46 @@
+	.text
+
+	# Signal trampolines mark their frame so that unwinders can cope with
+	# the interrupted context.
+	.type sigtramp, @function
+sigtramp:
+	.cfi_startproc
+	.cfi_signal_frame
+	movq %rsp, %rdi
+	call handler
+	ret
+	.cfi_endproc
+	.size sigtramp, .-sigtramp
+
+	# A function may keep its return address somewhere other than the
+	# default column.
+	.type altret, @function
+altret:
+	.cfi_startproc
+	.cfi_return_column %rbx
+	movq %rbx, %rax
+	jmpq *%rax
+	.cfi_endproc
+	.size altret, .-altret
+
+	# Registers whose values cannot be recovered are marked undefined.
+	.type noreturn, @function
+noreturn:
+	.cfi_startproc
+	.cfi_undefined %rip
+	xorq %rbp, %rbp
+	call abort
+	.cfi_endproc
+	.size noreturn, .-noreturn
+
+	# The CFA offset may be given as an expression.
+	.type frame, @function
+frame:
+	.cfi_startproc
+	subq $24, %rsp
+	.cfi_def_cfa_offset 8+24
+	addq $24, %rsp
+	.cfi_def_cfa_offset (2*2) + 4
+	ret
+	.cfi_endproc
+	.size frame, .-frame
@@ -0,0 +1,55 @@
+	.text
+
+	# Escapes may compute bytes from local symbols, which must be mapped.
+	.type escaped, @function
+escaped:
+	.cfi_startproc
+	.cfi_escape 0x16, 0x10, (1<<3), .Lend-.Lstart
+.Lstart:
+	movq %rsp, %rax
+.Lend:
+	ret
+	.cfi_endproc
+	.size escaped, .-escaped
+
+	# DWARF expressions are passed through verbatim.
+	.type expr, @function
+expr:
+	.cfi_startproc
+	.cfi_def_cfa_expression 0x77, 8, 0x06
+	.cfi_expression %rbp, 0x76, 0x10
+	.cfi_val_expression 6, 0x70, 8
+	ret
+	.cfi_endproc
+	.size expr, .-expr
+
+	# The symbol operand of .cfi_val_encoded_addr must be mapped.
+	.type encoded, @function
+encoded:
+	.cfi_startproc
+	.cfi_val_encoded_addr %rsp, 0x1b, .Lencoded_ret
+	ret
+.Lencoded_ret:
+	.cfi_endproc
+	.size encoded, .-encoded
+
+	# .cfi_label defines a label, which must be mapped if local.
+	.type labelled, @function
+labelled:
+	.cfi_startproc
+	.cfi_label .Lcfi_state
+	pushq %rbx
+	.cfi_label cfi_global_state
+	popq %rbx
+	ret
+	.cfi_endproc
+	.size labelled, .-labelled
+
+	# Personalities may be referenced by index.
+	.type personality, @function
+personality:
+	.cfi_startproc
+	.cfi_personality_id 3
+	ret
+	.cfi_endproc
+	.size personality, .-personality
@@ -0,0 +1,201 @@
+.text
+.file 1 "inserted_by_delocate.c"
+.loc 1 1 0
+BORINGSSL_bcm_text_start:
+	.text
+
+	# Signal trampolines mark their frame so that unwinders can cope with
+	# the interrupted context.
+	.type sigtramp, @function
+.Lsigtramp_local_target:
+sigtramp:
+	.cfi_startproc
+	.cfi_signal_frame
+	movq %rsp, %rdi
+	call handler
+	ret
+	.cfi_endproc
+	.size sigtramp, .-sigtramp
+
+	# A function may keep its return address somewhere other than the
+	# default column.
+	.type altret, @function
+.Laltret_local_target:
+altret:
+	.cfi_startproc
+	.cfi_return_column %rbx
+	movq %rbx, %rax
+	jmpq *%rax
+	.cfi_endproc
+	.size altret, .-altret
+
+	# Registers whose values cannot be recovered are marked undefined.
+	.type noreturn, @function
+.Lnoreturn_local_target:
+noreturn:
+	.cfi_startproc
+	.cfi_undefined %rip
+	xorq %rbp, %rbp
+	call abort
+	.cfi_endproc
+	.size noreturn, .-noreturn
+
+	# The CFA offset may be given as an expression.
+	.type frame, @function
+.Lframe_local_target:
+frame:
+	.cfi_startproc
+	subq $24, %rsp
+	.cfi_def_cfa_offset 8+24
+	addq $24, %rsp
+	.cfi_def_cfa_offset (2*2) + 4
+	ret
+	.cfi_endproc
+	.size frame, .-frame
+	.text
+
+	# Escapes may compute bytes from local symbols, which must be mapped.
+	.type escaped, @function
+.Lescaped_local_target:
+escaped:
+	.cfi_startproc
+# WAS .cfi_escape 0x16, 0x10, (1<<3), .Lend-.Lstart
+	.cfi_escape 0x16, 0x10, (1<<3), .Lend_BCM_1-.Lstart_BCM_1
+.Lstart_BCM_1:
+
+	movq %rsp, %rax
+.Lend_BCM_1:
+
+	ret
+	.cfi_endproc
+	.size escaped, .-escaped
+
+	# DWARF expressions are passed through verbatim.
+	.type expr, @function
+.Lexpr_local_target:
+expr:
+	.cfi_startproc
+	.cfi_def_cfa_expression 0x77, 8, 0x06
+	.cfi_expression %rbp, 0x76, 0x10
+	.cfi_val_expression 6, 0x70, 8
+	ret
+	.cfi_endproc
+	.size expr, .-expr
+
+	# The symbol operand of .cfi_val_encoded_addr must be mapped.
+	.type encoded, @function
+.Lencoded_local_target:
+encoded:
+	.cfi_startproc
+# WAS .cfi_val_encoded_addr %rsp, 0x1b, .Lencoded_ret
+	.cfi_val_encoded_addr %rsp, 0x1b, .Lencoded_ret_BCM_1
+	ret
+.Lencoded_ret_BCM_1:
+
+	.cfi_endproc
+	.size encoded, .-encoded
+
+	# .cfi_label defines a label, which must be mapped if local.
+	.type labelled, @function
+.Llabelled_local_target:
+labelled:
+	.cfi_startproc
+# WAS .cfi_label .Lcfi_state
+	.cfi_label .Lcfi_state_BCM_1
+	pushq %rbx
+	.cfi_label cfi_global_state
+	popq %rbx
+	ret
+	.cfi_endproc
+	.size labelled, .-labelled
+
+	# Personalities may be referenced by index.
+	.type personality, @function
+.Lpersonality_local_target:
+personality:
+	.cfi_startproc
+	.cfi_personality_id 3
+	ret
+	.cfi_endproc
+	.size personality, .-personality
+.text
+.loc 1 2 0
+BORINGSSL_bcm_text_end:
+.type OPENSSL_ia32cap_get, @function
+.globl OPENSSL_ia32cap_get
+.LOPENSSL_ia32cap_get_local_target:
+OPENSSL_ia32cap_get:
+	leaq OPENSSL_ia32cap_P(%rip), %rax
+	ret
+.extern OPENSSL_ia32cap_P
+.type OPENSSL_ia32cap_addr_delta, @object
+.size OPENSSL_ia32cap_addr_delta, 8
+OPENSSL_ia32cap_addr_delta:
+.quad OPENSSL_ia32cap_P-OPENSSL_ia32cap_addr_delta
+.type BORINGSSL_bcm_text_hash, @object
+.size BORINGSSL_bcm_text_hash, 64
+BORINGSSL_bcm_text_hash:
+.byte 0xae
+.byte 0x2c
+.byte 0xea
+.byte 0x2a
+.byte 0xbd
+.byte 0xa6
+.byte 0xf3
+.byte 0xec
+.byte 0x97
+.byte 0x7f
+.byte 0x9b
+.byte 0xf6
+.byte 0x94
+.byte 0x9a
+.byte 0xfc
+.byte 0x83
+.byte 0x68
+.byte 0x27
+.byte 0xcb
+.byte 0xa0
+.byte 0xa0
+.byte 0x9f
+.byte 0x6b
+.byte 0x6f
+.byte 0xde
+.byte 0x52
+.byte 0xcd
+.byte 0xe2
+.byte 0xcd
+.byte 0xff
+.byte 0x31
+.byte 0x80
+.byte 0xa2
+.byte 0xd4
+.byte 0xc3
+.byte 0x66
+.byte 0xf
+.byte 0xc2
+.byte 0x6a
+.byte 0x7b
+.byte 0xf4
+.byte 0xbe
+.byte 0x39
+.byte 0xa2
+.byte 0xd7
+.byte 0x25
+.byte 0xdb
+.byte 0x21
+.byte 0x98
+.byte 0xe9
+.byte 0xd5
+.byte 0x53
+.byte 0xbf
+.byte 0x5c
+.byte 0x32
+.byte 0x6
+.byte 0x83
+.byte 0x34
+.byte 0xc
+.byte 0x65
+.byte 0x89
+.byte 0x52
+.byte 0xbd
+.byte 0x1f
@@ -0,0 +1,15 @@
+	.type foo, @function
+	.globl foo
+foo:
+	movq $0, %rax
+	ret
+
+	.section .rodata
+.Ltable:
+	.dc.a foo
+	.dc.l 1,2,3
+	.dc.w 4
+	.dc.b 5
+	# Embedded files are left alone.
+	.incbin "data.bin"
+	.incbin "data.bin", 16, 32
@@ -0,0 +1,12 @@
+	.type bar, @function
+bar:
+	ret
+
+	.section .rodata
+	# Local symbols in data constants must be mapped.
+.Ltable:
+	.dc.a .Ltable
+	.dc.l .Lend-.Ltable
+	.short .Ltable
+	.hword .Lend-.Ltable
+.Lend:
@@ -0,0 +1,31 @@
+	.text
+	.type foo, @function
+foo:
+.Lfunc_begin0:
+	movq %rdi, %rax
+	ret
+.Lfunc_end0:
+
+	# Debug sections, as emitted with LTO, are left in place.
+	.section .debug_loc,"",@progbits
+.Ldebug_loc0:
+	.quad .Lfunc_begin0-.Lfunc_begin0
+	.quad .Lfunc_end0-.Lfunc_begin0
+	.short 1
+	.byte 85
+	.quad 0
+	.quad 0
+
+	.section .debug_ranges,"",@progbits
+.Ldebug_ranges0:
+	.quad .Lfunc_begin0
+	.quad .Lfunc_end0
+	.quad 0
+	.quad 0
+
+	.section .debug_str,"MS",@progbits,1
+.Linfo_string0:
+	.asciz "foo"
+
+	.section .debug_info,"G",@progbits,foo,comdat
+	.long .Linfo_string0
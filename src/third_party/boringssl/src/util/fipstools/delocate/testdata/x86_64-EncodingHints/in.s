@@ -0,0 +1,10 @@
+	.type foo, @function
+	.globl foo
+foo:
+	{vex} vmovaps %xmm0, %xmm1
+	{evex} vpaddd %zmm0, %zmm1, %zmm2
+	{vex3}vpxor %xmm0, %xmm1, %xmm2
+
+	# Hints are kept when an instruction is rewritten.
+	{disp32} leaq foo(%rip), %rax
+	ret
@@ -0,0 +1,9 @@
+	.equ FOO, 42
+	.equiv BAR, foo
+	.set BAZ, 8
+
+	.type foo, @function
+	.globl foo
+foo:
+	movq $0, %rax
+	ret
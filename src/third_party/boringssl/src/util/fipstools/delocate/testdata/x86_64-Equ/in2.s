@@ -0,0 +1,10 @@
+	# Local symbols in definitions must be mapped to avoid collisions with
+	# the other file.
+	.equ .Lconst, 16
+	.equiv .Lsize, .Lend-.Lstart
+	.equ QUX, .Lstart+4
+
+.Lstart:
+	leaq .Lconst(%rip), %rax
+	ret
+.Lend:
@@ -0,0 +1,22 @@
+	.text
+	.type foo, @function
+foo:
+	leaq .L$table(%rip), %rax
+	movslq (%rax,%rdi,8), %rdx
+	addq %rdx, %rax
+	jmp *%rax
+.L$case0:
+	ret
+.L$case1:
+	ret
+.Lcase$2:
+	ret
+
+	.section .rodata
+	.p2align 3
+	# Relative jump table entries must be mapped on both sides.
+.L$table:
+	.quad .L$case0 - .L$table
+	.quad .L$case1-.L$table
+	.quad .Lcase$2 - .L$table
+	.quad .L$case0 - .L$table + 8
@@ -0,0 +1,11 @@
+	.text
+	.type bar, @function
+bar:
+	leaq .L$table(%rip), %rax
+	ret
+
+	.section .rodata
+.L$table:
+	.quad .L$case0 - .L$table
+	.quad .L$case0 - .L$table + 8
+.L$case0:
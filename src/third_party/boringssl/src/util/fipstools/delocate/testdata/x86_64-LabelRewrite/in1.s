@@ -16,6 +16,12 @@ bar:
 	jmp memcpy@PLT
 	jbe memcpy@PLT
 
+	# Retpoline and return thunks are out-calls even without @PLT.
+	movq foo@GOTPCREL(%rip), %rax
+	call __x86_indirect_thunk_rax
+	jmp __x86_indirect_thunk_r11
+	jmp __x86_return_thunk
+
 	# Jumps to local PLT symbols use their local targets.
 	call foo@PLT
 	jmp foo@PLT
@@ -24,6 +30,20 @@ bar:
 	# Synthesized symbols are treated as local ones.
 	call OPENSSL_ia32cap_get@PLT
 
+	# Size-suffixed mnemonics are handled the same way.
+	callq foo
+	jmpq foo@PLT
+	jmpq memcpy@PLT
+
+	# RIP-relative references may carry a constant offset.
+	leaq foo+16(%rip), %rax
+	leaq foo-8(%rip), %rax
+
+	# Prefetch hints take a single memory operand.
+	prefetcht0 (%rax)
+	prefetchnta foo(%rip)
+	prefetchw 8(%rax,%rbx,4)
+
 	# References to local labels are left as-is in the first file.
 .Llocal_label:
 	jbe .Llocal_label
@@ -29,6 +29,16 @@ bar:
 # WAS jbe memcpy@PLT
 	jbe	bcm_redirector_memcpy
 
+	# Retpoline and return thunks are out-calls even without @PLT.
+# WAS movq foo@GOTPCREL(%rip), %rax
+	leaq	.Lfoo_local_target(%rip), %rax
+# WAS call __x86_indirect_thunk_rax
+	call	bcm_redirector___x86_indirect_thunk_rax
+# WAS jmp __x86_indirect_thunk_r11
+	jmp	bcm_redirector___x86_indirect_thunk_r11
+# WAS jmp __x86_return_thunk
+	jmp	bcm_redirector___x86_return_thunk
+
 	# Jumps to local PLT symbols use their local targets.
 # WAS call foo@PLT
 	call	.Lfoo_local_target
@@ -41,6 +51,26 @@ bar:
 # WAS call OPENSSL_ia32cap_get@PLT
 	call	.LOPENSSL_ia32cap_get_local_target
 
+	# Size-suffixed mnemonics are handled the same way.
+# WAS callq foo
+	callq	.Lfoo_local_target
+# WAS jmpq foo@PLT
+	jmpq	.Lfoo_local_target
+# WAS jmpq memcpy@PLT
+	jmpq	bcm_redirector_memcpy
+
+	# RIP-relative references may carry a constant offset.
+# WAS leaq foo+16(%rip), %rax
+	leaq	.Lfoo_local_target+16(%rip), %rax
+# WAS leaq foo-8(%rip), %rax
+	leaq	.Lfoo_local_target-8(%rip), %rax
+
+	# Prefetch hints take a single memory operand.
+	prefetcht0 (%rax)
+# WAS prefetchnta foo(%rip)
+	prefetchnta	.Lfoo_local_target(%rip)
+	prefetchw 8(%rax,%rbx,4)
+
 	# References to local labels are left as-is in the first file.
 .Llocal_label:
 
@@ -92,6 +122,15 @@ bar:
 .text
 .loc 1 2 0
 BORINGSSL_bcm_text_end:
+.type bcm_redirector___x86_indirect_thunk_r11, @function
+bcm_redirector___x86_indirect_thunk_r11:
+	jmp	__x86_indirect_thunk_r11@PLT
+.type bcm_redirector___x86_indirect_thunk_rax, @function
+bcm_redirector___x86_indirect_thunk_rax:
+	jmp	__x86_indirect_thunk_rax@PLT
+.type bcm_redirector___x86_return_thunk, @function
+bcm_redirector___x86_return_thunk:
+	jmp	__x86_return_thunk@PLT
 .type bcm_redirector_memcpy, @function
 bcm_redirector_memcpy:
 	jmp	memcpy@PLT
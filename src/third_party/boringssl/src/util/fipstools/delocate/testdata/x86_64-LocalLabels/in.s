@@ -0,0 +1,20 @@
+	.type foo, @function
+	.globl foo
+foo:
+	movq $10, %rax
+
+	# Numeric local labels may be used as backwards branch targets.
+1:
+	decq %rax
+	jne 1b
+
+	# And as forwards branch targets.
+	jmp 2f
+	nop
+2:
+	call 3f
+	ret
+
+3:
+	ret
+	.size foo, .-foo
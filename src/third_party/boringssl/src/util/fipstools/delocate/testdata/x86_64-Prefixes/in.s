@@ -0,0 +1,34 @@
+	.type foo, @function
+	.globl foo
+foo:
+	xacquire lock cmpxchg %rbx, (%rax)
+	xrelease movq %rcx, (%rax)
+	rep movsb
+	rep stosq
+	repz scasb
+	repne scasb
+
+	# Patchable function entry NOPs are kept byte-for-byte.
+	endbr64
+	xchg %ax, %ax
+	nopl (%rax)
+	nopl 0x0(%rax)
+	nopl 0x0(%rax,%rax,1)
+	nopw 0x0(%rax,%rax,1)
+	nopl 0x0(,%rax,1)
+	nopw %cs:0x0(%rax,%rax,1)
+	data16 nopw %cs:0x0(%rax,%rax,1)
+	data16 data16 nopw %cs:0x0(%rax,%rax,1)
+
+	# Prefixes are kept when an instruction is rewritten.
+	lock incq foo(%rip)
+
+	# Trap instructions are left alone.
+	int3
+	ud2
+	ud1 (%rax), %eax
+
+	# AVX state cleanup is left alone.
+	vzeroupper
+	vzeroall
+	ret
@@ -0,0 +1,16 @@
+	.text
+	.type foo, @function
+	.globl foo
+foo:
+	movq $0, %rax
+	# Relocations against symbols in the module use their local targets.
+	.reloc .-4, R_X86_64_PC32, foo
+	.reloc .Lfoo_end-(2*4), R_X86_64_NONE, .Lfoo_end
+	# Relocations against other symbols are left for the linker.
+	.reloc ., R_X86_64_NONE, memcpy
+	# BFD_RELOC_NONE keeps its target live without relocating anything.
+	.reloc ., BFD_RELOC_NONE, foo
+	.reloc ., BFD_RELOC_NONE, OPENSSL_cpuid_setup
+	ret
+.Lfoo_end:
+	.size foo, .-foo
@@ -0,0 +1,15 @@
+	.text
+	# Symbolic types are left alone.
+	.type foo, @function
+	.type resolver, @gnu_indirect_function
+	.type tls_var, @tls_object
+foo:
+	movq %rax, %rbx
+	ret
+
+	# Numeric types are written out by name.
+	.type bar, 2
+	.type baz, 10
+	.type qux, 0x1
+bar:
+	ret